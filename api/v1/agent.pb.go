@@ -20,6 +20,156 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExportTimeseriesRequest_Format int32
+
+const (
+	ExportTimeseriesRequest_CSV     ExportTimeseriesRequest_Format = 0
+	ExportTimeseriesRequest_EDF     ExportTimeseriesRequest_Format = 1
+	ExportTimeseriesRequest_NPY     ExportTimeseriesRequest_Format = 2
+	ExportTimeseriesRequest_PARQUET ExportTimeseriesRequest_Format = 3
+)
+
+// Enum value maps for ExportTimeseriesRequest_Format.
+var (
+	ExportTimeseriesRequest_Format_name = map[int32]string{
+		0: "CSV",
+		1: "EDF",
+		2: "NPY",
+		3: "PARQUET",
+	}
+	ExportTimeseriesRequest_Format_value = map[string]int32{
+		"CSV":     0,
+		"EDF":     1,
+		"NPY":     2,
+		"PARQUET": 3,
+	}
+)
+
+func (x ExportTimeseriesRequest_Format) Enum() *ExportTimeseriesRequest_Format {
+	p := new(ExportTimeseriesRequest_Format)
+	*p = x
+	return p
+}
+
+func (x ExportTimeseriesRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportTimeseriesRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportTimeseriesRequest_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[0]
+}
+
+func (x ExportTimeseriesRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportTimeseriesRequest_Format.Descriptor instead.
+func (ExportTimeseriesRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type GetTimeseriesRangeRequest_DecimationMethod int32
+
+const (
+	GetTimeseriesRangeRequest_MIN_MAX GetTimeseriesRangeRequest_DecimationMethod = 0 // Minimum and maximum value per bucket
+	GetTimeseriesRangeRequest_LTTB    GetTimeseriesRangeRequest_DecimationMethod = 1 // Largest-Triangle-Three-Buckets
+)
+
+// Enum value maps for GetTimeseriesRangeRequest_DecimationMethod.
+var (
+	GetTimeseriesRangeRequest_DecimationMethod_name = map[int32]string{
+		0: "MIN_MAX",
+		1: "LTTB",
+	}
+	GetTimeseriesRangeRequest_DecimationMethod_value = map[string]int32{
+		"MIN_MAX": 0,
+		"LTTB":    1,
+	}
+)
+
+func (x GetTimeseriesRangeRequest_DecimationMethod) Enum() *GetTimeseriesRangeRequest_DecimationMethod {
+	p := new(GetTimeseriesRangeRequest_DecimationMethod)
+	*p = x
+	return p
+}
+
+func (x GetTimeseriesRangeRequest_DecimationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTimeseriesRangeRequest_DecimationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[1].Descriptor()
+}
+
+func (GetTimeseriesRangeRequest_DecimationMethod) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[1]
+}
+
+func (x GetTimeseriesRangeRequest_DecimationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeRequest_DecimationMethod.Descriptor instead.
+func (GetTimeseriesRangeRequest_DecimationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type GetTimeseriesRangeRequest_SampleEncoding int32
+
+const (
+	GetTimeseriesRangeRequest_FLOAT32 GetTimeseriesRangeRequest_SampleEncoding = 0 // Values in data
+	GetTimeseriesRangeRequest_FLOAT64 GetTimeseriesRangeRequest_SampleEncoding = 1 // Values in data_float64
+	GetTimeseriesRangeRequest_INT16   GetTimeseriesRangeRequest_SampleEncoding = 2 // Integers in data_int, in the int16 range
+	GetTimeseriesRangeRequest_INT32   GetTimeseriesRangeRequest_SampleEncoding = 3 // Integers in data_int, in the int32 range
+)
+
+// Enum value maps for GetTimeseriesRangeRequest_SampleEncoding.
+var (
+	GetTimeseriesRangeRequest_SampleEncoding_name = map[int32]string{
+		0: "FLOAT32",
+		1: "FLOAT64",
+		2: "INT16",
+		3: "INT32",
+	}
+	GetTimeseriesRangeRequest_SampleEncoding_value = map[string]int32{
+		"FLOAT32": 0,
+		"FLOAT64": 1,
+		"INT16":   2,
+		"INT32":   3,
+	}
+)
+
+func (x GetTimeseriesRangeRequest_SampleEncoding) Enum() *GetTimeseriesRangeRequest_SampleEncoding {
+	p := new(GetTimeseriesRangeRequest_SampleEncoding)
+	*p = x
+	return p
+}
+
+func (x GetTimeseriesRangeRequest_SampleEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTimeseriesRangeRequest_SampleEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (GetTimeseriesRangeRequest_SampleEncoding) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[2]
+}
+
+func (x GetTimeseriesRangeRequest_SampleEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeRequest_SampleEncoding.Descriptor instead.
+func (GetTimeseriesRangeRequest_SampleEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{17, 1}
+}
+
 type GetTimeseriesRangeResponse_MessageType int32
 
 const (
@@ -27,6 +177,7 @@ const (
 	GetTimeseriesRangeResponse_RANGE_DATA   GetTimeseriesRangeResponse_MessageType = 1
 	GetTimeseriesRangeResponse_CHANNEL_INFO GetTimeseriesRangeResponse_MessageType = 2
 	GetTimeseriesRangeResponse_ERROR        GetTimeseriesRangeResponse_MessageType = 3
+	GetTimeseriesRangeResponse_GAP          GetTimeseriesRangeResponse_MessageType = 4
 )
 
 // Enum value maps for GetTimeseriesRangeResponse_MessageType.
@@ -36,12 +187,14 @@ var (
 		1: "RANGE_DATA",
 		2: "CHANNEL_INFO",
 		3: "ERROR",
+		4: "GAP",
 	}
 	GetTimeseriesRangeResponse_MessageType_value = map[string]int32{
 		"RANGE_STATUS": 0,
 		"RANGE_DATA":   1,
 		"CHANNEL_INFO": 2,
 		"ERROR":        3,
+		"GAP":          4,
 	}
 )
 
@@ -56,11 +209,11 @@ func (x GetTimeseriesRangeResponse_MessageType) String() string {
 }
 
 func (GetTimeseriesRangeResponse_MessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[0].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[3].Descriptor()
 }
 
 func (GetTimeseriesRangeResponse_MessageType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[0]
+	return &file_api_v1_agent_proto_enumTypes[3]
 }
 
 func (x GetTimeseriesRangeResponse_MessageType) Number() protoreflect.EnumNumber {
@@ -69,7 +222,7 @@ func (x GetTimeseriesRangeResponse_MessageType) Number() protoreflect.EnumNumber
 
 // Deprecated: Use GetTimeseriesRangeResponse_MessageType.Descriptor instead.
 func (GetTimeseriesRangeResponse_MessageType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{8, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type SubscribeResponse_MessageType int32
@@ -81,6 +234,7 @@ const (
 	SubscribeResponse_SYNC_STATUS     SubscribeResponse_MessageType = 3 // Sync Status message
 	SubscribeResponse_DOWNLOAD_STATUS SubscribeResponse_MessageType = 4 // Download Status Message
 	SubscribeResponse_DOWNLOAD_CANCEL SubscribeResponse_MessageType = 5 // Download was cancelled
+	SubscribeResponse_WORKFLOW_STATUS SubscribeResponse_MessageType = 6 // Workflow status or output message
 )
 
 // Enum value maps for SubscribeResponse_MessageType.
@@ -92,6 +246,7 @@ var (
 		3: "SYNC_STATUS",
 		4: "DOWNLOAD_STATUS",
 		5: "DOWNLOAD_CANCEL",
+		6: "WORKFLOW_STATUS",
 	}
 	SubscribeResponse_MessageType_value = map[string]int32{
 		"EVENT":           0,
@@ -100,6 +255,7 @@ var (
 		"SYNC_STATUS":     3,
 		"DOWNLOAD_STATUS": 4,
 		"DOWNLOAD_CANCEL": 5,
+		"WORKFLOW_STATUS": 6,
 	}
 )
 
@@ -114,11 +270,11 @@ func (x SubscribeResponse_MessageType) String() string {
 }
 
 func (SubscribeResponse_MessageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[1].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[4].Descriptor()
 }
 
 func (SubscribeResponse_MessageType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[1]
+	return &file_api_v1_agent_proto_enumTypes[4]
 }
 
 func (x SubscribeResponse_MessageType) Number() protoreflect.EnumNumber {
@@ -127,7 +283,7 @@ func (x SubscribeResponse_MessageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubscribeResponse_MessageType.Descriptor instead.
 func (SubscribeResponse_MessageType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type SubscribeResponse_UploadResponse_UploadStatus int32
@@ -163,11 +319,11 @@ func (x SubscribeResponse_UploadResponse_UploadStatus) String() string {
 }
 
 func (SubscribeResponse_UploadResponse_UploadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[2].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[5].Descriptor()
 }
 
 func (SubscribeResponse_UploadResponse_UploadStatus) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[2]
+	return &file_api_v1_agent_proto_enumTypes[5]
 }
 
 func (x SubscribeResponse_UploadResponse_UploadStatus) Number() protoreflect.EnumNumber {
@@ -176,7 +332,7 @@ func (x SubscribeResponse_UploadResponse_UploadStatus) Number() protoreflect.Enu
 
 // Deprecated: Use SubscribeResponse_UploadResponse_UploadStatus.Descriptor instead.
 func (SubscribeResponse_UploadResponse_UploadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 1, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 1, 0}
 }
 
 type SubscribeResponse_DownloadStatusResponse_DownloadStatus int32
@@ -212,11 +368,11 @@ func (x SubscribeResponse_DownloadStatusResponse_DownloadStatus) String() string
 }
 
 func (SubscribeResponse_DownloadStatusResponse_DownloadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[3].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[6].Descriptor()
 }
 
 func (SubscribeResponse_DownloadStatusResponse_DownloadStatus) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[3]
+	return &file_api_v1_agent_proto_enumTypes[6]
 }
 
 func (x SubscribeResponse_DownloadStatusResponse_DownloadStatus) Number() protoreflect.EnumNumber {
@@ -225,7 +381,7 @@ func (x SubscribeResponse_DownloadStatusResponse_DownloadStatus) Number() protor
 
 // Deprecated: Use SubscribeResponse_DownloadStatusResponse_DownloadStatus.Descriptor instead.
 func (SubscribeResponse_DownloadStatusResponse_DownloadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 2, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 2, 0}
 }
 
 type SubscribeResponse_SyncResponse_SyncStatus int32
@@ -261,11 +417,11 @@ func (x SubscribeResponse_SyncResponse_SyncStatus) String() string {
 }
 
 func (SubscribeResponse_SyncResponse_SyncStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[4].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[7].Descriptor()
 }
 
 func (SubscribeResponse_SyncResponse_SyncStatus) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[4]
+	return &file_api_v1_agent_proto_enumTypes[7]
 }
 
 func (x SubscribeResponse_SyncResponse_SyncStatus) Number() protoreflect.EnumNumber {
@@ -274,49 +430,107 @@ func (x SubscribeResponse_SyncResponse_SyncStatus) Number() protoreflect.EnumNum
 
 // Deprecated: Use SubscribeResponse_SyncResponse_SyncStatus.Descriptor instead.
 func (SubscribeResponse_SyncResponse_SyncStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 3, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 3, 0}
+}
+
+type ListManifestFilesRequest_SortField int32
+
+const (
+	ListManifestFilesRequest_ID          ListManifestFilesRequest_SortField = 0
+	ListManifestFilesRequest_SOURCE_PATH ListManifestFilesRequest_SortField = 1
+	ListManifestFilesRequest_TARGET_PATH ListManifestFilesRequest_SortField = 2 // Path of the file in the dataset, including its name
+	ListManifestFilesRequest_STATUS      ListManifestFilesRequest_SortField = 3
+	ListManifestFilesRequest_UPDATED_AT  ListManifestFilesRequest_SortField = 4
+)
+
+// Enum value maps for ListManifestFilesRequest_SortField.
+var (
+	ListManifestFilesRequest_SortField_name = map[int32]string{
+		0: "ID",
+		1: "SOURCE_PATH",
+		2: "TARGET_PATH",
+		3: "STATUS",
+		4: "UPDATED_AT",
+	}
+	ListManifestFilesRequest_SortField_value = map[string]int32{
+		"ID":          0,
+		"SOURCE_PATH": 1,
+		"TARGET_PATH": 2,
+		"STATUS":      3,
+		"UPDATED_AT":  4,
+	}
+)
+
+func (x ListManifestFilesRequest_SortField) Enum() *ListManifestFilesRequest_SortField {
+	p := new(ListManifestFilesRequest_SortField)
+	*p = x
+	return p
+}
+
+func (x ListManifestFilesRequest_SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListManifestFilesRequest_SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[8].Descriptor()
+}
+
+func (ListManifestFilesRequest_SortField) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[8]
+}
+
+func (x ListManifestFilesRequest_SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListManifestFilesRequest_SortField.Descriptor instead.
+func (ListManifestFilesRequest_SortField) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{36, 0}
 }
 
 type ListManifestFilesResponse_StatusType int32
 
 const (
-	ListManifestFilesResponse_LOCAL      ListManifestFilesResponse_StatusType = 0 // File indexed locally
-	ListManifestFilesResponse_REGISTERED ListManifestFilesResponse_StatusType = 1 // File manifest synchronized with Cloud
-	ListManifestFilesResponse_IMPORTED   ListManifestFilesResponse_StatusType = 2
-	ListManifestFilesResponse_FINALIZED  ListManifestFilesResponse_StatusType = 3
-	ListManifestFilesResponse_VERIFIED   ListManifestFilesResponse_StatusType = 4
-	ListManifestFilesResponse_FAILED     ListManifestFilesResponse_StatusType = 5 // File imported in cloud
-	ListManifestFilesResponse_REMOVED    ListManifestFilesResponse_StatusType = 6 // File Upload canceled (manually, or failed)
-	ListManifestFilesResponse_UNKNOWN    ListManifestFilesResponse_StatusType = 7
-	ListManifestFilesResponse_CHANGED    ListManifestFilesResponse_StatusType = 8
-	ListManifestFilesResponse_UPLOADED   ListManifestFilesResponse_StatusType = 9
+	ListManifestFilesResponse_LOCAL        ListManifestFilesResponse_StatusType = 0 // File indexed locally
+	ListManifestFilesResponse_REGISTERED   ListManifestFilesResponse_StatusType = 1 // File manifest synchronized with Cloud
+	ListManifestFilesResponse_IMPORTED     ListManifestFilesResponse_StatusType = 2
+	ListManifestFilesResponse_FINALIZED    ListManifestFilesResponse_StatusType = 3
+	ListManifestFilesResponse_VERIFIED     ListManifestFilesResponse_StatusType = 4
+	ListManifestFilesResponse_FAILED       ListManifestFilesResponse_StatusType = 5 // File imported in cloud
+	ListManifestFilesResponse_REMOVED      ListManifestFilesResponse_StatusType = 6 // File Upload canceled (manually, or failed)
+	ListManifestFilesResponse_UNKNOWN      ListManifestFilesResponse_StatusType = 7
+	ListManifestFilesResponse_CHANGED      ListManifestFilesResponse_StatusType = 8
+	ListManifestFilesResponse_UPLOADED     ListManifestFilesResponse_StatusType = 9
+	ListManifestFilesResponse_FAILEDORPHAN ListManifestFilesResponse_StatusType = 10 // File object missing on the server after registration
 )
 
 // Enum value maps for ListManifestFilesResponse_StatusType.
 var (
 	ListManifestFilesResponse_StatusType_name = map[int32]string{
-		0: "LOCAL",
-		1: "REGISTERED",
-		2: "IMPORTED",
-		3: "FINALIZED",
-		4: "VERIFIED",
-		5: "FAILED",
-		6: "REMOVED",
-		7: "UNKNOWN",
-		8: "CHANGED",
-		9: "UPLOADED",
+		0:  "LOCAL",
+		1:  "REGISTERED",
+		2:  "IMPORTED",
+		3:  "FINALIZED",
+		4:  "VERIFIED",
+		5:  "FAILED",
+		6:  "REMOVED",
+		7:  "UNKNOWN",
+		8:  "CHANGED",
+		9:  "UPLOADED",
+		10: "FAILEDORPHAN",
 	}
 	ListManifestFilesResponse_StatusType_value = map[string]int32{
-		"LOCAL":      0,
-		"REGISTERED": 1,
-		"IMPORTED":   2,
-		"FINALIZED":  3,
-		"VERIFIED":   4,
-		"FAILED":     5,
-		"REMOVED":    6,
-		"UNKNOWN":    7,
-		"CHANGED":    8,
-		"UPLOADED":   9,
+		"LOCAL":        0,
+		"REGISTERED":   1,
+		"IMPORTED":     2,
+		"FINALIZED":    3,
+		"VERIFIED":     4,
+		"FAILED":       5,
+		"REMOVED":      6,
+		"UNKNOWN":      7,
+		"CHANGED":      8,
+		"UPLOADED":     9,
+		"FAILEDORPHAN": 10,
 	}
 )
 
@@ -331,11 +545,11 @@ func (x ListManifestFilesResponse_StatusType) String() string {
 }
 
 func (ListManifestFilesResponse_StatusType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[5].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[9].Descriptor()
 }
 
 func (ListManifestFilesResponse_StatusType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[5]
+	return &file_api_v1_agent_proto_enumTypes[9]
 }
 
 func (x ListManifestFilesResponse_StatusType) Number() protoreflect.EnumNumber {
@@ -344,7 +558,53 @@ func (x ListManifestFilesResponse_StatusType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListManifestFilesResponse_StatusType.Descriptor instead.
 func (ListManifestFilesResponse_StatusType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{27, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type ValidateManifestResponse_Issue_Severity int32
+
+const (
+	ValidateManifestResponse_Issue_ERROR   ValidateManifestResponse_Issue_Severity = 0
+	ValidateManifestResponse_Issue_WARNING ValidateManifestResponse_Issue_Severity = 1
+)
+
+// Enum value maps for ValidateManifestResponse_Issue_Severity.
+var (
+	ValidateManifestResponse_Issue_Severity_name = map[int32]string{
+		0: "ERROR",
+		1: "WARNING",
+	}
+	ValidateManifestResponse_Issue_Severity_value = map[string]int32{
+		"ERROR":   0,
+		"WARNING": 1,
+	}
+)
+
+func (x ValidateManifestResponse_Issue_Severity) Enum() *ValidateManifestResponse_Issue_Severity {
+	p := new(ValidateManifestResponse_Issue_Severity)
+	*p = x
+	return p
+}
+
+func (x ValidateManifestResponse_Issue_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidateManifestResponse_Issue_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[10].Descriptor()
+}
+
+func (ValidateManifestResponse_Issue_Severity) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[10]
+}
+
+func (x ValidateManifestResponse_Issue_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidateManifestResponse_Issue_Severity.Descriptor instead.
+func (ValidateManifestResponse_Issue_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{53, 0, 0}
 }
 
 type WorkflowResponse_WorkflowType int32
@@ -377,11 +637,11 @@ func (x WorkflowResponse_WorkflowType) String() string {
 }
 
 func (WorkflowResponse_WorkflowType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[6].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[11].Descriptor()
 }
 
 func (WorkflowResponse_WorkflowType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[6]
+	return &file_api_v1_agent_proto_enumTypes[11]
 }
 
 func (x WorkflowResponse_WorkflowType) Number() protoreflect.EnumNumber {
@@ -390,7 +650,62 @@ func (x WorkflowResponse_WorkflowType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkflowResponse_WorkflowType.Descriptor instead.
 func (WorkflowResponse_WorkflowType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{40, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{57, 0}
+}
+
+type WorkflowRun_Status int32
+
+const (
+	WorkflowRun_PENDING   WorkflowRun_Status = 0
+	WorkflowRun_RUNNING   WorkflowRun_Status = 1
+	WorkflowRun_SUCCEEDED WorkflowRun_Status = 2
+	WorkflowRun_FAILED    WorkflowRun_Status = 3
+	WorkflowRun_CANCELLED WorkflowRun_Status = 4
+)
+
+// Enum value maps for WorkflowRun_Status.
+var (
+	WorkflowRun_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+		4: "CANCELLED",
+	}
+	WorkflowRun_Status_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+		"CANCELLED": 4,
+	}
+)
+
+func (x WorkflowRun_Status) Enum() *WorkflowRun_Status {
+	p := new(WorkflowRun_Status)
+	*p = x
+	return p
+}
+
+func (x WorkflowRun_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkflowRun_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[12].Descriptor()
+}
+
+func (WorkflowRun_Status) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[12]
+}
+
+func (x WorkflowRun_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkflowRun_Status.Descriptor instead.
+func (WorkflowRun_Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{58, 0}
 }
 
 type Account_AccountType int32
@@ -423,11 +738,11 @@ func (x Account_AccountType) String() string {
 }
 
 func (Account_AccountType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[7].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[13].Descriptor()
 }
 
 func (Account_AccountType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[7]
+	return &file_api_v1_agent_proto_enumTypes[13]
 }
 
 func (x Account_AccountType) Number() protoreflect.EnumNumber {
@@ -436,7 +751,7 @@ func (x Account_AccountType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Account_AccountType.Descriptor instead.
 func (Account_AccountType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{45, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{73, 0}
 }
 
 type DownloadRequest_DownloadType int32
@@ -469,11 +784,11 @@ func (x DownloadRequest_DownloadType) String() string {
 }
 
 func (DownloadRequest_DownloadType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[8].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[14].Descriptor()
 }
 
 func (DownloadRequest_DownloadType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[8]
+	return &file_api_v1_agent_proto_enumTypes[14]
 }
 
 func (x DownloadRequest_DownloadType) Number() protoreflect.EnumNumber {
@@ -482,7 +797,7 @@ func (x DownloadRequest_DownloadType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadRequest_DownloadType.Descriptor instead.
 func (DownloadRequest_DownloadType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{48, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{76, 0}
 }
 
 type DownloadResponse_ResponseType int32
@@ -515,11 +830,11 @@ func (x DownloadResponse_ResponseType) String() string {
 }
 
 func (DownloadResponse_ResponseType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[9].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[15].Descriptor()
 }
 
 func (DownloadResponse_ResponseType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[9]
+	return &file_api_v1_agent_proto_enumTypes[15]
 }
 
 func (x DownloadResponse_ResponseType) Number() protoreflect.EnumNumber {
@@ -528,7 +843,56 @@ func (x DownloadResponse_ResponseType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadResponse_ResponseType.Descriptor instead.
 func (DownloadResponse_ResponseType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{51, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{79, 0}
+}
+
+type MapDiffRequest_Strength int32
+
+const (
+	MapDiffRequest_FAST       MapDiffRequest_Strength = 0 // CRC32 over the entire file
+	MapDiffRequest_FULL       MapDiffRequest_Strength = 1 // SHA-256 over the entire file
+	MapDiffRequest_SIZE_MTIME MapDiffRequest_Strength = 2 // File size and modification time
+)
+
+// Enum value maps for MapDiffRequest_Strength.
+var (
+	MapDiffRequest_Strength_name = map[int32]string{
+		0: "FAST",
+		1: "FULL",
+		2: "SIZE_MTIME",
+	}
+	MapDiffRequest_Strength_value = map[string]int32{
+		"FAST":       0,
+		"FULL":       1,
+		"SIZE_MTIME": 2,
+	}
+)
+
+func (x MapDiffRequest_Strength) Enum() *MapDiffRequest_Strength {
+	p := new(MapDiffRequest_Strength)
+	*p = x
+	return p
+}
+
+func (x MapDiffRequest_Strength) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapDiffRequest_Strength) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[16].Descriptor()
+}
+
+func (MapDiffRequest_Strength) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[16]
+}
+
+func (x MapDiffRequest_Strength) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapDiffRequest_Strength.Descriptor instead.
+func (MapDiffRequest_Strength) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{82, 0}
 }
 
 type PackageStatus_StatusType int32
@@ -540,6 +904,7 @@ const (
 	PackageStatus_CHANGED       PackageStatus_StatusType = 3
 	PackageStatus_MOVED         PackageStatus_StatusType = 4
 	PackageStatus_MOVED_RENAMED PackageStatus_StatusType = 5
+	PackageStatus_CONFLICT      PackageStatus_StatusType = 6 // File changed locally and on Pennsieve since it was pulled
 )
 
 // Enum value maps for PackageStatus_StatusType.
@@ -551,6 +916,7 @@ var (
 		3: "CHANGED",
 		4: "MOVED",
 		5: "MOVED_RENAMED",
+		6: "CONFLICT",
 	}
 	PackageStatus_StatusType_value = map[string]int32{
 		"ADDED":         0,
@@ -559,6 +925,7 @@ var (
 		"CHANGED":       3,
 		"MOVED":         4,
 		"MOVED_RENAMED": 5,
+		"CONFLICT":      6,
 	}
 )
 
@@ -573,11 +940,11 @@ func (x PackageStatus_StatusType) String() string {
 }
 
 func (PackageStatus_StatusType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_agent_proto_enumTypes[10].Descriptor()
+	return file_api_v1_agent_proto_enumTypes[17].Descriptor()
 }
 
 func (PackageStatus_StatusType) Type() protoreflect.EnumType {
-	return &file_api_v1_agent_proto_enumTypes[10]
+	return &file_api_v1_agent_proto_enumTypes[17]
 }
 
 func (x PackageStatus_StatusType) Number() protoreflect.EnumNumber {
@@ -586,7 +953,56 @@ func (x PackageStatus_StatusType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PackageStatus_StatusType.Descriptor instead.
 func (PackageStatus_StatusType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{54, 0}
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{84, 0}
+}
+
+type ResolveConflictRequest_Resolution int32
+
+const (
+	ResolveConflictRequest_KEEP_LOCAL  ResolveConflictRequest_Resolution = 0 // Keep local file as a local change to the latest remote file
+	ResolveConflictRequest_KEEP_REMOTE ResolveConflictRequest_Resolution = 1 // Replace local file with remote file
+	ResolveConflictRequest_KEEP_BOTH   ResolveConflictRequest_Resolution = 2 // Rename local file and pull remote file
+)
+
+// Enum value maps for ResolveConflictRequest_Resolution.
+var (
+	ResolveConflictRequest_Resolution_name = map[int32]string{
+		0: "KEEP_LOCAL",
+		1: "KEEP_REMOTE",
+		2: "KEEP_BOTH",
+	}
+	ResolveConflictRequest_Resolution_value = map[string]int32{
+		"KEEP_LOCAL":  0,
+		"KEEP_REMOTE": 1,
+		"KEEP_BOTH":   2,
+	}
+)
+
+func (x ResolveConflictRequest_Resolution) Enum() *ResolveConflictRequest_Resolution {
+	p := new(ResolveConflictRequest_Resolution)
+	*p = x
+	return p
+}
+
+func (x ResolveConflictRequest_Resolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResolveConflictRequest_Resolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_agent_proto_enumTypes[18].Descriptor()
+}
+
+func (ResolveConflictRequest_Resolution) Type() protoreflect.EnumType {
+	return &file_api_v1_agent_proto_enumTypes[18]
+}
+
+func (x ResolveConflictRequest_Resolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResolveConflictRequest_Resolution.Descriptor instead.
+func (ResolveConflictRequest_Resolution) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{86, 0}
 }
 
 type PullRequest struct {
@@ -777,18 +1193,14 @@ func (x *ResetCacheRequest) GetId() string {
 	return ""
 }
 
-type GetTimeseriesChannelsRequest struct {
+type GetTimeseriesCacheStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	PackageId string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	Refresh   bool   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
 }
 
-func (x *GetTimeseriesChannelsRequest) Reset() {
-	*x = GetTimeseriesChannelsRequest{}
+func (x *GetTimeseriesCacheStatsRequest) Reset() {
+	*x = GetTimeseriesCacheStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,13 +1208,13 @@ func (x *GetTimeseriesChannelsRequest) Reset() {
 	}
 }
 
-func (x *GetTimeseriesChannelsRequest) String() string {
+func (x *GetTimeseriesCacheStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesChannelsRequest) ProtoMessage() {}
+func (*GetTimeseriesCacheStatsRequest) ProtoMessage() {}
 
-func (x *GetTimeseriesChannelsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTimeseriesCacheStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,62 +1226,106 @@ func (x *GetTimeseriesChannelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesChannelsRequest.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesChannelsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTimeseriesCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesCacheStatsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetTimeseriesChannelsRequest) GetDatasetId() string {
-	if x != nil {
-		return x.DatasetId
-	}
-	return ""
+type GetTimeseriesCacheStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalSize int64                                                `protobuf:"varint,1,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	MaxSize   int64                                                `protobuf:"varint,2,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"` // Maximum cache size in bytes; 0 is unlimited
+	Packages  []*GetTimeseriesCacheStatsResponse_PackageCacheStats `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
 }
 
-func (x *GetTimeseriesChannelsRequest) GetPackageId() string {
+func (x *GetTimeseriesCacheStatsResponse) Reset() {
+	*x = GetTimeseriesCacheStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetTimeseriesCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTimeseriesCacheStatsResponse) GetTotalSize() int64 {
 	if x != nil {
-		return x.PackageId
+		return x.TotalSize
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTimeseriesChannelsRequest) GetRefresh() bool {
+func (x *GetTimeseriesCacheStatsResponse) GetMaxSize() int64 {
 	if x != nil {
-		return x.Refresh
+		return x.MaxSize
 	}
-	return false
+	return 0
 }
 
-type TimeseriesChannel struct {
+func (x *GetTimeseriesCacheStatsResponse) GetPackages() []*GetTimeseriesCacheStatsResponse_PackageCacheStats {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type ExportTimeseriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	StartTime uint64  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   uint64  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Unit      string  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
-	Rate      float32 `protobuf:"fixed32,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	DatasetId    string                         `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId    string                         `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	ChannelIds   []string                       `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"` // Channels to export; all channels if empty
+	StartTime    float64                        `protobuf:"fixed64,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      float64                        `protobuf:"fixed64,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	RelativeTime bool                           `protobuf:"varint,6,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
+	Format       ExportTimeseriesRequest_Format `protobuf:"varint,7,opt,name=format,proto3,enum=v1.ExportTimeseriesRequest_Format" json:"format,omitempty"`
+	TargetPath   string                         `protobuf:"bytes,8,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"` // Absolute path of the exported file
 }
 
-func (x *TimeseriesChannel) Reset() {
-	*x = TimeseriesChannel{}
+func (x *ExportTimeseriesRequest) Reset() {
+	*x = ExportTimeseriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[5]
+		mi := &file_api_v1_agent_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TimeseriesChannel) String() string {
+func (x *ExportTimeseriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeseriesChannel) ProtoMessage() {}
+func (*ExportTimeseriesRequest) ProtoMessage() {}
 
-func (x *TimeseriesChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[5]
+func (x *ExportTimeseriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,78 +1336,94 @@ func (x *TimeseriesChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeseriesChannel.ProtoReflect.Descriptor instead.
-func (*TimeseriesChannel) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExportTimeseriesRequest.ProtoReflect.Descriptor instead.
+func (*ExportTimeseriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TimeseriesChannel) GetId() string {
+func (x *ExportTimeseriesRequest) GetDatasetId() string {
 	if x != nil {
-		return x.Id
+		return x.DatasetId
 	}
 	return ""
 }
 
-func (x *TimeseriesChannel) GetName() string {
+func (x *ExportTimeseriesRequest) GetPackageId() string {
 	if x != nil {
-		return x.Name
+		return x.PackageId
 	}
 	return ""
 }
 
-func (x *TimeseriesChannel) GetStartTime() uint64 {
+func (x *ExportTimeseriesRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *ExportTimeseriesRequest) GetStartTime() float64 {
 	if x != nil {
 		return x.StartTime
 	}
 	return 0
 }
 
-func (x *TimeseriesChannel) GetEndTime() uint64 {
+func (x *ExportTimeseriesRequest) GetEndTime() float64 {
 	if x != nil {
 		return x.EndTime
 	}
 	return 0
 }
 
-func (x *TimeseriesChannel) GetUnit() string {
+func (x *ExportTimeseriesRequest) GetRelativeTime() bool {
 	if x != nil {
-		return x.Unit
+		return x.RelativeTime
 	}
-	return ""
+	return false
 }
 
-func (x *TimeseriesChannel) GetRate() float32 {
+func (x *ExportTimeseriesRequest) GetFormat() ExportTimeseriesRequest_Format {
 	if x != nil {
-		return x.Rate
+		return x.Format
 	}
-	return 0
+	return ExportTimeseriesRequest_CSV
 }
 
-type GetTimeseriesChannelsResponse struct {
+func (x *ExportTimeseriesRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+type ExportTimeseriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channel []*TimeseriesChannel `protobuf:"bytes,1,rep,name=channel,proto3" json:"channel,omitempty"`
+	Status  string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Files   []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`      // Files written by the export
+	Samples int64    `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"` // Number of exported samples across all channels
 }
 
-func (x *GetTimeseriesChannelsResponse) Reset() {
-	*x = GetTimeseriesChannelsResponse{}
+func (x *ExportTimeseriesResponse) Reset() {
+	*x = ExportTimeseriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[6]
+		mi := &file_api_v1_agent_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimeseriesChannelsResponse) String() string {
+func (x *ExportTimeseriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesChannelsResponse) ProtoMessage() {}
+func (*ExportTimeseriesResponse) ProtoMessage() {}
 
-func (x *GetTimeseriesChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[6]
+func (x *ExportTimeseriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,49 +1434,58 @@ func (x *GetTimeseriesChannelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesChannelsResponse.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportTimeseriesResponse.ProtoReflect.Descriptor instead.
+func (*ExportTimeseriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTimeseriesChannelsResponse) GetChannel() []*TimeseriesChannel {
+func (x *ExportTimeseriesResponse) GetStatus() string {
 	if x != nil {
-		return x.Channel
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportTimeseriesResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
 	}
 	return nil
 }
 
-type GetTimeseriesRangeRequest struct {
+func (x *ExportTimeseriesResponse) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type PinTimeseriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId    string   `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	PackageId    string   `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	ChannelIds   []string `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
-	StartTime    float32  `protobuf:"fixed32,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime      float32  `protobuf:"fixed32,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	Refresh      bool     `protobuf:"varint,6,opt,name=refresh,proto3" json:"refresh,omitempty"`
-	RelativeTime bool     `protobuf:"varint,7,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
 }
 
-func (x *GetTimeseriesRangeRequest) Reset() {
-	*x = GetTimeseriesRangeRequest{}
+func (x *PinTimeseriesRequest) Reset() {
+	*x = PinTimeseriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[7]
+		mi := &file_api_v1_agent_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimeseriesRangeRequest) String() string {
+func (x *PinTimeseriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesRangeRequest) ProtoMessage() {}
+func (*PinTimeseriesRequest) ProtoMessage() {}
 
-func (x *GetTimeseriesRangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[7]
+func (x *PinTimeseriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,91 +1496,118 @@ func (x *GetTimeseriesRangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesRangeRequest.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesRangeRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PinTimeseriesRequest.ProtoReflect.Descriptor instead.
+func (*PinTimeseriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetTimeseriesRangeRequest) GetDatasetId() string {
+func (x *PinTimeseriesRequest) GetDatasetId() string {
 	if x != nil {
 		return x.DatasetId
 	}
 	return ""
 }
 
-func (x *GetTimeseriesRangeRequest) GetPackageId() string {
+func (x *PinTimeseriesRequest) GetPackageId() string {
 	if x != nil {
 		return x.PackageId
 	}
 	return ""
 }
 
-func (x *GetTimeseriesRangeRequest) GetChannelIds() []string {
-	if x != nil {
-		return x.ChannelIds
+type PinTimeseriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Blocks     int64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`         // Number of blocks of the package
+	Downloaded int64  `protobuf:"varint,3,opt,name=downloaded,proto3" json:"downloaded,omitempty"` // Number of blocks that were downloaded
+}
+
+func (x *PinTimeseriesResponse) Reset() {
+	*x = PinTimeseriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetTimeseriesRangeRequest) GetStartTime() float32 {
-	if x != nil {
-		return x.StartTime
+func (x *PinTimeseriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinTimeseriesResponse) ProtoMessage() {}
+
+func (x *PinTimeseriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetTimeseriesRangeRequest) GetEndTime() float32 {
+// Deprecated: Use PinTimeseriesResponse.ProtoReflect.Descriptor instead.
+func (*PinTimeseriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PinTimeseriesResponse) GetStatus() string {
 	if x != nil {
-		return x.EndTime
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTimeseriesRangeRequest) GetRefresh() bool {
+func (x *PinTimeseriesResponse) GetBlocks() int64 {
 	if x != nil {
-		return x.Refresh
+		return x.Blocks
 	}
-	return false
+	return 0
 }
 
-func (x *GetTimeseriesRangeRequest) GetRelativeTime() bool {
+func (x *PinTimeseriesResponse) GetDownloaded() int64 {
 	if x != nil {
-		return x.RelativeTime
+		return x.Downloaded
 	}
-	return false
+	return 0
 }
 
-type GetTimeseriesRangeResponse struct {
+type GetTimeseriesGapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type GetTimeseriesRangeResponse_MessageType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.GetTimeseriesRangeResponse_MessageType" json:"type,omitempty"`
-	// Types that are assignable to MessageData:
-	//
-	//	*GetTimeseriesRangeResponse_Error
-	//	*GetTimeseriesRangeResponse_Data
-	//	*GetTimeseriesRangeResponse_Channel
-	MessageData isGetTimeseriesRangeResponse_MessageData `protobuf_oneof:"message_data"`
+	DatasetId    string   `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId    string   `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	ChannelIds   []string `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"` // Channels to check; all channels if empty
+	StartTime    float64  `protobuf:"fixed64,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      float64  `protobuf:"fixed64,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	RelativeTime bool     `protobuf:"varint,6,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
 }
 
-func (x *GetTimeseriesRangeResponse) Reset() {
-	*x = GetTimeseriesRangeResponse{}
+func (x *GetTimeseriesGapsRequest) Reset() {
+	*x = GetTimeseriesGapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[8]
+		mi := &file_api_v1_agent_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimeseriesRangeResponse) String() string {
+func (x *GetTimeseriesGapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesRangeResponse) ProtoMessage() {}
+func (*GetTimeseriesGapsRequest) ProtoMessage() {}
 
-func (x *GetTimeseriesRangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[8]
+func (x *GetTimeseriesGapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,100 +1618,132 @@ func (x *GetTimeseriesRangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesRangeResponse.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesRangeResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetTimeseriesGapsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesGapsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetTimeseriesRangeResponse) GetType() GetTimeseriesRangeResponse_MessageType {
+func (x *GetTimeseriesGapsRequest) GetDatasetId() string {
 	if x != nil {
-		return x.Type
+		return x.DatasetId
 	}
-	return GetTimeseriesRangeResponse_RANGE_STATUS
+	return ""
 }
 
-func (m *GetTimeseriesRangeResponse) GetMessageData() isGetTimeseriesRangeResponse_MessageData {
-	if m != nil {
-		return m.MessageData
+func (x *GetTimeseriesGapsRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTimeseriesRangeResponse) GetError() *GetTimeseriesRangeResponse_ErrorData {
-	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Error); ok {
-		return x.Error
+func (x *GetTimeseriesGapsRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
 	}
 	return nil
 }
 
-func (x *GetTimeseriesRangeResponse) GetData() *GetTimeseriesRangeResponse_RangeData {
-	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Data); ok {
-		return x.Data
+func (x *GetTimeseriesGapsRequest) GetStartTime() float64 {
+	if x != nil {
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTimeseriesRangeResponse) GetChannel() *GetTimeseriesRangeResponse_ChannelInfo {
-	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Channel); ok {
-		return x.Channel
+func (x *GetTimeseriesGapsRequest) GetEndTime() float64 {
+	if x != nil {
+		return x.EndTime
 	}
-	return nil
+	return 0
 }
 
-type isGetTimeseriesRangeResponse_MessageData interface {
-	isGetTimeseriesRangeResponse_MessageData()
+func (x *GetTimeseriesGapsRequest) GetRelativeTime() bool {
+	if x != nil {
+		return x.RelativeTime
+	}
+	return false
 }
 
-type GetTimeseriesRangeResponse_Error struct {
-	Error *GetTimeseriesRangeResponse_ErrorData `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
+type GetTimeseriesGapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type GetTimeseriesRangeResponse_Data struct {
-	Data *GetTimeseriesRangeResponse_RangeData `protobuf:"bytes,3,opt,name=data,proto3,oneof"`
+	Channels []*GetTimeseriesGapsResponse_ChannelGaps `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-type GetTimeseriesRangeResponse_Channel struct {
-	Channel *GetTimeseriesRangeResponse_ChannelInfo `protobuf:"bytes,4,opt,name=channel,proto3,oneof"`
+func (x *GetTimeseriesGapsResponse) Reset() {
+	*x = GetTimeseriesGapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*GetTimeseriesRangeResponse_Error) isGetTimeseriesRangeResponse_MessageData() {}
+func (x *GetTimeseriesGapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*GetTimeseriesRangeResponse_Data) isGetTimeseriesRangeResponse_MessageData() {}
+func (*GetTimeseriesGapsResponse) ProtoMessage() {}
 
-func (*GetTimeseriesRangeResponse_Channel) isGetTimeseriesRangeResponse_MessageData() {}
+func (x *GetTimeseriesGapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type SubscribeResponse struct {
+// Deprecated: Use GetTimeseriesGapsResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesGapsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTimeseriesGapsResponse) GetChannels() []*GetTimeseriesGapsResponse_ChannelGaps {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type GetTimeseriesAnnotationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type SubscribeResponse_MessageType `protobuf:"varint,8,opt,name=type,proto3,enum=v1.SubscribeResponse_MessageType" json:"type,omitempty"`
-	// Types that are assignable to MessageData:
-	//
-	//	*SubscribeResponse_UploadStatus
-	//	*SubscribeResponse_EventInfo
-	//	*SubscribeResponse_SyncStatus
-	//	*SubscribeResponse_DownloadStatus
-	MessageData isSubscribeResponse_MessageData `protobuf_oneof:"message_data"`
+	DatasetId    string   `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId    string   `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	LayerIds     []int64  `protobuf:"varint,3,rep,packed,name=layer_ids,json=layerIds,proto3" json:"layer_ids,omitempty"` // Layers to return; all layers if empty
+	ChannelIds   []string `protobuf:"bytes,4,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`   // Only annotations on any of these channels; all annotations if empty
+	StartTime    float64  `protobuf:"fixed64,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      float64  `protobuf:"fixed64,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` // Until the end of the recording if not after start_time
+	RelativeTime bool     `protobuf:"varint,7,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
+	Refresh      bool     `protobuf:"varint,8,opt,name=refresh,proto3" json:"refresh,omitempty"`
 }
 
-func (x *SubscribeResponse) Reset() {
-	*x = SubscribeResponse{}
+func (x *GetTimeseriesAnnotationsRequest) Reset() {
+	*x = GetTimeseriesAnnotationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[9]
+		mi := &file_api_v1_agent_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeResponse) String() string {
+func (x *GetTimeseriesAnnotationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse) ProtoMessage() {}
+func (*GetTimeseriesAnnotationsRequest) ProtoMessage() {}
 
-func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[9]
+func (x *GetTimeseriesAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,106 +1754,94 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTimeseriesAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SubscribeResponse) GetType() SubscribeResponse_MessageType {
+func (x *GetTimeseriesAnnotationsRequest) GetDatasetId() string {
 	if x != nil {
-		return x.Type
+		return x.DatasetId
 	}
-	return SubscribeResponse_EVENT
+	return ""
 }
 
-func (m *SubscribeResponse) GetMessageData() isSubscribeResponse_MessageData {
-	if m != nil {
-		return m.MessageData
+func (x *GetTimeseriesAnnotationsRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
 	}
-	return nil
+	return ""
 }
 
-func (x *SubscribeResponse) GetUploadStatus() *SubscribeResponse_UploadResponse {
-	if x, ok := x.GetMessageData().(*SubscribeResponse_UploadStatus); ok {
-		return x.UploadStatus
+func (x *GetTimeseriesAnnotationsRequest) GetLayerIds() []int64 {
+	if x != nil {
+		return x.LayerIds
 	}
 	return nil
 }
 
-func (x *SubscribeResponse) GetEventInfo() *SubscribeResponse_EventResponse {
-	if x, ok := x.GetMessageData().(*SubscribeResponse_EventInfo); ok {
-		return x.EventInfo
+func (x *GetTimeseriesAnnotationsRequest) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
 	}
 	return nil
 }
 
-func (x *SubscribeResponse) GetSyncStatus() *SubscribeResponse_SyncResponse {
-	if x, ok := x.GetMessageData().(*SubscribeResponse_SyncStatus); ok {
-		return x.SyncStatus
+func (x *GetTimeseriesAnnotationsRequest) GetStartTime() float64 {
+	if x != nil {
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (x *SubscribeResponse) GetDownloadStatus() *SubscribeResponse_DownloadStatusResponse {
-	if x, ok := x.GetMessageData().(*SubscribeResponse_DownloadStatus); ok {
-		return x.DownloadStatus
+func (x *GetTimeseriesAnnotationsRequest) GetEndTime() float64 {
+	if x != nil {
+		return x.EndTime
 	}
-	return nil
-}
-
-type isSubscribeResponse_MessageData interface {
-	isSubscribeResponse_MessageData()
-}
-
-type SubscribeResponse_UploadStatus struct {
-	UploadStatus *SubscribeResponse_UploadResponse `protobuf:"bytes,9,opt,name=upload_status,json=uploadStatus,proto3,oneof"`
-}
-
-type SubscribeResponse_EventInfo struct {
-	EventInfo *SubscribeResponse_EventResponse `protobuf:"bytes,10,opt,name=event_info,json=eventInfo,proto3,oneof"`
+	return 0
 }
 
-type SubscribeResponse_SyncStatus struct {
-	SyncStatus *SubscribeResponse_SyncResponse `protobuf:"bytes,11,opt,name=sync_status,json=syncStatus,proto3,oneof"`
+func (x *GetTimeseriesAnnotationsRequest) GetRelativeTime() bool {
+	if x != nil {
+		return x.RelativeTime
+	}
+	return false
 }
 
-type SubscribeResponse_DownloadStatus struct {
-	DownloadStatus *SubscribeResponse_DownloadStatusResponse `protobuf:"bytes,12,opt,name=download_status,json=downloadStatus,proto3,oneof"`
+func (x *GetTimeseriesAnnotationsRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
 }
 
-func (*SubscribeResponse_UploadStatus) isSubscribeResponse_MessageData() {}
-
-func (*SubscribeResponse_EventInfo) isSubscribeResponse_MessageData() {}
-
-func (*SubscribeResponse_SyncStatus) isSubscribeResponse_MessageData() {}
-
-func (*SubscribeResponse_DownloadStatus) isSubscribeResponse_MessageData() {}
-
-type SimpleStatusResponse struct {
+type GetTimeseriesAnnotationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Layers      []*GetTimeseriesAnnotationsResponse_Layer      `protobuf:"bytes,1,rep,name=layers,proto3" json:"layers,omitempty"`
+	Annotations []*GetTimeseriesAnnotationsResponse_Annotation `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	Offline     bool                                           `protobuf:"varint,3,opt,name=offline,proto3" json:"offline,omitempty"` // Annotations were read from the local cache as Pennsieve could not be reached
 }
 
-func (x *SimpleStatusResponse) Reset() {
-	*x = SimpleStatusResponse{}
+func (x *GetTimeseriesAnnotationsResponse) Reset() {
+	*x = GetTimeseriesAnnotationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[10]
+		mi := &file_api_v1_agent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleStatusResponse) String() string {
+func (x *GetTimeseriesAnnotationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleStatusResponse) ProtoMessage() {}
+func (*GetTimeseriesAnnotationsResponse) ProtoMessage() {}
 
-func (x *SimpleStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[10]
+func (x *GetTimeseriesAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,44 +1852,59 @@ func (x *SimpleStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleStatusResponse.ProtoReflect.Descriptor instead.
-func (*SimpleStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetTimeseriesAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SimpleStatusResponse) GetStatus() string {
+func (x *GetTimeseriesAnnotationsResponse) GetLayers() []*GetTimeseriesAnnotationsResponse_Layer {
 	if x != nil {
-		return x.Status
+		return x.Layers
 	}
-	return ""
+	return nil
 }
 
-type CancelUploadRequest struct {
+func (x *GetTimeseriesAnnotationsResponse) GetAnnotations() []*GetTimeseriesAnnotationsResponse_Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *GetTimeseriesAnnotationsResponse) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+type GetTimeseriesChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	CancelAll  bool  `protobuf:"varint,2,opt,name=cancel_all,json=cancelAll,proto3" json:"cancel_all,omitempty"`
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Refresh   bool   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
 }
 
-func (x *CancelUploadRequest) Reset() {
-	*x = CancelUploadRequest{}
+func (x *GetTimeseriesChannelsRequest) Reset() {
+	*x = GetTimeseriesChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[11]
+		mi := &file_api_v1_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelUploadRequest) String() string {
+func (x *GetTimeseriesChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelUploadRequest) ProtoMessage() {}
+func (*GetTimeseriesChannelsRequest) ProtoMessage() {}
 
-func (x *CancelUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[11]
+func (x *GetTimeseriesChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,51 +1915,65 @@ func (x *CancelUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelUploadRequest.ProtoReflect.Descriptor instead.
-func (*CancelUploadRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetTimeseriesChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CancelUploadRequest) GetManifestId() int32 {
+func (x *GetTimeseriesChannelsRequest) GetDatasetId() string {
 	if x != nil {
-		return x.ManifestId
+		return x.DatasetId
 	}
-	return 0
+	return ""
 }
 
-func (x *CancelUploadRequest) GetCancelAll() bool {
+func (x *GetTimeseriesChannelsRequest) GetPackageId() string {
 	if x != nil {
-		return x.CancelAll
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesChannelsRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
 	}
 	return false
 }
 
-type CancelDownloadRequest struct {
+type TimeseriesChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	CancelAll bool    `protobuf:"varint,2,opt,name=cancel_all,json=cancelAll,proto3" json:"cancel_all,omitempty"`
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime  uint64            `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    uint64            `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Unit       string            `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Rate       float32           `protobuf:"fixed32,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	Group      string            `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	Type       string            `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Properties map[string]string `protobuf:"bytes,9,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Channel settings, such as the reference and filters
 }
 
-func (x *CancelDownloadRequest) Reset() {
-	*x = CancelDownloadRequest{}
+func (x *TimeseriesChannel) Reset() {
+	*x = TimeseriesChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[12]
+		mi := &file_api_v1_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelDownloadRequest) String() string {
+func (x *TimeseriesChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDownloadRequest) ProtoMessage() {}
+func (*TimeseriesChannel) ProtoMessage() {}
 
-func (x *CancelDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[12]
+func (x *TimeseriesChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,122 +1984,99 @@ func (x *CancelDownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDownloadRequest.ProtoReflect.Descriptor instead.
-func (*CancelDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TimeseriesChannel.ProtoReflect.Descriptor instead.
+func (*TimeseriesChannel) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CancelDownloadRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *TimeseriesChannel) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CancelDownloadRequest) GetCancelAll() bool {
+func (x *TimeseriesChannel) GetName() string {
 	if x != nil {
-		return x.CancelAll
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type CreateManifestRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BasePath       string   `protobuf:"bytes,1,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"`
-	TargetBasePath string   `protobuf:"bytes,2,opt,name=target_base_path,json=targetBasePath,proto3" json:"target_base_path,omitempty"`
-	Recursive      bool     `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Files          []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+func (x *TimeseriesChannel) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
 }
 
-func (x *CreateManifestRequest) Reset() {
-	*x = CreateManifestRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TimeseriesChannel) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
 	}
+	return 0
 }
 
-func (x *CreateManifestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimeseriesChannel) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
 }
 
-func (*CreateManifestRequest) ProtoMessage() {}
-
-func (x *CreateManifestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateManifestRequest.ProtoReflect.Descriptor instead.
-func (*CreateManifestRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *CreateManifestRequest) GetBasePath() string {
+func (x *TimeseriesChannel) GetRate() float32 {
 	if x != nil {
-		return x.BasePath
+		return x.Rate
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateManifestRequest) GetTargetBasePath() string {
+func (x *TimeseriesChannel) GetGroup() string {
 	if x != nil {
-		return x.TargetBasePath
+		return x.Group
 	}
 	return ""
 }
 
-func (x *CreateManifestRequest) GetRecursive() bool {
+func (x *TimeseriesChannel) GetType() string {
 	if x != nil {
-		return x.Recursive
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *CreateManifestRequest) GetFiles() []string {
+func (x *TimeseriesChannel) GetProperties() map[string]string {
 	if x != nil {
-		return x.Files
+		return x.Properties
 	}
 	return nil
 }
 
-type CreateManifestResponse struct {
+type GetTimeseriesChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Channel []*TimeseriesChannel `protobuf:"bytes,1,rep,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (x *CreateManifestResponse) Reset() {
-	*x = CreateManifestResponse{}
+func (x *GetTimeseriesChannelsResponse) Reset() {
+	*x = GetTimeseriesChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[14]
+		mi := &file_api_v1_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateManifestResponse) String() string {
+func (x *GetTimeseriesChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateManifestResponse) ProtoMessage() {}
+func (*GetTimeseriesChannelsResponse) ProtoMessage() {}
 
-func (x *CreateManifestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[14]
+func (x *GetTimeseriesChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,54 +2087,56 @@ func (x *CreateManifestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateManifestResponse.ProtoReflect.Descriptor instead.
-func (*CreateManifestResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CreateManifestResponse) GetManifestId() int32 {
-	if x != nil {
-		return x.ManifestId
-	}
-	return 0
+// Deprecated: Use GetTimeseriesChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateManifestResponse) GetMessage() string {
+func (x *GetTimeseriesChannelsResponse) GetChannel() []*TimeseriesChannel {
 	if x != nil {
-		return x.Message
+		return x.Channel
 	}
-	return ""
+	return nil
 }
 
-type AddToManifestRequest struct {
+type GetTimeseriesRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId     int32    `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	BasePath       string   `protobuf:"bytes,2,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"`
-	TargetBasePath string   `protobuf:"bytes,3,opt,name=target_base_path,json=targetBasePath,proto3" json:"target_base_path,omitempty"`
-	Recursive      bool     `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Files          []string `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	DatasetId        string                                              `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	PackageId        string                                              `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	ChannelIds       []string                                            `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	StartTime        float32                                             `protobuf:"fixed32,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          float32                                             `protobuf:"fixed32,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Refresh          bool                                                `protobuf:"varint,6,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	RelativeTime     bool                                                `protobuf:"varint,7,opt,name=relative_time,json=relativeTime,proto3" json:"relative_time,omitempty"`
+	ReadAhead        bool                                                `protobuf:"varint,8,opt,name=read_ahead,json=readAhead,proto3" json:"read_ahead,omitempty"`            // Download the next time window into the cache after the request
+	PixelWidth       uint32                                              `protobuf:"varint,9,opt,name=pixel_width,json=pixelWidth,proto3" json:"pixel_width,omitempty"`         // Target number of points per channel for the requested range; 0 is full resolution
+	SamplePeriod     float64                                             `protobuf:"fixed64,10,opt,name=sample_period,json=samplePeriod,proto3" json:"sample_period,omitempty"` // Target period between points in microseconds; 0 is full resolution
+	Decimation       GetTimeseriesRangeRequest_DecimationMethod          `protobuf:"varint,11,opt,name=decimation,proto3,enum=v1.GetTimeseriesRangeRequest_DecimationMethod" json:"decimation,omitempty"`
+	Offline          bool                                                `protobuf:"varint,12,opt,name=offline,proto3" json:"offline,omitempty"`                                                                                                                                                                                    // Only use blocks from the local cache
+	Encoding         GetTimeseriesRangeRequest_SampleEncoding            `protobuf:"varint,13,opt,name=encoding,proto3,enum=v1.GetTimeseriesRangeRequest_SampleEncoding" json:"encoding,omitempty"`                                                                                                                                 // Encoding of the data of all channels
+	ChannelEncodings map[string]GetTimeseriesRangeRequest_SampleEncoding `protobuf:"bytes,14,rep,name=channel_encodings,json=channelEncodings,proto3" json:"channel_encodings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=v1.GetTimeseriesRangeRequest_SampleEncoding"` // Encoding per channel id, overriding encoding
 }
 
-func (x *AddToManifestRequest) Reset() {
-	*x = AddToManifestRequest{}
+func (x *GetTimeseriesRangeRequest) Reset() {
+	*x = GetTimeseriesRangeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[15]
+		mi := &file_api_v1_agent_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddToManifestRequest) String() string {
+func (x *GetTimeseriesRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddToManifestRequest) ProtoMessage() {}
+func (*GetTimeseriesRangeRequest) ProtoMessage() {}
 
-func (x *AddToManifestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[15]
+func (x *GetTimeseriesRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,150 +2147,127 @@ func (x *AddToManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddToManifestRequest.ProtoReflect.Descriptor instead.
-func (*AddToManifestRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetTimeseriesRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AddToManifestRequest) GetManifestId() int32 {
+func (x *GetTimeseriesRangeRequest) GetDatasetId() string {
 	if x != nil {
-		return x.ManifestId
+		return x.DatasetId
 	}
-	return 0
+	return ""
 }
 
-func (x *AddToManifestRequest) GetBasePath() string {
+func (x *GetTimeseriesRangeRequest) GetPackageId() string {
 	if x != nil {
-		return x.BasePath
+		return x.PackageId
 	}
 	return ""
 }
 
-func (x *AddToManifestRequest) GetTargetBasePath() string {
+func (x *GetTimeseriesRangeRequest) GetChannelIds() []string {
 	if x != nil {
-		return x.TargetBasePath
+		return x.ChannelIds
 	}
-	return ""
+	return nil
 }
 
-func (x *AddToManifestRequest) GetRecursive() bool {
+func (x *GetTimeseriesRangeRequest) GetStartTime() float32 {
 	if x != nil {
-		return x.Recursive
+		return x.StartTime
 	}
-	return false
+	return 0
 }
 
-func (x *AddToManifestRequest) GetFiles() []string {
+func (x *GetTimeseriesRangeRequest) GetEndTime() float32 {
 	if x != nil {
-		return x.Files
+		return x.EndTime
 	}
-	return nil
-}
-
-type RemoveFromManifestRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	RemovePath string `protobuf:"bytes,3,opt,name=remove_path,json=removePath,proto3" json:"remove_path,omitempty"`
+	return 0
 }
 
-func (x *RemoveFromManifestRequest) Reset() {
-	*x = RemoveFromManifestRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTimeseriesRangeRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
 	}
+	return false
 }
 
-func (x *RemoveFromManifestRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RemoveFromManifestRequest) ProtoMessage() {}
-
-func (x *RemoveFromManifestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTimeseriesRangeRequest) GetRelativeTime() bool {
+	if x != nil {
+		return x.RelativeTime
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use RemoveFromManifestRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFromManifestRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{16}
+func (x *GetTimeseriesRangeRequest) GetReadAhead() bool {
+	if x != nil {
+		return x.ReadAhead
+	}
+	return false
 }
 
-func (x *RemoveFromManifestRequest) GetManifestId() int32 {
+func (x *GetTimeseriesRangeRequest) GetPixelWidth() uint32 {
 	if x != nil {
-		return x.ManifestId
+		return x.PixelWidth
 	}
 	return 0
 }
 
-func (x *RemoveFromManifestRequest) GetRemovePath() string {
+func (x *GetTimeseriesRangeRequest) GetSamplePeriod() float64 {
 	if x != nil {
-		return x.RemovePath
+		return x.SamplePeriod
 	}
-	return ""
-}
-
-type VersionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *VersionRequest) Reset() {
-	*x = VersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTimeseriesRangeRequest) GetDecimation() GetTimeseriesRangeRequest_DecimationMethod {
+	if x != nil {
+		return x.Decimation
 	}
+	return GetTimeseriesRangeRequest_MIN_MAX
 }
 
-func (x *VersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTimeseriesRangeRequest) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
 }
 
-func (*VersionRequest) ProtoMessage() {}
-
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTimeseriesRangeRequest) GetEncoding() GetTimeseriesRangeRequest_SampleEncoding {
+	if x != nil {
+		return x.Encoding
 	}
-	return mi.MessageOf(x)
+	return GetTimeseriesRangeRequest_FLOAT32
 }
 
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{17}
+func (x *GetTimeseriesRangeRequest) GetChannelEncodings() map[string]GetTimeseriesRangeRequest_SampleEncoding {
+	if x != nil {
+		return x.ChannelEncodings
+	}
+	return nil
 }
 
-type VersionResponse struct {
+type GetTimeseriesRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version  string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	LogLevel string `protobuf:"bytes,2,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	Type GetTimeseriesRangeResponse_MessageType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.GetTimeseriesRangeResponse_MessageType" json:"type,omitempty"`
+	// Types that are assignable to MessageData:
+	//
+	//	*GetTimeseriesRangeResponse_Error
+	//	*GetTimeseriesRangeResponse_Data
+	//	*GetTimeseriesRangeResponse_Channel
+	//	*GetTimeseriesRangeResponse_Gap
+	//	*GetTimeseriesRangeResponse_Status
+	MessageData isGetTimeseriesRangeResponse_MessageData `protobuf_oneof:"message_data"`
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
+func (x *GetTimeseriesRangeResponse) Reset() {
+	*x = GetTimeseriesRangeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1762,13 +2275,13 @@ func (x *VersionResponse) Reset() {
 	}
 }
 
-func (x *VersionResponse) String() string {
+func (x *GetTimeseriesRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*GetTimeseriesRangeResponse) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTimeseriesRangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1780,33 +2293,112 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTimeseriesRangeResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *VersionResponse) GetVersion() string {
+func (x *GetTimeseriesRangeResponse) GetType() GetTimeseriesRangeResponse_MessageType {
 	if x != nil {
-		return x.Version
+		return x.Type
 	}
-	return ""
+	return GetTimeseriesRangeResponse_RANGE_STATUS
 }
 
-func (x *VersionResponse) GetLogLevel() string {
-	if x != nil {
-		return x.LogLevel
+func (m *GetTimeseriesRangeResponse) GetMessageData() isGetTimeseriesRangeResponse_MessageData {
+	if m != nil {
+		return m.MessageData
 	}
-	return ""
+	return nil
 }
 
-type PingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+func (x *GetTimeseriesRangeResponse) GetError() *GetTimeseriesRangeResponse_ErrorData {
+	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
 
-func (x *PingRequest) Reset() {
-	*x = PingRequest{}
+func (x *GetTimeseriesRangeResponse) GetData() *GetTimeseriesRangeResponse_RangeData {
+	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse) GetChannel() *GetTimeseriesRangeResponse_ChannelInfo {
+	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Channel); ok {
+		return x.Channel
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse) GetGap() *GetTimeseriesRangeResponse_GapData {
+	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Gap); ok {
+		return x.Gap
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse) GetStatus() *GetTimeseriesRangeResponse_RangeStatus {
+	if x, ok := x.GetMessageData().(*GetTimeseriesRangeResponse_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+type isGetTimeseriesRangeResponse_MessageData interface {
+	isGetTimeseriesRangeResponse_MessageData()
+}
+
+type GetTimeseriesRangeResponse_Error struct {
+	Error *GetTimeseriesRangeResponse_ErrorData `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type GetTimeseriesRangeResponse_Data struct {
+	Data *GetTimeseriesRangeResponse_RangeData `protobuf:"bytes,3,opt,name=data,proto3,oneof"`
+}
+
+type GetTimeseriesRangeResponse_Channel struct {
+	Channel *GetTimeseriesRangeResponse_ChannelInfo `protobuf:"bytes,4,opt,name=channel,proto3,oneof"`
+}
+
+type GetTimeseriesRangeResponse_Gap struct {
+	Gap *GetTimeseriesRangeResponse_GapData `protobuf:"bytes,5,opt,name=gap,proto3,oneof"`
+}
+
+type GetTimeseriesRangeResponse_Status struct {
+	Status *GetTimeseriesRangeResponse_RangeStatus `protobuf:"bytes,6,opt,name=status,proto3,oneof"`
+}
+
+func (*GetTimeseriesRangeResponse_Error) isGetTimeseriesRangeResponse_MessageData() {}
+
+func (*GetTimeseriesRangeResponse_Data) isGetTimeseriesRangeResponse_MessageData() {}
+
+func (*GetTimeseriesRangeResponse_Channel) isGetTimeseriesRangeResponse_MessageData() {}
+
+func (*GetTimeseriesRangeResponse_Gap) isGetTimeseriesRangeResponse_MessageData() {}
+
+func (*GetTimeseriesRangeResponse_Status) isGetTimeseriesRangeResponse_MessageData() {}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SubscribeResponse_MessageType `protobuf:"varint,8,opt,name=type,proto3,enum=v1.SubscribeResponse_MessageType" json:"type,omitempty"`
+	// Types that are assignable to MessageData:
+	//
+	//	*SubscribeResponse_UploadStatus
+	//	*SubscribeResponse_EventInfo
+	//	*SubscribeResponse_SyncStatus
+	//	*SubscribeResponse_DownloadStatus
+	//	*SubscribeResponse_WorkflowStatus
+	MessageData isSubscribeResponse_MessageData `protobuf_oneof:"message_data"`
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1814,13 +2406,13 @@ func (x *PingRequest) Reset() {
 	}
 }
 
-func (x *PingRequest) String() string {
+func (x *SubscribeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingRequest) ProtoMessage() {}
+func (*SubscribeResponse) ProtoMessage() {}
 
-func (x *PingRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1832,21 +2424,104 @@ func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
-func (*PingRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{19}
 }
 
-type PingResponse struct {
+func (x *SubscribeResponse) GetType() SubscribeResponse_MessageType {
+	if x != nil {
+		return x.Type
+	}
+	return SubscribeResponse_EVENT
+}
+
+func (m *SubscribeResponse) GetMessageData() isSubscribeResponse_MessageData {
+	if m != nil {
+		return m.MessageData
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetUploadStatus() *SubscribeResponse_UploadResponse {
+	if x, ok := x.GetMessageData().(*SubscribeResponse_UploadStatus); ok {
+		return x.UploadStatus
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetEventInfo() *SubscribeResponse_EventResponse {
+	if x, ok := x.GetMessageData().(*SubscribeResponse_EventInfo); ok {
+		return x.EventInfo
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetSyncStatus() *SubscribeResponse_SyncResponse {
+	if x, ok := x.GetMessageData().(*SubscribeResponse_SyncStatus); ok {
+		return x.SyncStatus
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetDownloadStatus() *SubscribeResponse_DownloadStatusResponse {
+	if x, ok := x.GetMessageData().(*SubscribeResponse_DownloadStatus); ok {
+		return x.DownloadStatus
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetWorkflowStatus() *SubscribeResponse_WorkflowStatusResponse {
+	if x, ok := x.GetMessageData().(*SubscribeResponse_WorkflowStatus); ok {
+		return x.WorkflowStatus
+	}
+	return nil
+}
+
+type isSubscribeResponse_MessageData interface {
+	isSubscribeResponse_MessageData()
+}
+
+type SubscribeResponse_UploadStatus struct {
+	UploadStatus *SubscribeResponse_UploadResponse `protobuf:"bytes,9,opt,name=upload_status,json=uploadStatus,proto3,oneof"`
+}
+
+type SubscribeResponse_EventInfo struct {
+	EventInfo *SubscribeResponse_EventResponse `protobuf:"bytes,10,opt,name=event_info,json=eventInfo,proto3,oneof"`
+}
+
+type SubscribeResponse_SyncStatus struct {
+	SyncStatus *SubscribeResponse_SyncResponse `protobuf:"bytes,11,opt,name=sync_status,json=syncStatus,proto3,oneof"`
+}
+
+type SubscribeResponse_DownloadStatus struct {
+	DownloadStatus *SubscribeResponse_DownloadStatusResponse `protobuf:"bytes,12,opt,name=download_status,json=downloadStatus,proto3,oneof"`
+}
+
+type SubscribeResponse_WorkflowStatus struct {
+	WorkflowStatus *SubscribeResponse_WorkflowStatusResponse `protobuf:"bytes,13,opt,name=workflow_status,json=workflowStatus,proto3,oneof"`
+}
+
+func (*SubscribeResponse_UploadStatus) isSubscribeResponse_MessageData() {}
+
+func (*SubscribeResponse_EventInfo) isSubscribeResponse_MessageData() {}
+
+func (*SubscribeResponse_SyncStatus) isSubscribeResponse_MessageData() {}
+
+func (*SubscribeResponse_DownloadStatus) isSubscribeResponse_MessageData() {}
+
+func (*SubscribeResponse_WorkflowStatus) isSubscribeResponse_MessageData() {}
+
+type SimpleStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *PingResponse) Reset() {
-	*x = PingResponse{}
+func (x *SimpleStatusResponse) Reset() {
+	*x = SimpleStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1854,13 +2529,13 @@ func (x *PingResponse) Reset() {
 	}
 }
 
-func (x *PingResponse) String() string {
+func (x *SimpleStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingResponse) ProtoMessage() {}
+func (*SimpleStatusResponse) ProtoMessage() {}
 
-func (x *PingResponse) ProtoReflect() protoreflect.Message {
+func (x *SimpleStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1872,26 +2547,29 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
-func (*PingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimpleStatusResponse.ProtoReflect.Descriptor instead.
+func (*SimpleStatusResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PingResponse) GetSuccess() bool {
+func (x *SimpleStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.Success
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-type StopRequest struct {
+type CancelUploadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	CancelAll  bool  `protobuf:"varint,2,opt,name=cancel_all,json=cancelAll,proto3" json:"cancel_all,omitempty"`
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
+func (x *CancelUploadRequest) Reset() {
+	*x = CancelUploadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1899,13 +2577,13 @@ func (x *StopRequest) Reset() {
 	}
 }
 
-func (x *StopRequest) String() string {
+func (x *CancelUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*CancelUploadRequest) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelUploadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1917,21 +2595,36 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelUploadRequest.ProtoReflect.Descriptor instead.
+func (*CancelUploadRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{21}
 }
 
-type StopResponse struct {
+func (x *CancelUploadRequest) GetManifestId() int32 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *CancelUploadRequest) GetCancelAll() bool {
+	if x != nil {
+		return x.CancelAll
+	}
+	return false
+}
+
+type CancelDownloadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id        *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	CancelAll bool    `protobuf:"varint,2,opt,name=cancel_all,json=cancelAll,proto3" json:"cancel_all,omitempty"`
 }
 
-func (x *StopResponse) Reset() {
-	*x = StopResponse{}
+func (x *CancelDownloadRequest) Reset() {
+	*x = CancelDownloadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1939,13 +2632,13 @@ func (x *StopResponse) Reset() {
 	}
 }
 
-func (x *StopResponse) String() string {
+func (x *CancelDownloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopResponse) ProtoMessage() {}
+func (*CancelDownloadRequest) ProtoMessage() {}
 
-func (x *StopResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelDownloadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,40 +2650,52 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
-func (*StopResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelDownloadRequest.ProtoReflect.Descriptor instead.
+func (*CancelDownloadRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *StopResponse) GetSuccess() bool {
+func (x *CancelDownloadRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *CancelDownloadRequest) GetCancelAll() bool {
 	if x != nil {
-		return x.Success
+		return x.CancelAll
 	}
 	return false
 }
 
-type ListManifestsRequest struct {
+type CreateManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ListManifestsRequest) Reset() {
-	*x = ListManifestsRequest{}
-	if protoimpl.UnsafeEnabled {
+	BasePath       string   `protobuf:"bytes,1,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"`
+	TargetBasePath string   `protobuf:"bytes,2,opt,name=target_base_path,json=targetBasePath,proto3" json:"target_base_path,omitempty"`
+	Recursive      bool     `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Files          []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *CreateManifestRequest) Reset() {
+	*x = CreateManifestRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListManifestsRequest) String() string {
+func (x *CreateManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestsRequest) ProtoMessage() {}
+func (*CreateManifestRequest) ProtoMessage() {}
 
-func (x *ListManifestsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,21 +2707,50 @@ func (x *ListManifestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestsRequest.ProtoReflect.Descriptor instead.
-func (*ListManifestsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateManifestRequest.ProtoReflect.Descriptor instead.
+func (*CreateManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{23}
 }
 
-type ListManifestsResponse struct {
+func (x *CreateManifestRequest) GetBasePath() string {
+	if x != nil {
+		return x.BasePath
+	}
+	return ""
+}
+
+func (x *CreateManifestRequest) GetTargetBasePath() string {
+	if x != nil {
+		return x.TargetBasePath
+	}
+	return ""
+}
+
+func (x *CreateManifestRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *CreateManifestRequest) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type CreateManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Manifests []*ListManifestsResponse_Manifest `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ListManifestsResponse) Reset() {
-	*x = ListManifestsResponse{}
+func (x *CreateManifestResponse) Reset() {
+	*x = CreateManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2024,13 +2758,13 @@ func (x *ListManifestsResponse) Reset() {
 	}
 }
 
-func (x *ListManifestsResponse) String() string {
+func (x *CreateManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestsResponse) ProtoMessage() {}
+func (*CreateManifestResponse) ProtoMessage() {}
 
-func (x *ListManifestsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2042,28 +2776,40 @@ func (x *ListManifestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestsResponse.ProtoReflect.Descriptor instead.
-func (*ListManifestsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateManifestResponse.ProtoReflect.Descriptor instead.
+func (*CreateManifestResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListManifestsResponse) GetManifests() []*ListManifestsResponse_Manifest {
+func (x *CreateManifestResponse) GetManifestId() int32 {
 	if x != nil {
-		return x.Manifests
+		return x.ManifestId
 	}
-	return nil
+	return 0
 }
 
-type DeleteManifestRequest struct {
+func (x *CreateManifestResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddToManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	ManifestId     int32    `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	BasePath       string   `protobuf:"bytes,2,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"`
+	TargetBasePath string   `protobuf:"bytes,3,opt,name=target_base_path,json=targetBasePath,proto3" json:"target_base_path,omitempty"`
+	Recursive      bool     `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Files          []string `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	MetadataPath   string   `protobuf:"bytes,6,opt,name=metadata_path,json=metadataPath,proto3" json:"metadata_path,omitempty"` // CSV or JSON file with key/value metadata of the added files
 }
 
-func (x *DeleteManifestRequest) Reset() {
-	*x = DeleteManifestRequest{}
+func (x *AddToManifestRequest) Reset() {
+	*x = AddToManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2071,13 +2817,13 @@ func (x *DeleteManifestRequest) Reset() {
 	}
 }
 
-func (x *DeleteManifestRequest) String() string {
+func (x *AddToManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteManifestRequest) ProtoMessage() {}
+func (*AddToManifestRequest) ProtoMessage() {}
 
-func (x *DeleteManifestRequest) ProtoReflect() protoreflect.Message {
+func (x *AddToManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2089,30 +2835,64 @@ func (x *DeleteManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteManifestRequest.ProtoReflect.Descriptor instead.
-func (*DeleteManifestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddToManifestRequest.ProtoReflect.Descriptor instead.
+func (*AddToManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteManifestRequest) GetManifestId() int32 {
+func (x *AddToManifestRequest) GetManifestId() int32 {
 	if x != nil {
 		return x.ManifestId
 	}
 	return 0
 }
 
-type ListManifestFilesRequest struct {
+func (x *AddToManifestRequest) GetBasePath() string {
+	if x != nil {
+		return x.BasePath
+	}
+	return ""
+}
+
+func (x *AddToManifestRequest) GetTargetBasePath() string {
+	if x != nil {
+		return x.TargetBasePath
+	}
+	return ""
+}
+
+func (x *AddToManifestRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *AddToManifestRequest) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *AddToManifestRequest) GetMetadataPath() string {
+	if x != nil {
+		return x.MetadataPath
+	}
+	return ""
+}
+
+type RemoveFromManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	Offset     int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit      int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	RemovePath string `protobuf:"bytes,3,opt,name=remove_path,json=removePath,proto3" json:"remove_path,omitempty"`
 }
 
-func (x *ListManifestFilesRequest) Reset() {
-	*x = ListManifestFilesRequest{}
+func (x *RemoveFromManifestRequest) Reset() {
+	*x = RemoveFromManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2120,13 +2900,13 @@ func (x *ListManifestFilesRequest) Reset() {
 	}
 }
 
-func (x *ListManifestFilesRequest) String() string {
+func (x *RemoveFromManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestFilesRequest) ProtoMessage() {}
+func (*RemoveFromManifestRequest) ProtoMessage() {}
 
-func (x *ListManifestFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveFromManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2138,42 +2918,33 @@ func (x *ListManifestFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestFilesRequest.ProtoReflect.Descriptor instead.
-func (*ListManifestFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveFromManifestRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListManifestFilesRequest) GetManifestId() int32 {
+func (x *RemoveFromManifestRequest) GetManifestId() int32 {
 	if x != nil {
 		return x.ManifestId
 	}
 	return 0
 }
 
-func (x *ListManifestFilesRequest) GetOffset() int32 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ListManifestFilesRequest) GetLimit() int32 {
+func (x *RemoveFromManifestRequest) GetRemovePath() string {
 	if x != nil {
-		return x.Limit
+		return x.RemovePath
 	}
-	return 0
+	return ""
 }
 
-type ListManifestFilesResponse struct {
+type VersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	File []*ListManifestFilesResponse_FileUpload `protobuf:"bytes,1,rep,name=file,proto3" json:"file,omitempty"`
 }
 
-func (x *ListManifestFilesResponse) Reset() {
-	*x = ListManifestFilesResponse{}
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2181,13 +2952,13 @@ func (x *ListManifestFilesResponse) Reset() {
 	}
 }
 
-func (x *ListManifestFilesResponse) String() string {
+func (x *VersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestFilesResponse) ProtoMessage() {}
+func (*VersionRequest) ProtoMessage() {}
 
-func (x *ListManifestFilesResponse) ProtoReflect() protoreflect.Message {
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2199,34 +2970,22 @@ func (x *ListManifestFilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestFilesResponse.ProtoReflect.Descriptor instead.
-func (*ListManifestFilesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListManifestFilesResponse) GetFile() []*ListManifestFilesResponse_FileUpload {
-	if x != nil {
-		return x.File
-	}
-	return nil
-}
-
-type UploadManifestRequest struct {
+type VersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	// on_conflict controls server-side name-collision resolution during
-	// finalize. Accepted values: "keepBoth" (default, auto-rename new
-	// upload to " (N)") or "replace" (soft-delete predecessor and record
-	// provenance). Empty string is treated as "keepBoth" for backward
-	// compatibility.
-	OnConflict string `protobuf:"bytes,2,opt,name=on_conflict,json=onConflict,proto3" json:"on_conflict,omitempty"`
+	Version  string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	LogLevel string `protobuf:"bytes,2,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
 }
 
-func (x *UploadManifestRequest) Reset() {
-	*x = UploadManifestRequest{}
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2234,13 +2993,13 @@ func (x *UploadManifestRequest) Reset() {
 	}
 }
 
-func (x *UploadManifestRequest) String() string {
+func (x *VersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadManifestRequest) ProtoMessage() {}
+func (*VersionResponse) ProtoMessage() {}
 
-func (x *UploadManifestRequest) ProtoReflect() protoreflect.Message {
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,33 +3011,33 @@ func (x *UploadManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadManifestRequest.ProtoReflect.Descriptor instead.
-func (*UploadManifestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UploadManifestRequest) GetManifestId() int32 {
+func (x *VersionResponse) GetVersion() string {
 	if x != nil {
-		return x.ManifestId
+		return x.Version
 	}
-	return 0
+	return ""
 }
 
-func (x *UploadManifestRequest) GetOnConflict() string {
+func (x *VersionResponse) GetLogLevel() string {
 	if x != nil {
-		return x.OnConflict
+		return x.LogLevel
 	}
 	return ""
 }
 
-type GetUserRequest struct {
+type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2286,13 +3045,13 @@ func (x *GetUserRequest) Reset() {
 	}
 }
 
-func (x *GetUserRequest) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2304,30 +3063,21 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{29}
 }
 
-type UserResponse struct {
+type PingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	SessionToken     string `protobuf:"bytes,4,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	TokenExpire      int64  `protobuf:"varint,5,opt,name=token_expire,json=tokenExpire,proto3" json:"token_expire,omitempty"`
-	Profile          string `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
-	Environment      string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
-	OrganizationId   string `protobuf:"bytes,10,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	OrganizationName string `protobuf:"bytes,11,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	ApiHost          string `protobuf:"bytes,12,opt,name=api_host,json=apiHost,proto3" json:"api_host,omitempty"`
-	Api2Host         string `protobuf:"bytes,13,opt,name=api2_host,json=api2Host,proto3" json:"api2_host,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *UserResponse) Reset() {
-	*x = UserResponse{}
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2335,13 +3085,13 @@ func (x *UserResponse) Reset() {
 	}
 }
 
-func (x *UserResponse) String() string {
+func (x *PingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserResponse) ProtoMessage() {}
+func (*PingResponse) ProtoMessage() {}
 
-func (x *UserResponse) ProtoReflect() protoreflect.Message {
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2353,91 +3103,29 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
-func (*UserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UserResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UserResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UserResponse) GetSessionToken() string {
-	if x != nil {
-		return x.SessionToken
-	}
-	return ""
-}
-
-func (x *UserResponse) GetTokenExpire() int64 {
-	if x != nil {
-		return x.TokenExpire
-	}
-	return 0
-}
-
-func (x *UserResponse) GetProfile() string {
-	if x != nil {
-		return x.Profile
-	}
-	return ""
-}
-
-func (x *UserResponse) GetEnvironment() string {
-	if x != nil {
-		return x.Environment
-	}
-	return ""
-}
-
-func (x *UserResponse) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *UserResponse) GetOrganizationName() string {
-	if x != nil {
-		return x.OrganizationName
-	}
-	return ""
-}
-
-func (x *UserResponse) GetApiHost() string {
-	if x != nil {
-		return x.ApiHost
-	}
-	return ""
-}
-
-func (x *UserResponse) GetApi2Host() string {
+func (x *PingResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Api2Host
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type SwitchProfileRequest struct {
+type StopRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Force   bool  `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`     // Stop immediately without waiting for active uploads
+	Timeout int32 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"` // Seconds to wait for active uploads and requests; uses agent.shutdown_timeout if 0
 }
 
-func (x *SwitchProfileRequest) Reset() {
-	*x = SwitchProfileRequest{}
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2445,13 +3133,13 @@ func (x *SwitchProfileRequest) Reset() {
 	}
 }
 
-func (x *SwitchProfileRequest) String() string {
+func (x *StopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwitchProfileRequest) ProtoMessage() {}
+func (*StopRequest) ProtoMessage() {}
 
-func (x *SwitchProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2463,26 +3151,35 @@ func (x *SwitchProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwitchProfileRequest.ProtoReflect.Descriptor instead.
-func (*SwitchProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SwitchProfileRequest) GetProfile() string {
+func (x *StopRequest) GetForce() bool {
 	if x != nil {
-		return x.Profile
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type ReAuthenticateRequest struct {
+func (x *StopRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type StopResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *ReAuthenticateRequest) Reset() {
-	*x = ReAuthenticateRequest{}
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2490,13 +3187,13 @@ func (x *ReAuthenticateRequest) Reset() {
 	}
 }
 
-func (x *ReAuthenticateRequest) String() string {
+func (x *StopResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReAuthenticateRequest) ProtoMessage() {}
+func (*StopResponse) ProtoMessage() {}
 
-func (x *ReAuthenticateRequest) ProtoReflect() protoreflect.Message {
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2508,21 +3205,26 @@ func (x *ReAuthenticateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReAuthenticateRequest.ProtoReflect.Descriptor instead.
-func (*ReAuthenticateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{32}
 }
 
-type UseDatasetRequest struct {
+func (x *StopResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListManifestsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
 }
 
-func (x *UseDatasetRequest) Reset() {
-	*x = UseDatasetRequest{}
+func (x *ListManifestsRequest) Reset() {
+	*x = ListManifestsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2530,13 +3232,13 @@ func (x *UseDatasetRequest) Reset() {
 	}
 }
 
-func (x *UseDatasetRequest) String() string {
+func (x *ListManifestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseDatasetRequest) ProtoMessage() {}
+func (*ListManifestsRequest) ProtoMessage() {}
 
-func (x *UseDatasetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListManifestsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2548,28 +3250,21 @@ func (x *UseDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseDatasetRequest.ProtoReflect.Descriptor instead.
-func (*UseDatasetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListManifestsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UseDatasetRequest) GetDatasetId() string {
-	if x != nil {
-		return x.DatasetId
-	}
-	return ""
-}
-
-type UseDatasetResponse struct {
+type ListManifestsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Manifests []*ListManifestsResponse_Manifest `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
 }
 
-func (x *UseDatasetResponse) Reset() {
-	*x = UseDatasetResponse{}
+func (x *ListManifestsResponse) Reset() {
+	*x = ListManifestsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2577,13 +3272,13 @@ func (x *UseDatasetResponse) Reset() {
 	}
 }
 
-func (x *UseDatasetResponse) String() string {
+func (x *ListManifestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseDatasetResponse) ProtoMessage() {}
+func (*ListManifestsResponse) ProtoMessage() {}
 
-func (x *UseDatasetResponse) ProtoReflect() protoreflect.Message {
+func (x *ListManifestsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,19 +3290,19 @@ func (x *UseDatasetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseDatasetResponse.ProtoReflect.Descriptor instead.
-func (*UseDatasetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListManifestsResponse.ProtoReflect.Descriptor instead.
+func (*ListManifestsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UseDatasetResponse) GetDatasetId() string {
+func (x *ListManifestsResponse) GetManifests() []*ListManifestsResponse_Manifest {
 	if x != nil {
-		return x.DatasetId
+		return x.Manifests
 	}
-	return ""
+	return nil
 }
 
-type SyncManifestRequest struct {
+type DeleteManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2615,8 +3310,8 @@ type SyncManifestRequest struct {
 	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
 }
 
-func (x *SyncManifestRequest) Reset() {
-	*x = SyncManifestRequest{}
+func (x *DeleteManifestRequest) Reset() {
+	*x = DeleteManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2624,13 +3319,13 @@ func (x *SyncManifestRequest) Reset() {
 	}
 }
 
-func (x *SyncManifestRequest) String() string {
+func (x *DeleteManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncManifestRequest) ProtoMessage() {}
+func (*DeleteManifestRequest) ProtoMessage() {}
 
-func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2642,31 +3337,35 @@ func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncManifestRequest.ProtoReflect.Descriptor instead.
-func (*SyncManifestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteManifestRequest.ProtoReflect.Descriptor instead.
+func (*DeleteManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SyncManifestRequest) GetManifestId() int32 {
+func (x *DeleteManifestRequest) GetManifestId() int32 {
 	if x != nil {
 		return x.ManifestId
 	}
 	return 0
 }
 
-type SyncManifestResponse struct {
+type ListManifestFilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestNodeId string `protobuf:"bytes,1,opt,name=manifest_node_id,json=manifestNodeId,proto3" json:"manifest_node_id,omitempty"`
-	NrFilesUpdated int32  `protobuf:"varint,2,opt,name=nr_files_updated,json=nrFilesUpdated,proto3" json:"nr_files_updated,omitempty"`
-	NrFilesRemoved int32  `protobuf:"varint,3,opt,name=nr_files_removed,json=nrFilesRemoved,proto3" json:"nr_files_removed,omitempty"`
-	NrFilesFailed  int32  `protobuf:"varint,4,opt,name=nr_files_failed,json=nrFilesFailed,proto3" json:"nr_files_failed,omitempty"`
+	ManifestId int32                                  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Offset     int32                                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit      int32                                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Status     []ListManifestFilesResponse_StatusType `protobuf:"varint,4,rep,packed,name=status,proto3,enum=v1.ListManifestFilesResponse_StatusType" json:"status,omitempty"` // Only files with one of the statuses; all files if empty
+	Path       string                                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`                                                          // Glob (sub-03/*) or prefix of the path of files in the dataset
+	SortBy     ListManifestFilesRequest_SortField     `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=v1.ListManifestFilesRequest_SortField" json:"sort_by,omitempty"`
+	Descending bool                                   `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	SourcePath string                                 `protobuf:"bytes,8,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"` // Glob (/data/*.edf) or prefix of the source path of files
 }
 
-func (x *SyncManifestResponse) Reset() {
-	*x = SyncManifestResponse{}
+func (x *ListManifestFilesRequest) Reset() {
+	*x = ListManifestFilesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2674,13 +3373,13 @@ func (x *SyncManifestResponse) Reset() {
 	}
 }
 
-func (x *SyncManifestResponse) String() string {
+func (x *ListManifestFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncManifestResponse) ProtoMessage() {}
+func (*ListManifestFilesRequest) ProtoMessage() {}
 
-func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
+func (x *ListManifestFilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2692,49 +3391,78 @@ func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncManifestResponse.ProtoReflect.Descriptor instead.
-func (*SyncManifestResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListManifestFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListManifestFilesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SyncManifestResponse) GetManifestNodeId() string {
+func (x *ListManifestFilesRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.ManifestNodeId
+		return x.ManifestId
 	}
-	return ""
+	return 0
 }
 
-func (x *SyncManifestResponse) GetNrFilesUpdated() int32 {
+func (x *ListManifestFilesRequest) GetOffset() int32 {
 	if x != nil {
-		return x.NrFilesUpdated
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *SyncManifestResponse) GetNrFilesRemoved() int32 {
+func (x *ListManifestFilesRequest) GetLimit() int32 {
 	if x != nil {
-		return x.NrFilesRemoved
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *SyncManifestResponse) GetNrFilesFailed() int32 {
+func (x *ListManifestFilesRequest) GetStatus() []ListManifestFilesResponse_StatusType {
 	if x != nil {
-		return x.NrFilesFailed
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-type ResetManifestRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListManifestFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
 
-	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+func (x *ListManifestFilesRequest) GetSortBy() ListManifestFilesRequest_SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ListManifestFilesRequest_ID
 }
 
-func (x *ResetManifestRequest) Reset() {
-	*x = ResetManifestRequest{}
+func (x *ListManifestFilesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListManifestFilesRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+type ListManifestFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File  []*ListManifestFilesResponse_FileUpload `protobuf:"bytes,1,rep,name=file,proto3" json:"file,omitempty"`
+	Total int64                                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // Number of files that match the filters, regardless of offset and limit
+}
+
+func (x *ListManifestFilesResponse) Reset() {
+	*x = ListManifestFilesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2742,13 +3470,13 @@ func (x *ResetManifestRequest) Reset() {
 	}
 }
 
-func (x *ResetManifestRequest) String() string {
+func (x *ListManifestFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetManifestRequest) ProtoMessage() {}
+func (*ListManifestFilesResponse) ProtoMessage() {}
 
-func (x *ResetManifestRequest) ProtoReflect() protoreflect.Message {
+func (x *ListManifestFilesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2760,30 +3488,41 @@ func (x *ResetManifestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetManifestRequest.ProtoReflect.Descriptor instead.
-func (*ResetManifestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListManifestFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListManifestFilesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ResetManifestRequest) GetManifestId() int32 {
+func (x *ListManifestFilesResponse) GetFile() []*ListManifestFilesResponse_FileUpload {
 	if x != nil {
-		return x.ManifestId
+		return x.File
+	}
+	return nil
+}
+
+func (x *ListManifestFilesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-type RelocateManifestFilesRequest struct {
+type UploadManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ManifestId  int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	UpdatedPath string `protobuf:"bytes,3,opt,name=updated_path,json=updatedPath,proto3" json:"updated_path,omitempty"`
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	// on_conflict controls server-side name-collision resolution during
+	// finalize. Accepted values: "keepBoth" (default, auto-rename new
+	// upload to " (N)") or "replace" (soft-delete predecessor and record
+	// provenance). Empty string is treated as "keepBoth" for backward
+	// compatibility.
+	OnConflict string `protobuf:"bytes,2,opt,name=on_conflict,json=onConflict,proto3" json:"on_conflict,omitempty"`
 }
 
-func (x *RelocateManifestFilesRequest) Reset() {
-	*x = RelocateManifestFilesRequest{}
+func (x *UploadManifestRequest) Reset() {
+	*x = UploadManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,13 +3530,13 @@ func (x *RelocateManifestFilesRequest) Reset() {
 	}
 }
 
-func (x *RelocateManifestFilesRequest) String() string {
+func (x *UploadManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RelocateManifestFilesRequest) ProtoMessage() {}
+func (*UploadManifestRequest) ProtoMessage() {}
 
-func (x *RelocateManifestFilesRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2809,43 +3548,33 @@ func (x *RelocateManifestFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RelocateManifestFilesRequest.ProtoReflect.Descriptor instead.
-func (*RelocateManifestFilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadManifestRequest.ProtoReflect.Descriptor instead.
+func (*UploadManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RelocateManifestFilesRequest) GetManifestId() int32 {
+func (x *UploadManifestRequest) GetManifestId() int32 {
 	if x != nil {
 		return x.ManifestId
 	}
 	return 0
 }
 
-func (x *RelocateManifestFilesRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RelocateManifestFilesRequest) GetUpdatedPath() string {
+func (x *UploadManifestRequest) GetOnConflict() string {
 	if x != nil {
-		return x.UpdatedPath
+		return x.OnConflict
 	}
 	return ""
 }
 
-type StartWorkflowRequest struct {
+type GetUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ManifestId   int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	WorkflowFlag string `protobuf:"bytes,2,opt,name=workflowFlag,proto3" json:"workflowFlag,omitempty"`
 }
 
-func (x *StartWorkflowRequest) Reset() {
-	*x = StartWorkflowRequest{}
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2853,13 +3582,13 @@ func (x *StartWorkflowRequest) Reset() {
 	}
 }
 
-func (x *StartWorkflowRequest) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWorkflowRequest) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2871,37 +3600,30 @@ func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *StartWorkflowRequest) GetManifestId() int32 {
-	if x != nil {
-		return x.ManifestId
-	}
-	return 0
-}
-
-func (x *StartWorkflowRequest) GetWorkflowFlag() string {
-	if x != nil {
-		return x.WorkflowFlag
-	}
-	return ""
-}
-
-type WorkflowResponse struct {
+type UserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Derivatives  string                        `protobuf:"bytes,2,opt,name=derivatives,proto3" json:"derivatives,omitempty"`
-	WorkflowType WorkflowResponse_WorkflowType `protobuf:"varint,3,opt,name=workflowType,proto3,enum=v1.WorkflowResponse_WorkflowType" json:"workflowType,omitempty"`
+	Id               string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SessionToken     string `protobuf:"bytes,4,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	TokenExpire      int64  `protobuf:"varint,5,opt,name=token_expire,json=tokenExpire,proto3" json:"token_expire,omitempty"`
+	Profile          string `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"`
+	Environment      string `protobuf:"bytes,9,opt,name=environment,proto3" json:"environment,omitempty"`
+	OrganizationId   string `protobuf:"bytes,10,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string `protobuf:"bytes,11,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	ApiHost          string `protobuf:"bytes,12,opt,name=api_host,json=apiHost,proto3" json:"api_host,omitempty"`
+	Api2Host         string `protobuf:"bytes,13,opt,name=api2_host,json=api2Host,proto3" json:"api2_host,omitempty"`
 }
 
-func (x *WorkflowResponse) Reset() {
-	*x = WorkflowResponse{}
+func (x *UserResponse) Reset() {
+	*x = UserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,13 +3631,13 @@ func (x *WorkflowResponse) Reset() {
 	}
 }
 
-func (x *WorkflowResponse) String() string {
+func (x *UserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkflowResponse) ProtoMessage() {}
+func (*UserResponse) ProtoMessage() {}
 
-func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
+func (x *UserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2927,43 +3649,91 @@ func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkflowResponse.ProtoReflect.Descriptor instead.
-func (*WorkflowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
+func (*UserResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *WorkflowResponse) GetSuccess() bool {
+func (x *UserResponse) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *WorkflowResponse) GetDerivatives() string {
+func (x *UserResponse) GetName() string {
 	if x != nil {
-		return x.Derivatives
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WorkflowResponse) GetWorkflowType() WorkflowResponse_WorkflowType {
+func (x *UserResponse) GetSessionToken() string {
 	if x != nil {
-		return x.WorkflowType
+		return x.SessionToken
 	}
-	return WorkflowResponse_PATH
+	return ""
 }
 
-type RegisterRequest struct {
+func (x *UserResponse) GetTokenExpire() int64 {
+	if x != nil {
+		return x.TokenExpire
+	}
+	return 0
+}
+
+func (x *UserResponse) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *UserResponse) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UserResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UserResponse) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *UserResponse) GetApiHost() string {
+	if x != nil {
+		return x.ApiHost
+	}
+	return ""
+}
+
+func (x *UserResponse) GetApi2Host() string {
+	if x != nil {
+		return x.Api2Host
+	}
+	return ""
+}
+
+type SwitchProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *RegisterRequest) Reset() {
-	*x = RegisterRequest{}
+func (x *SwitchProfileRequest) Reset() {
+	*x = SwitchProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2971,13 +3741,13 @@ func (x *RegisterRequest) Reset() {
 	}
 }
 
-func (x *RegisterRequest) String() string {
+func (x *SwitchProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterRequest) ProtoMessage() {}
+func (*SwitchProfileRequest) ProtoMessage() {}
 
-func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+func (x *SwitchProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2989,35 +3759,26 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
-func (*RegisterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwitchProfileRequest.ProtoReflect.Descriptor instead.
+func (*SwitchProfileRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RegisterRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *RegisterRequest) GetCredentials() *Credentials {
+func (x *SwitchProfileRequest) GetProfile() string {
 	if x != nil {
-		return x.Credentials
+		return x.Profile
 	}
-	return nil
+	return ""
 }
 
-type RegisterResponse struct {
+type ReAuthenticateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
-func (x *RegisterResponse) Reset() {
-	*x = RegisterResponse{}
+func (x *ReAuthenticateRequest) Reset() {
+	*x = ReAuthenticateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3025,13 +3786,13 @@ func (x *RegisterResponse) Reset() {
 	}
 }
 
-func (x *RegisterResponse) String() string {
+func (x *ReAuthenticateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterResponse) ProtoMessage() {}
+func (*ReAuthenticateRequest) ProtoMessage() {}
 
-func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+func (x *ReAuthenticateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3043,30 +3804,21 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
-func (*RegisterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReAuthenticateRequest.ProtoReflect.Descriptor instead.
+func (*ReAuthenticateRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RegisterResponse) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-type DeregisterRequest struct {
+type UseDatasetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Force       bool         `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
 }
 
-func (x *DeregisterRequest) Reset() {
-	*x = DeregisterRequest{}
+func (x *UseDatasetRequest) Reset() {
+	*x = UseDatasetRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3074,13 +3826,13 @@ func (x *DeregisterRequest) Reset() {
 	}
 }
 
-func (x *DeregisterRequest) String() string {
+func (x *UseDatasetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeregisterRequest) ProtoMessage() {}
+func (*UseDatasetRequest) ProtoMessage() {}
 
-func (x *DeregisterRequest) ProtoReflect() protoreflect.Message {
+func (x *UseDatasetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3092,43 +3844,28 @@ func (x *DeregisterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeregisterRequest.ProtoReflect.Descriptor instead.
-func (*DeregisterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseDatasetRequest.ProtoReflect.Descriptor instead.
+func (*UseDatasetRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DeregisterRequest) GetAccount() *Account {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *DeregisterRequest) GetCredentials() *Credentials {
-	if x != nil {
-		return x.Credentials
-	}
-	return nil
-}
-
-func (x *DeregisterRequest) GetForce() bool {
+func (x *UseDatasetRequest) GetDatasetId() string {
 	if x != nil {
-		return x.Force
+		return x.DatasetId
 	}
-	return false
+	return ""
 }
 
-type DeregisterResponse struct {
+type UseDatasetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	RoleName  string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	DatasetId string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
 }
 
-func (x *DeregisterResponse) Reset() {
-	*x = DeregisterResponse{}
+func (x *UseDatasetResponse) Reset() {
+	*x = UseDatasetResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3136,13 +3873,13 @@ func (x *DeregisterResponse) Reset() {
 	}
 }
 
-func (x *DeregisterResponse) String() string {
+func (x *UseDatasetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeregisterResponse) ProtoMessage() {}
+func (*UseDatasetResponse) ProtoMessage() {}
 
-func (x *DeregisterResponse) ProtoReflect() protoreflect.Message {
+func (x *UseDatasetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3154,35 +3891,28 @@ func (x *DeregisterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeregisterResponse.ProtoReflect.Descriptor instead.
-func (*DeregisterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseDatasetResponse.ProtoReflect.Descriptor instead.
+func (*UseDatasetResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeregisterResponse) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *DeregisterResponse) GetRoleName() string {
+func (x *UseDatasetResponse) GetDatasetId() string {
 	if x != nil {
-		return x.RoleName
+		return x.DatasetId
 	}
 	return ""
 }
 
-type Account struct {
+type SyncManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type Account_AccountType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.Account_AccountType" json:"type,omitempty"`
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *SyncManifestRequest) Reset() {
+	*x = SyncManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3190,13 +3920,13 @@ func (x *Account) Reset() {
 	}
 }
 
-func (x *Account) String() string {
+func (x *SyncManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*SyncManifestRequest) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *SyncManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3208,28 +3938,31 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncManifestRequest.ProtoReflect.Descriptor instead.
+func (*SyncManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Account) GetType() Account_AccountType {
+func (x *SyncManifestRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Type
+		return x.ManifestId
 	}
-	return Account_AWS
+	return 0
 }
 
-type Credentials struct {
+type SyncManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	ManifestNodeId string `protobuf:"bytes,1,opt,name=manifest_node_id,json=manifestNodeId,proto3" json:"manifest_node_id,omitempty"`
+	NrFilesUpdated int32  `protobuf:"varint,2,opt,name=nr_files_updated,json=nrFilesUpdated,proto3" json:"nr_files_updated,omitempty"`
+	NrFilesRemoved int32  `protobuf:"varint,3,opt,name=nr_files_removed,json=nrFilesRemoved,proto3" json:"nr_files_removed,omitempty"`
+	NrFilesFailed  int32  `protobuf:"varint,4,opt,name=nr_files_failed,json=nrFilesFailed,proto3" json:"nr_files_failed,omitempty"`
 }
 
-func (x *Credentials) Reset() {
-	*x = Credentials{}
+func (x *SyncManifestResponse) Reset() {
+	*x = SyncManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3237,13 +3970,13 @@ func (x *Credentials) Reset() {
 	}
 }
 
-func (x *Credentials) String() string {
+func (x *SyncManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Credentials) ProtoMessage() {}
+func (*SyncManifestResponse) ProtoMessage() {}
 
-func (x *Credentials) ProtoReflect() protoreflect.Message {
+func (x *SyncManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3255,29 +3988,49 @@ func (x *Credentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Credentials.ProtoReflect.Descriptor instead.
-func (*Credentials) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncManifestResponse.ProtoReflect.Descriptor instead.
+func (*SyncManifestResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Credentials) GetProfile() string {
+func (x *SyncManifestResponse) GetManifestNodeId() string {
 	if x != nil {
-		return x.Profile
+		return x.ManifestNodeId
 	}
 	return ""
 }
 
-type MapRequest struct {
+func (x *SyncManifestResponse) GetNrFilesUpdated() int32 {
+	if x != nil {
+		return x.NrFilesUpdated
+	}
+	return 0
+}
+
+func (x *SyncManifestResponse) GetNrFilesRemoved() int32 {
+	if x != nil {
+		return x.NrFilesRemoved
+	}
+	return 0
+}
+
+func (x *SyncManifestResponse) GetNrFilesFailed() int32 {
+	if x != nil {
+		return x.NrFilesFailed
+	}
+	return 0
+}
+
+type ResetManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId    string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	TargetFolder string `protobuf:"bytes,2,opt,name=target_folder,json=targetFolder,proto3" json:"target_folder,omitempty"`
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
 }
 
-func (x *MapRequest) Reset() {
-	*x = MapRequest{}
+func (x *ResetManifestRequest) Reset() {
+	*x = ResetManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3285,13 +4038,13 @@ func (x *MapRequest) Reset() {
 	}
 }
 
-func (x *MapRequest) String() string {
+func (x *ResetManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapRequest) ProtoMessage() {}
+func (*ResetManifestRequest) ProtoMessage() {}
 
-func (x *MapRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3303,40 +4056,30 @@ func (x *MapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
-func (*MapRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetManifestRequest.ProtoReflect.Descriptor instead.
+func (*ResetManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MapRequest) GetDatasetId() string {
-	if x != nil {
-		return x.DatasetId
-	}
-	return ""
-}
-
-func (x *MapRequest) GetTargetFolder() string {
+func (x *ResetManifestRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.TargetFolder
+		return x.ManifestId
 	}
-	return ""
+	return 0
 }
 
-type DownloadRequest struct {
+type ExportManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type DownloadRequest_DownloadType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.DownloadRequest_DownloadType" json:"type,omitempty"`
-	// Types that are assignable to Data:
-	//
-	//	*DownloadRequest_Dataset
-	//	*DownloadRequest_Package
-	Data isDownloadRequest_Data `protobuf_oneof:"data"`
+	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`     // File that the manifest is written to
+	Format     string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // csv or json; inferred from the extension of path if empty
 }
 
-func (x *DownloadRequest) Reset() {
-	*x = DownloadRequest{}
+func (x *ExportManifestRequest) Reset() {
+	*x = ExportManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3344,13 +4087,13 @@ func (x *DownloadRequest) Reset() {
 	}
 }
 
-func (x *DownloadRequest) String() string {
+func (x *ExportManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadRequest) ProtoMessage() {}
+func (*ExportManifestRequest) ProtoMessage() {}
 
-func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3362,66 +4105,43 @@ func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadRequest.ProtoReflect.Descriptor instead.
-func (*DownloadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportManifestRequest.ProtoReflect.Descriptor instead.
+func (*ExportManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DownloadRequest) GetType() DownloadRequest_DownloadType {
+func (x *ExportManifestRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Type
-	}
-	return DownloadRequest_PACKAGE
-}
-
-func (m *DownloadRequest) GetData() isDownloadRequest_Data {
-	if m != nil {
-		return m.Data
+		return x.ManifestId
 	}
-	return nil
+	return 0
 }
 
-func (x *DownloadRequest) GetDataset() *DownloadDatasetRequest {
-	if x, ok := x.GetData().(*DownloadRequest_Dataset); ok {
-		return x.Dataset
+func (x *ExportManifestRequest) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *DownloadRequest) GetPackage() *DownloadPackageRequest {
-	if x, ok := x.GetData().(*DownloadRequest_Package); ok {
-		return x.Package
+func (x *ExportManifestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
-	return nil
-}
-
-type isDownloadRequest_Data interface {
-	isDownloadRequest_Data()
-}
-
-type DownloadRequest_Dataset struct {
-	Dataset *DownloadDatasetRequest `protobuf:"bytes,9,opt,name=dataset,proto3,oneof"`
-}
-
-type DownloadRequest_Package struct {
-	Package *DownloadPackageRequest `protobuf:"bytes,10,opt,name=package,proto3,oneof"`
+	return ""
 }
 
-func (*DownloadRequest_Dataset) isDownloadRequest_Data() {}
-
-func (*DownloadRequest_Package) isDownloadRequest_Data() {}
-
-type DownloadDatasetRequest struct {
+type ExportManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DatasetId    string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	TargetFolder string `protobuf:"bytes,2,opt,name=target_folder,json=targetFolder,proto3" json:"target_folder,omitempty"`
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NrFiles int64  `protobuf:"varint,2,opt,name=nr_files,json=nrFiles,proto3" json:"nr_files,omitempty"`
 }
 
-func (x *DownloadDatasetRequest) Reset() {
-	*x = DownloadDatasetRequest{}
+func (x *ExportManifestResponse) Reset() {
+	*x = ExportManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3429,13 +4149,13 @@ func (x *DownloadDatasetRequest) Reset() {
 	}
 }
 
-func (x *DownloadDatasetRequest) String() string {
+func (x *ExportManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadDatasetRequest) ProtoMessage() {}
+func (*ExportManifestResponse) ProtoMessage() {}
 
-func (x *DownloadDatasetRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3447,36 +4167,39 @@ func (x *DownloadDatasetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadDatasetRequest.ProtoReflect.Descriptor instead.
-func (*DownloadDatasetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportManifestResponse.ProtoReflect.Descriptor instead.
+func (*ExportManifestResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *DownloadDatasetRequest) GetDatasetId() string {
+func (x *ExportManifestResponse) GetPath() string {
 	if x != nil {
-		return x.DatasetId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DownloadDatasetRequest) GetTargetFolder() string {
+func (x *ExportManifestResponse) GetNrFiles() int64 {
 	if x != nil {
-		return x.TargetFolder
+		return x.NrFiles
 	}
-	return ""
+	return 0
 }
 
-type DownloadPackageRequest struct {
+// ImportManifestRequest creates a manifest from a file written by ExportManifest, or any CSV or JSON
+// file with the same fields. Only source_path is required; the status and upload id are not imported.
+type ImportManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageId       string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	GetPresignedUrl bool   `protobuf:"varint,2,opt,name=get_presigned_url,json=getPresignedUrl,proto3" json:"get_presigned_url,omitempty"`
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                            // File that the manifest is read from
+	DatasetId string `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"` // Dataset that the files are uploaded to
+	Format    string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                        // csv or json; inferred from the extension of path if empty
 }
 
-func (x *DownloadPackageRequest) Reset() {
-	*x = DownloadPackageRequest{}
+func (x *ImportManifestRequest) Reset() {
+	*x = ImportManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3484,13 +4207,13 @@ func (x *DownloadPackageRequest) Reset() {
 	}
 }
 
-func (x *DownloadPackageRequest) String() string {
+func (x *ImportManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadPackageRequest) ProtoMessage() {}
+func (*ImportManifestRequest) ProtoMessage() {}
 
-func (x *DownloadPackageRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3502,37 +4225,43 @@ func (x *DownloadPackageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadPackageRequest.ProtoReflect.Descriptor instead.
-func (*DownloadPackageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportManifestRequest.ProtoReflect.Descriptor instead.
+func (*ImportManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DownloadPackageRequest) GetPackageId() string {
+func (x *ImportManifestRequest) GetPath() string {
 	if x != nil {
-		return x.PackageId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DownloadPackageRequest) GetGetPresignedUrl() bool {
+func (x *ImportManifestRequest) GetDatasetId() string {
 	if x != nil {
-		return x.GetPresignedUrl
+		return x.DatasetId
 	}
-	return false
+	return ""
 }
 
-type DownloadResponse struct {
+func (x *ImportManifestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   DownloadResponse_ResponseType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.DownloadResponse_ResponseType" json:"type,omitempty"`
-	Status string                        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Url    []string                      `protobuf:"bytes,3,rep,name=url,proto3" json:"url,omitempty"`
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	NrFiles    int64 `protobuf:"varint,2,opt,name=nr_files,json=nrFiles,proto3" json:"nr_files,omitempty"`
 }
 
-func (x *DownloadResponse) Reset() {
-	*x = DownloadResponse{}
+func (x *ImportManifestResponse) Reset() {
+	*x = ImportManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3540,13 +4269,13 @@ func (x *DownloadResponse) Reset() {
 	}
 }
 
-func (x *DownloadResponse) String() string {
+func (x *ImportManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadResponse) ProtoMessage() {}
+func (*ImportManifestResponse) ProtoMessage() {}
 
-func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3558,42 +4287,38 @@ func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadResponse.ProtoReflect.Descriptor instead.
-func (*DownloadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportManifestResponse.ProtoReflect.Descriptor instead.
+func (*ImportManifestResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DownloadResponse) GetType() DownloadResponse_ResponseType {
-	if x != nil {
-		return x.Type
-	}
-	return DownloadResponse_PRESIGNED_URL
-}
-
-func (x *DownloadResponse) GetStatus() string {
+func (x *ImportManifestResponse) GetManifestId() int32 {
 	if x != nil {
-		return x.Status
+		return x.ManifestId
 	}
-	return ""
+	return 0
 }
 
-func (x *DownloadResponse) GetUrl() []string {
+func (x *ImportManifestResponse) GetNrFiles() int64 {
 	if x != nil {
-		return x.Url
+		return x.NrFiles
 	}
-	return nil
+	return 0
 }
 
-type MapDiffRequest struct {
+// ValidateManifestRequest checks the target paths and names of the files in a manifest against the rules
+// of a data standard. Only the layout of the files is checked, not their content.
+type ValidateManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ManifestId int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Standard   string `protobuf:"bytes,2,opt,name=standard,proto3" json:"standard,omitempty"` // Data standard to validate against; only "bids" is supported
 }
 
-func (x *MapDiffRequest) Reset() {
-	*x = MapDiffRequest{}
+func (x *ValidateManifestRequest) Reset() {
+	*x = ValidateManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3601,13 +4326,13 @@ func (x *MapDiffRequest) Reset() {
 	}
 }
 
-func (x *MapDiffRequest) String() string {
+func (x *ValidateManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapDiffRequest) ProtoMessage() {}
+func (*ValidateManifestRequest) ProtoMessage() {}
 
-func (x *MapDiffRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3619,31 +4344,38 @@ func (x *MapDiffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapDiffRequest.ProtoReflect.Descriptor instead.
-func (*MapDiffRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateManifestRequest.ProtoReflect.Descriptor instead.
+func (*ValidateManifestRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MapDiffRequest) GetPath() string {
+func (x *ValidateManifestRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Path
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *ValidateManifestRequest) GetStandard() string {
+	if x != nil {
+		return x.Standard
 	}
 	return ""
 }
 
-type FileInfo struct {
+type ValidateManifestResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Valid      bool                              `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"` // True if there are no errors
+	NrErrors   int32                             `protobuf:"varint,2,opt,name=nr_errors,json=nrErrors,proto3" json:"nr_errors,omitempty"`
+	NrWarnings int32                             `protobuf:"varint,3,opt,name=nr_warnings,json=nrWarnings,proto3" json:"nr_warnings,omitempty"`
+	Issues     []*ValidateManifestResponse_Issue `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
+func (x *ValidateManifestResponse) Reset() {
+	*x = ValidateManifestResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3651,13 +4383,13 @@ func (x *FileInfo) Reset() {
 	}
 }
 
-func (x *FileInfo) String() string {
+func (x *ValidateManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*ValidateManifestResponse) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
+func (x *ValidateManifestResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3669,50 +4401,58 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateManifestResponse.ProtoReflect.Descriptor instead.
+func (*ValidateManifestResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *FileInfo) GetPackageId() string {
+func (x *ValidateManifestResponse) GetValid() bool {
 	if x != nil {
-		return x.PackageId
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *FileInfo) GetPath() string {
+func (x *ValidateManifestResponse) GetNrErrors() int32 {
 	if x != nil {
-		return x.Path
+		return x.NrErrors
 	}
-	return ""
+	return 0
 }
 
-func (x *FileInfo) GetName() string {
+func (x *ValidateManifestResponse) GetNrWarnings() int32 {
 	if x != nil {
-		return x.Name
+		return x.NrWarnings
 	}
-	return ""
+	return 0
 }
 
-func (x *FileInfo) GetMessage() string {
+func (x *ValidateManifestResponse) GetIssues() []*ValidateManifestResponse_Issue {
 	if x != nil {
-		return x.Message
+		return x.Issues
 	}
-	return ""
+	return nil
 }
 
-type PackageStatus struct {
+// RelocateManifestFilesRequest changes where files in a manifest are uploaded to. It moves the files under
+// a target path prefix, renames a single file if target_name is set, or applies a path template to all files
+// whose source path matches source_pattern.
+type RelocateManifestFilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content    *FileInfo                `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	ChangeType PackageStatus_StatusType `protobuf:"varint,2,opt,name=changeType,proto3,enum=v1.PackageStatus_StatusType" json:"changeType,omitempty"`
+	ManifestId    int32  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                        // Target path prefix of the files to move, or the source path or target path and name of the file to rename
+	UpdatedPath   string `protobuf:"bytes,3,opt,name=updated_path,json=updatedPath,proto3" json:"updated_path,omitempty"`       // New target path prefix of the files under path
+	TargetName    string `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`          // New name of the file at path
+	SourcePattern string `protobuf:"bytes,5,opt,name=source_pattern,json=sourcePattern,proto3" json:"source_pattern,omitempty"` // Regular expression matched against source paths; its groups fill the path template
+	PathTemplate  string `protobuf:"bytes,6,opt,name=path_template,json=pathTemplate,proto3" json:"path_template,omitempty"`    // Target path template such as sub-{subject}/ses-{session}, using named or numbered groups of source_pattern
+	DryRun        bool   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                     // Returns the changes without applying them
 }
 
-func (x *PackageStatus) Reset() {
-	*x = PackageStatus{}
+func (x *RelocateManifestFilesRequest) Reset() {
+	*x = RelocateManifestFilesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3720,13 +4460,13 @@ func (x *PackageStatus) Reset() {
 	}
 }
 
-func (x *PackageStatus) String() string {
+func (x *RelocateManifestFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PackageStatus) ProtoMessage() {}
+func (*RelocateManifestFilesRequest) ProtoMessage() {}
 
-func (x *PackageStatus) ProtoReflect() protoreflect.Message {
+func (x *RelocateManifestFilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3738,35 +4478,72 @@ func (x *PackageStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PackageStatus.ProtoReflect.Descriptor instead.
-func (*PackageStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelocateManifestFilesRequest.ProtoReflect.Descriptor instead.
+func (*RelocateManifestFilesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *PackageStatus) GetContent() *FileInfo {
+func (x *RelocateManifestFilesRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Content
+		return x.ManifestId
 	}
-	return nil
+	return 0
 }
 
-func (x *PackageStatus) GetChangeType() PackageStatus_StatusType {
+func (x *RelocateManifestFilesRequest) GetPath() string {
 	if x != nil {
-		return x.ChangeType
+		return x.Path
 	}
-	return PackageStatus_ADDED
+	return ""
 }
 
-type MapDiffResponse struct {
+func (x *RelocateManifestFilesRequest) GetUpdatedPath() string {
+	if x != nil {
+		return x.UpdatedPath
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesRequest) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesRequest) GetSourcePattern() string {
+	if x != nil {
+		return x.SourcePattern
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesRequest) GetPathTemplate() string {
+	if x != nil {
+		return x.PathTemplate
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RelocateManifestFilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files []*PackageStatus `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	NrFilesUpdated  int32                                       `protobuf:"varint,1,opt,name=nr_files_updated,json=nrFilesUpdated,proto3" json:"nr_files_updated,omitempty"`
+	NrFilesResynced int32                                       `protobuf:"varint,2,opt,name=nr_files_resynced,json=nrFilesResynced,proto3" json:"nr_files_resynced,omitempty"` // Registered files that are synchronized again with their new target
+	Relocations     []*RelocateManifestFilesResponse_Relocation `protobuf:"bytes,3,rep,name=relocations,proto3" json:"relocations,omitempty"`                                   // Changes of a dry run
 }
 
-func (x *MapDiffResponse) Reset() {
-	*x = MapDiffResponse{}
+func (x *RelocateManifestFilesResponse) Reset() {
+	*x = RelocateManifestFilesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3774,13 +4551,13 @@ func (x *MapDiffResponse) Reset() {
 	}
 }
 
-func (x *MapDiffResponse) String() string {
+func (x *RelocateManifestFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MapDiffResponse) ProtoMessage() {}
+func (*RelocateManifestFilesResponse) ProtoMessage() {}
 
-func (x *MapDiffResponse) ProtoReflect() protoreflect.Message {
+func (x *RelocateManifestFilesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3792,29 +4569,48 @@ func (x *MapDiffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MapDiffResponse.ProtoReflect.Descriptor instead.
-func (*MapDiffResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RelocateManifestFilesResponse.ProtoReflect.Descriptor instead.
+func (*RelocateManifestFilesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *MapDiffResponse) GetFiles() []*PackageStatus {
+func (x *RelocateManifestFilesResponse) GetNrFilesUpdated() int32 {
 	if x != nil {
-		return x.Files
+		return x.NrFilesUpdated
+	}
+	return 0
+}
+
+func (x *RelocateManifestFilesResponse) GetNrFilesResynced() int32 {
+	if x != nil {
+		return x.NrFilesResynced
+	}
+	return 0
+}
+
+func (x *RelocateManifestFilesResponse) GetRelocations() []*RelocateManifestFilesResponse_Relocation {
+	if x != nil {
+		return x.Relocations
 	}
 	return nil
 }
 
-type UpdateRoleRequest struct {
+type StartWorkflowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ManifestId            int32             `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	WorkflowFlag          string            `protobuf:"bytes,2,opt,name=workflowFlag,proto3" json:"workflowFlag,omitempty"`                                                                             // Name of a registered workflow, or the absolute path of a workflow file
+	UploadDerivatives     bool              `protobuf:"varint,3,opt,name=upload_derivatives,json=uploadDerivatives,proto3" json:"upload_derivatives,omitempty"`                                         // Upload the derivatives of a successful run to the dataset of the manifest
+	DerivativesTargetPath string            `protobuf:"bytes,4,opt,name=derivatives_target_path,json=derivativesTargetPath,proto3" json:"derivatives_target_path,omitempty"`                            // Target path of the derivatives; {workflow} and {run} are replaced by the workflow name and run id. Defaults to derivatives/{workflow}/{run}
+	Executor              string            `protobuf:"bytes,5,opt,name=executor,proto3" json:"executor,omitempty"`                                                                                     // Executor that runs the workflow: local, nextflow, podman or apptainer. Defaults to agent.workflow_executor
+	Image                 string            `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`                                                                                           // Container image for the podman and apptainer executors. Defaults to agent.workflow_image
+	Params                map[string]string `protobuf:"bytes,7,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Parameters of a named workflow, validated against the schema of the workflow
 }
 
-func (x *UpdateRoleRequest) Reset() {
-	*x = UpdateRoleRequest{}
+func (x *StartWorkflowRequest) Reset() {
+	*x = StartWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3822,13 +4618,13 @@ func (x *UpdateRoleRequest) Reset() {
 	}
 }
 
-func (x *UpdateRoleRequest) String() string {
+func (x *StartWorkflowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleRequest) ProtoMessage() {}
+func (*StartWorkflowRequest) ProtoMessage() {}
 
-func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3840,36 +4636,73 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UpdateRoleRequest) GetAccount() *Account {
+func (x *StartWorkflowRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Account
+		return x.ManifestId
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateRoleRequest) GetCredentials() *Credentials {
+func (x *StartWorkflowRequest) GetWorkflowFlag() string {
 	if x != nil {
-		return x.Credentials
+		return x.WorkflowFlag
+	}
+	return ""
+}
+
+func (x *StartWorkflowRequest) GetUploadDerivatives() bool {
+	if x != nil {
+		return x.UploadDerivatives
+	}
+	return false
+}
+
+func (x *StartWorkflowRequest) GetDerivativesTargetPath() string {
+	if x != nil {
+		return x.DerivativesTargetPath
+	}
+	return ""
+}
+
+func (x *StartWorkflowRequest) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *StartWorkflowRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *StartWorkflowRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
 	}
 	return nil
 }
 
-type UpdateRoleResponse struct {
+type WorkflowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	RoleName  string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	Success      bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Derivatives  string                        `protobuf:"bytes,2,opt,name=derivatives,proto3" json:"derivatives,omitempty"`
+	WorkflowType WorkflowResponse_WorkflowType `protobuf:"varint,3,opt,name=workflowType,proto3,enum=v1.WorkflowResponse_WorkflowType" json:"workflowType,omitempty"`
+	RunId        string                        `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // Id of the run, which continues in the background
 }
 
-func (x *UpdateRoleResponse) Reset() {
-	*x = UpdateRoleResponse{}
+func (x *WorkflowResponse) Reset() {
+	*x = WorkflowResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3877,13 +4710,13 @@ func (x *UpdateRoleResponse) Reset() {
 	}
 }
 
-func (x *UpdateRoleResponse) String() string {
+func (x *WorkflowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleResponse) ProtoMessage() {}
+func (*WorkflowResponse) ProtoMessage() {}
 
-func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3895,38 +4728,60 @@ func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkflowResponse.ProtoReflect.Descriptor instead.
+func (*WorkflowResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_agent_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateRoleResponse) GetAccountId() string {
+func (x *WorkflowResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AccountId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateRoleResponse) GetRoleName() string {
+func (x *WorkflowResponse) GetDerivatives() string {
 	if x != nil {
-		return x.RoleName
+		return x.Derivatives
 	}
 	return ""
 }
 
-type GetTimeseriesRangeResponse_ChannelInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WorkflowResponse) GetWorkflowType() WorkflowResponse_WorkflowType {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return WorkflowResponse_PATH
+}
 
-	ChannelId string  `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Unit      string  `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
-	Rate      float32 `protobuf:"fixed32,4,opt,name=rate,proto3" json:"rate,omitempty"`
+func (x *WorkflowResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) Reset() {
-	*x = GetTimeseriesRangeResponse_ChannelInfo{}
+type WorkflowRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ManifestId            int32              `protobuf:"varint,2,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	Workflow              string             `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Status                WorkflowRun_Status `protobuf:"varint,4,opt,name=status,proto3,enum=v1.WorkflowRun_Status" json:"status,omitempty"`
+	JobDir                string             `protobuf:"bytes,5,opt,name=job_dir,json=jobDir,proto3" json:"job_dir,omitempty"`
+	LogPath               string             `protobuf:"bytes,6,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	ExitCode              int32              `protobuf:"varint,7,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error                 string             `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt             int64              `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                        // Unix time
+	StartedAt             int64              `protobuf:"varint,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`                                       // Unix time; 0 if the workflow did not start
+	FinishedAt            int64              `protobuf:"varint,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`                                    // Unix time; 0 if the run has not ended
+	DerivativesManifestId int32              `protobuf:"varint,12,opt,name=derivatives_manifest_id,json=derivativesManifestId,proto3" json:"derivatives_manifest_id,omitempty"` // Manifest through which the derivatives are uploaded; 0 if none
+}
+
+func (x *WorkflowRun) Reset() {
+	*x = WorkflowRun{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3934,13 +4789,13 @@ func (x *GetTimeseriesRangeResponse_ChannelInfo) Reset() {
 	}
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) String() string {
+func (x *WorkflowRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesRangeResponse_ChannelInfo) ProtoMessage() {}
+func (*WorkflowRun) ProtoMessage() {}
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) ProtoReflect() protoreflect.Message {
+func (x *WorkflowRun) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3952,143 +4807,120 @@ func (x *GetTimeseriesRangeResponse_ChannelInfo) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesRangeResponse_ChannelInfo.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesRangeResponse_ChannelInfo) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use WorkflowRun.ProtoReflect.Descriptor instead.
+func (*WorkflowRun) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) GetChannelId() string {
+func (x *WorkflowRun) GetId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) GetName() string {
+func (x *WorkflowRun) GetManifestId() int32 {
 	if x != nil {
-		return x.Name
+		return x.ManifestId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) GetUnit() string {
+func (x *WorkflowRun) GetWorkflow() string {
 	if x != nil {
-		return x.Unit
+		return x.Workflow
 	}
 	return ""
 }
 
-func (x *GetTimeseriesRangeResponse_ChannelInfo) GetRate() float32 {
+func (x *WorkflowRun) GetStatus() WorkflowRun_Status {
 	if x != nil {
-		return x.Rate
+		return x.Status
 	}
-	return 0
-}
-
-type GetTimeseriesRangeResponse_RangeData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Start     uint64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End       uint64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Rate      float32   `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	ChannelId string    `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Data      []float32 `protobuf:"fixed32,5,rep,packed,name=data,proto3" json:"data,omitempty"`
+	return WorkflowRun_PENDING
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) Reset() {
-	*x = GetTimeseriesRangeResponse_RangeData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WorkflowRun) GetJobDir() string {
+	if x != nil {
+		return x.JobDir
 	}
+	return ""
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetTimeseriesRangeResponse_RangeData) ProtoMessage() {}
-
-func (x *GetTimeseriesRangeResponse_RangeData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WorkflowRun) GetLogPath() string {
+	if x != nil {
+		return x.LogPath
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetTimeseriesRangeResponse_RangeData.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesRangeResponse_RangeData) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{8, 1}
+func (x *WorkflowRun) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) GetStart() uint64 {
+func (x *WorkflowRun) GetError() string {
 	if x != nil {
-		return x.Start
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) GetEnd() uint64 {
+func (x *WorkflowRun) GetCreatedAt() int64 {
 	if x != nil {
-		return x.End
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) GetRate() float32 {
+func (x *WorkflowRun) GetStartedAt() int64 {
 	if x != nil {
-		return x.Rate
+		return x.StartedAt
 	}
 	return 0
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) GetChannelId() string {
+func (x *WorkflowRun) GetFinishedAt() int64 {
 	if x != nil {
-		return x.ChannelId
+		return x.FinishedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTimeseriesRangeResponse_RangeData) GetData() []float32 {
+func (x *WorkflowRun) GetDerivativesManifestId() int32 {
 	if x != nil {
-		return x.Data
+		return x.DerivativesManifestId
 	}
-	return nil
+	return 0
 }
 
-type GetTimeseriesRangeResponse_ErrorData struct {
+type ListWorkflowsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	ManifestId int32 `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"` // Lists runs for all manifests if 0
 }
 
-func (x *GetTimeseriesRangeResponse_ErrorData) Reset() {
-	*x = GetTimeseriesRangeResponse_ErrorData{}
+func (x *ListWorkflowsRequest) Reset() {
+	*x = ListWorkflowsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[60]
+		mi := &file_api_v1_agent_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetTimeseriesRangeResponse_ErrorData) String() string {
+func (x *ListWorkflowsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTimeseriesRangeResponse_ErrorData) ProtoMessage() {}
+func (*ListWorkflowsRequest) ProtoMessage() {}
 
-func (x *GetTimeseriesRangeResponse_ErrorData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[60]
+func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4099,43 +4931,43 @@ func (x *GetTimeseriesRangeResponse_ErrorData) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTimeseriesRangeResponse_ErrorData.ProtoReflect.Descriptor instead.
-func (*GetTimeseriesRangeResponse_ErrorData) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{8, 2}
+// Deprecated: Use ListWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetTimeseriesRangeResponse_ErrorData) GetInfo() string {
+func (x *ListWorkflowsRequest) GetManifestId() int32 {
 	if x != nil {
-		return x.Info
+		return x.ManifestId
 	}
-	return ""
+	return 0
 }
 
-type SubscribeResponse_EventResponse struct {
+type ListWorkflowsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Details string `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	Runs []*WorkflowRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 }
 
-func (x *SubscribeResponse_EventResponse) Reset() {
-	*x = SubscribeResponse_EventResponse{}
+func (x *ListWorkflowsResponse) Reset() {
+	*x = ListWorkflowsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[61]
+		mi := &file_api_v1_agent_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeResponse_EventResponse) String() string {
+func (x *ListWorkflowsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse_EventResponse) ProtoMessage() {}
+func (*ListWorkflowsResponse) ProtoMessage() {}
 
-func (x *SubscribeResponse_EventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[61]
+func (x *ListWorkflowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4146,47 +4978,44 @@ func (x *SubscribeResponse_EventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse_EventResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse_EventResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ListWorkflowsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SubscribeResponse_EventResponse) GetDetails() string {
+func (x *ListWorkflowsResponse) GetRuns() []*WorkflowRun {
 	if x != nil {
-		return x.Details
+		return x.Runs
 	}
-	return ""
+	return nil
 }
 
-type SubscribeResponse_UploadResponse struct {
+type GetWorkflowStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileId   string                                        `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	Total    int64                                         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Current  int64                                         `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
-	WorkerId int32                                         `protobuf:"varint,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
-	Status   SubscribeResponse_UploadResponse_UploadStatus `protobuf:"varint,5,opt,name=status,proto3,enum=v1.SubscribeResponse_UploadResponse_UploadStatus" json:"status,omitempty"`
+	RunId    string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	LogLines int32  `protobuf:"varint,2,opt,name=log_lines,json=logLines,proto3" json:"log_lines,omitempty"` // Number of lines at the end of the log to return
 }
 
-func (x *SubscribeResponse_UploadResponse) Reset() {
-	*x = SubscribeResponse_UploadResponse{}
+func (x *GetWorkflowStatusRequest) Reset() {
+	*x = GetWorkflowStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[62]
+		mi := &file_api_v1_agent_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeResponse_UploadResponse) String() string {
+func (x *GetWorkflowStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse_UploadResponse) ProtoMessage() {}
+func (*GetWorkflowStatusRequest) ProtoMessage() {}
 
-func (x *SubscribeResponse_UploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[62]
+func (x *GetWorkflowStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4197,74 +5026,51 @@ func (x *SubscribeResponse_UploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse_UploadResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse_UploadResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use GetWorkflowStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SubscribeResponse_UploadResponse) GetFileId() string {
+func (x *GetWorkflowStatusRequest) GetRunId() string {
 	if x != nil {
-		return x.FileId
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *SubscribeResponse_UploadResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SubscribeResponse_UploadResponse) GetCurrent() int64 {
-	if x != nil {
-		return x.Current
-	}
-	return 0
-}
-
-func (x *SubscribeResponse_UploadResponse) GetWorkerId() int32 {
+func (x *GetWorkflowStatusRequest) GetLogLines() int32 {
 	if x != nil {
-		return x.WorkerId
+		return x.LogLines
 	}
 	return 0
 }
 
-func (x *SubscribeResponse_UploadResponse) GetStatus() SubscribeResponse_UploadResponse_UploadStatus {
-	if x != nil {
-		return x.Status
-	}
-	return SubscribeResponse_UploadResponse_INIT
-}
-
-type SubscribeResponse_DownloadStatusResponse struct {
+type GetWorkflowStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileId  string                                                  `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	Total   int64                                                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Current int64                                                   `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
-	Status  SubscribeResponse_DownloadStatusResponse_DownloadStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.SubscribeResponse_DownloadStatusResponse_DownloadStatus" json:"status,omitempty"`
+	Run *WorkflowRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Log []string     `protobuf:"bytes,2,rep,name=log,proto3" json:"log,omitempty"`
 }
 
-func (x *SubscribeResponse_DownloadStatusResponse) Reset() {
-	*x = SubscribeResponse_DownloadStatusResponse{}
+func (x *GetWorkflowStatusResponse) Reset() {
+	*x = GetWorkflowStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[63]
+		mi := &file_api_v1_agent_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeResponse_DownloadStatusResponse) String() string {
+func (x *GetWorkflowStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse_DownloadStatusResponse) ProtoMessage() {}
+func (*GetWorkflowStatusResponse) ProtoMessage() {}
 
-func (x *SubscribeResponse_DownloadStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[63]
+func (x *GetWorkflowStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4275,67 +5081,50 @@ func (x *SubscribeResponse_DownloadStatusResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse_DownloadStatusResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse_DownloadStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 2}
-}
-
-func (x *SubscribeResponse_DownloadStatusResponse) GetFileId() string {
-	if x != nil {
-		return x.FileId
-	}
-	return ""
-}
-
-func (x *SubscribeResponse_DownloadStatusResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use GetWorkflowStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *SubscribeResponse_DownloadStatusResponse) GetCurrent() int64 {
+func (x *GetWorkflowStatusResponse) GetRun() *WorkflowRun {
 	if x != nil {
-		return x.Current
+		return x.Run
 	}
-	return 0
+	return nil
 }
 
-func (x *SubscribeResponse_DownloadStatusResponse) GetStatus() SubscribeResponse_DownloadStatusResponse_DownloadStatus {
+func (x *GetWorkflowStatusResponse) GetLog() []string {
 	if x != nil {
-		return x.Status
+		return x.Log
 	}
-	return SubscribeResponse_DownloadStatusResponse_INIT
+	return nil
 }
 
-type SubscribeResponse_SyncResponse struct {
+type CancelWorkflowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   SubscribeResponse_SyncResponse_SyncStatus `protobuf:"varint,1,opt,name=status,proto3,enum=v1.SubscribeResponse_SyncResponse_SyncStatus" json:"status,omitempty"`
-	Total    int64                                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	NrSynced int64                                     `protobuf:"varint,3,opt,name=nr_synced,json=nrSynced,proto3" json:"nr_synced,omitempty"`
-	WorkerId int32                                     `protobuf:"varint,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
 
-func (x *SubscribeResponse_SyncResponse) Reset() {
-	*x = SubscribeResponse_SyncResponse{}
+func (x *CancelWorkflowRequest) Reset() {
+	*x = CancelWorkflowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[64]
+		mi := &file_api_v1_agent_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeResponse_SyncResponse) String() string {
+func (x *CancelWorkflowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse_SyncResponse) ProtoMessage() {}
+func (*CancelWorkflowRequest) ProtoMessage() {}
 
-func (x *SubscribeResponse_SyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[64]
+func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4346,72 +5135,51 @@ func (x *SubscribeResponse_SyncResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse_SyncResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse_SyncResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{9, 3}
-}
-
-func (x *SubscribeResponse_SyncResponse) GetStatus() SubscribeResponse_SyncResponse_SyncStatus {
-	if x != nil {
-		return x.Status
-	}
-	return SubscribeResponse_SyncResponse_INIT
-}
-
-func (x *SubscribeResponse_SyncResponse) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SubscribeResponse_SyncResponse) GetNrSynced() int64 {
-	if x != nil {
-		return x.NrSynced
-	}
-	return 0
+// Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SubscribeResponse_SyncResponse) GetWorkerId() int32 {
+func (x *CancelWorkflowRequest) GetRunId() string {
 	if x != nil {
-		return x.WorkerId
+		return x.RunId
 	}
-	return 0
+	return ""
 }
 
-type ListManifestsResponse_Manifest struct {
+type RegisteredWorkflow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NodeId           string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	UserName         string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserId           string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrganizationName string `protobuf:"bytes,5,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
-	OrganizationId   string `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DatasetName      string `protobuf:"bytes,7,opt,name=dataset_name,json=datasetName,proto3" json:"dataset_name,omitempty"`
-	DatasetId        string `protobuf:"bytes,8,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
-	Status           string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Name             string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Version          string                          `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Entrypoint       string                          `protobuf:"bytes,4,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Executor         string                          `protobuf:"bytes,5,opt,name=executor,proto3" json:"executor,omitempty"`
+	Image            string                          `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	Folder           string                          `protobuf:"bytes,7,opt,name=folder,proto3" json:"folder,omitempty"`
+	Parameters       []*RegisteredWorkflow_Parameter `protobuf:"bytes,8,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	ParametersSchema string                          `protobuf:"bytes,9,opt,name=parameters_schema,json=parametersSchema,proto3" json:"parameters_schema,omitempty"` // JSON schema of the parameters
 }
 
-func (x *ListManifestsResponse_Manifest) Reset() {
-	*x = ListManifestsResponse_Manifest{}
+func (x *RegisteredWorkflow) Reset() {
+	*x = RegisteredWorkflow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_agent_proto_msgTypes[65]
+		mi := &file_api_v1_agent_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListManifestsResponse_Manifest) String() string {
+func (x *RegisteredWorkflow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestsResponse_Manifest) ProtoMessage() {}
+func (*RegisteredWorkflow) ProtoMessage() {}
 
-func (x *ListManifestsResponse_Manifest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_agent_proto_msgTypes[65]
+func (x *RegisteredWorkflow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4422,89 +5190,123 @@ func (x *ListManifestsResponse_Manifest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestsResponse_Manifest.ProtoReflect.Descriptor instead.
-func (*ListManifestsResponse_Manifest) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{24, 0}
+// Deprecated: Use RegisteredWorkflow.ProtoReflect.Descriptor instead.
+func (*RegisteredWorkflow) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListManifestsResponse_Manifest) GetId() int32 {
+func (x *RegisteredWorkflow) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetNodeId() string {
+func (x *RegisteredWorkflow) GetDescription() string {
 	if x != nil {
-		return x.NodeId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetUserName() string {
+func (x *RegisteredWorkflow) GetVersion() string {
 	if x != nil {
-		return x.UserName
+		return x.Version
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetUserId() string {
+func (x *RegisteredWorkflow) GetEntrypoint() string {
 	if x != nil {
-		return x.UserId
+		return x.Entrypoint
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetOrganizationName() string {
+func (x *RegisteredWorkflow) GetExecutor() string {
 	if x != nil {
-		return x.OrganizationName
+		return x.Executor
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetOrganizationId() string {
+func (x *RegisteredWorkflow) GetImage() string {
 	if x != nil {
-		return x.OrganizationId
+		return x.Image
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetDatasetName() string {
+func (x *RegisteredWorkflow) GetFolder() string {
 	if x != nil {
-		return x.DatasetName
+		return x.Folder
 	}
 	return ""
 }
 
-func (x *ListManifestsResponse_Manifest) GetDatasetId() string {
+func (x *RegisteredWorkflow) GetParameters() []*RegisteredWorkflow_Parameter {
 	if x != nil {
-		return x.DatasetId
+		return x.Parameters
 	}
-	return ""
+	return nil
 }
 
-func (x *ListManifestsResponse_Manifest) GetStatus() string {
+func (x *RegisteredWorkflow) GetParametersSchema() string {
 	if x != nil {
-		return x.Status
+		return x.ParametersSchema
 	}
 	return ""
 }
 
-type ListManifestFilesResponse_FileUpload struct {
+type ListRegisteredWorkflowsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Id         int32                                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ManifestId int32                                `protobuf:"varint,2,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
-	SourcePath string                               `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	TargetPath string                               `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
-	UploadId   string                               `protobuf:"bytes,5,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
-	Status     ListManifestFilesResponse_StatusType `protobuf:"varint,6,opt,name=status,proto3,enum=v1.ListManifestFilesResponse_StatusType" json:"status,omitempty"`
+func (x *ListRegisteredWorkflowsRequest) Reset() {
+	*x = ListRegisteredWorkflowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *ListManifestFilesResponse_FileUpload) Reset() {
-	*x = ListManifestFilesResponse_FileUpload{}
+func (x *ListRegisteredWorkflowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegisteredWorkflowsRequest) ProtoMessage() {}
+
+func (x *ListRegisteredWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegisteredWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegisteredWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{65}
+}
+
+type ListRegisteredWorkflowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder    string                `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"` // Folder of the workflow registry
+	Workflows []*RegisteredWorkflow `protobuf:"bytes,2,rep,name=workflows,proto3" json:"workflows,omitempty"`
+}
+
+func (x *ListRegisteredWorkflowsResponse) Reset() {
+	*x = ListRegisteredWorkflowsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_agent_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4512,13 +5314,13 @@ func (x *ListManifestFilesResponse_FileUpload) Reset() {
 	}
 }
 
-func (x *ListManifestFilesResponse_FileUpload) String() string {
+func (x *ListRegisteredWorkflowsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListManifestFilesResponse_FileUpload) ProtoMessage() {}
+func (*ListRegisteredWorkflowsResponse) ProtoMessage() {}
 
-func (x *ListManifestFilesResponse_FileUpload) ProtoReflect() protoreflect.Message {
+func (x *ListRegisteredWorkflowsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_agent_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4530,895 +5332,5034 @@ func (x *ListManifestFilesResponse_FileUpload) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListManifestFilesResponse_FileUpload.ProtoReflect.Descriptor instead.
-func (*ListManifestFilesResponse_FileUpload) Descriptor() ([]byte, []int) {
-	return file_api_v1_agent_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use ListRegisteredWorkflowsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegisteredWorkflowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetId() int32 {
+func (x *ListRegisteredWorkflowsResponse) GetFolder() string {
 	if x != nil {
-		return x.Id
+		return x.Folder
 	}
-	return 0
+	return ""
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetManifestId() int32 {
+func (x *ListRegisteredWorkflowsResponse) GetWorkflows() []*RegisteredWorkflow {
 	if x != nil {
-		return x.ManifestId
+		return x.Workflows
 	}
-	return 0
+	return nil
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetSourcePath() string {
+type DescribeWorkflowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DescribeWorkflowRequest) Reset() {
+	*x = DescribeWorkflowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkflowRequest) ProtoMessage() {}
+
+func (x *DescribeWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*DescribeWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DescribeWorkflowRequest) GetName() string {
 	if x != nil {
-		return x.SourcePath
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetTargetPath() string {
+type DescribeWorkflowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workflow *RegisteredWorkflow `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+}
+
+func (x *DescribeWorkflowResponse) Reset() {
+	*x = DescribeWorkflowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeWorkflowResponse) ProtoMessage() {}
+
+func (x *DescribeWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*DescribeWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DescribeWorkflowResponse) GetWorkflow() *RegisteredWorkflow {
 	if x != nil {
-		return x.TargetPath
+		return x.Workflow
 	}
-	return ""
+	return nil
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetUploadId() string {
+type RegisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RegisterRequest) GetAccount() *Account {
 	if x != nil {
-		return x.UploadId
+		return x.Account
 	}
-	return ""
+	return nil
 }
 
-func (x *ListManifestFilesResponse_FileUpload) GetStatus() ListManifestFilesResponse_StatusType {
+func (x *RegisterRequest) GetCredentials() *Credentials {
 	if x != nil {
-		return x.Status
+		return x.Credentials
 	}
-	return ListManifestFilesResponse_LOCAL
+	return nil
 }
 
-var File_api_v1_agent_proto protoreflect.FileDescriptor
+type RegisterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_v1_agent_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x21, 0x0a, 0x0b, 0x50,
-	0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x22,
-	0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x99, 0x01, 0x0a, 0x11,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xf3, 0x01, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x8b, 0x05, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x46, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
-	0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x68, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61,
-	0x74, 0x65, 0x1a, 0x7a, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x1f,
-	0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x4c, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
-	0x0a, 0x0c, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00,
-	0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01,
-	0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
-	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x42, 0x0e, 0x0a,
-	0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x83, 0x0a,
-	0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a,
-	0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x64,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x29, 0x0a,
-	0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0xfa, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
-	0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x31, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0c,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f,
-	0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
-	0x45, 0x54, 0x45, 0x10, 0x02, 0x1a, 0xf1, 0x01, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39,
-	0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e,
-	0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43,
-	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x1a, 0xdc, 0x01, 0x0a, 0x0c, 0x53, 0x79,
-	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
-	0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x72, 0x5f, 0x73, 0x79,
-	0x6e, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x72, 0x53, 0x79,
-	0x6e, 0x63, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x35, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x22, 0x79, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x56, 0x45, 0x4e, 0x54,
-	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f,
-	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59, 0x4e, 0x43,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x57,
-	0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x04, 0x12, 0x13,
-	0x0a, 0x0f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
-	0x4c, 0x10, 0x05, 0x42, 0x0e, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x92,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64,
-	0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63,
-	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65,
-	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x5d, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x10, 0x0a, 0x0e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
-	0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
-	0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x28, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xf5, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09,
-	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x99,
-	0x02, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0xd0, 0x03, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
-	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0xde, 0x01, 0x0a, 0x0a,
-	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a,
-	0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x93, 0x01, 0x0a,
-	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c,
-	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
-	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
-	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45,
-	0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a,
-	0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x41, 0x4e, 0x47,
-	0x45, 0x44, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44,
-	0x10, 0x09, 0x22, 0x59, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x22, 0x10, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xc4, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
-	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76,
-	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72,
-	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x70, 0x69,
-	0x32, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70,
-	0x69, 0x32, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x32, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x13, 0x53, 0x79,
-	0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d,
-	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x28, 0x0a, 0x10, 0x6e, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x72, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x72, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x22, 0x37, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x1c, 0x52, 0x65,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x21, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61,
-	0x74, 0x68, 0x22, 0x5b, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x77,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x22,
-	0xba, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
-	0x12, 0x45, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x22, 0x23, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x22, 0x6b, 0x0a, 0x0f,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x25, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a,
-	0x11, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
-	0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x21, 0x0a, 0x0b,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x57, 0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x10, 0x01, 0x22,
-	0x27, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x50, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42, 0x06,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
-	0x11, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x65,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22,
-	0x2f, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x11, 0x0a, 0x0d, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x55, 0x52, 0x4c,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x01,
-	0x22, 0x24, 0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x6b, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x69, 0x6c, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a,
-	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x0a, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b,
-	0x0a, 0x07, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d,
-	0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f,
-	0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x22, 0x3a, 0x0a, 0x0f, 0x4d, 0x61, 0x70,
-	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31,
-	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x73, 0x22, 0x50, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x95, 0x11, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x12, 0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x41,
-	0x64, 0x64, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
-	0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
-	0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x03, 0x4d,
-	0x61, 0x70, 0x12, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33,
-	0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x0f, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x44,
-	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x34, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x2b, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a,
-	0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3d, 0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3f, 0x0a, 0x0e, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x15,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x41, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x13,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x44, 0x65,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46,
-	0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a,
-	0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30,
-	0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e,
-	0x6e, 0x73, 0x69, 0x65, 0x76, 0x65, 0x2f, 0x70, 0x65, 0x6e, 0x6e, 0x73, 0x69, 0x65, 0x76, 0x65,
-	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
-var (
-	file_api_v1_agent_proto_rawDescOnce sync.Once
-	file_api_v1_agent_proto_rawDescData = file_api_v1_agent_proto_rawDesc
-)
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_v1_agent_proto_rawDescGZIP() []byte {
-	file_api_v1_agent_proto_rawDescOnce.Do(func() {
-		file_api_v1_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_agent_proto_rawDescData)
-	})
-	return file_api_v1_agent_proto_rawDescData
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_api_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
-var file_api_v1_agent_proto_goTypes = []interface{}{
-	(GetTimeseriesRangeResponse_MessageType)(0),                  // 0: v1.GetTimeseriesRangeResponse.MessageType
-	(SubscribeResponse_MessageType)(0),                           // 1: v1.SubscribeResponse.MessageType
-	(SubscribeResponse_UploadResponse_UploadStatus)(0),           // 2: v1.SubscribeResponse.UploadResponse.UploadStatus
-	(SubscribeResponse_DownloadStatusResponse_DownloadStatus)(0), // 3: v1.SubscribeResponse.DownloadStatusResponse.DownloadStatus
-	(SubscribeResponse_SyncResponse_SyncStatus)(0),               // 4: v1.SubscribeResponse.SyncResponse.SyncStatus
-	(ListManifestFilesResponse_StatusType)(0),                    // 5: v1.ListManifestFilesResponse.StatusType
-	(WorkflowResponse_WorkflowType)(0),                           // 6: v1.WorkflowResponse.WorkflowType
-	(Account_AccountType)(0),                                     // 7: v1.Account.AccountType
-	(DownloadRequest_DownloadType)(0),                            // 8: v1.DownloadRequest.DownloadType
-	(DownloadResponse_ResponseType)(0),                           // 9: v1.DownloadResponse.ResponseType
-	(PackageStatus_StatusType)(0),                                // 10: v1.packageStatus.StatusType
-	(*PullRequest)(nil),                                          // 11: v1.PullRequest
-	(*PushRequest)(nil),                                          // 12: v1.PushRequest
-	(*SubscribeRequest)(nil),                                     // 13: v1.SubscribeRequest
-	(*ResetCacheRequest)(nil),                                    // 14: v1.ResetCacheRequest
-	(*GetTimeseriesChannelsRequest)(nil),                         // 15: v1.GetTimeseriesChannelsRequest
-	(*TimeseriesChannel)(nil),                                    // 16: v1.TimeseriesChannel
-	(*GetTimeseriesChannelsResponse)(nil),                        // 17: v1.GetTimeseriesChannelsResponse
-	(*GetTimeseriesRangeRequest)(nil),                            // 18: v1.GetTimeseriesRangeRequest
-	(*GetTimeseriesRangeResponse)(nil),                           // 19: v1.GetTimeseriesRangeResponse
-	(*SubscribeResponse)(nil),                                    // 20: v1.SubscribeResponse
-	(*SimpleStatusResponse)(nil),                                 // 21: v1.SimpleStatusResponse
-	(*CancelUploadRequest)(nil),                                  // 22: v1.CancelUploadRequest
-	(*CancelDownloadRequest)(nil),                                // 23: v1.CancelDownloadRequest
-	(*CreateManifestRequest)(nil),                                // 24: v1.CreateManifestRequest
-	(*CreateManifestResponse)(nil),                               // 25: v1.CreateManifestResponse
-	(*AddToManifestRequest)(nil),                                 // 26: v1.AddToManifestRequest
-	(*RemoveFromManifestRequest)(nil),                            // 27: v1.RemoveFromManifestRequest
-	(*VersionRequest)(nil),                                       // 28: v1.VersionRequest
-	(*VersionResponse)(nil),                                      // 29: v1.VersionResponse
-	(*PingRequest)(nil),                                          // 30: v1.PingRequest
-	(*PingResponse)(nil),                                         // 31: v1.PingResponse
-	(*StopRequest)(nil),                                          // 32: v1.StopRequest
-	(*StopResponse)(nil),                                         // 33: v1.StopResponse
-	(*ListManifestsRequest)(nil),                                 // 34: v1.ListManifestsRequest
-	(*ListManifestsResponse)(nil),                                // 35: v1.ListManifestsResponse
-	(*DeleteManifestRequest)(nil),                                // 36: v1.DeleteManifestRequest
-	(*ListManifestFilesRequest)(nil),                             // 37: v1.ListManifestFilesRequest
-	(*ListManifestFilesResponse)(nil),                            // 38: v1.ListManifestFilesResponse
-	(*UploadManifestRequest)(nil),                                // 39: v1.UploadManifestRequest
-	(*GetUserRequest)(nil),                                       // 40: v1.GetUserRequest
-	(*UserResponse)(nil),                                         // 41: v1.UserResponse
-	(*SwitchProfileRequest)(nil),                                 // 42: v1.SwitchProfileRequest
-	(*ReAuthenticateRequest)(nil),                                // 43: v1.ReAuthenticateRequest
-	(*UseDatasetRequest)(nil),                                    // 44: v1.UseDatasetRequest
-	(*UseDatasetResponse)(nil),                                   // 45: v1.UseDatasetResponse
-	(*SyncManifestRequest)(nil),                                  // 46: v1.SyncManifestRequest
-	(*SyncManifestResponse)(nil),                                 // 47: v1.SyncManifestResponse
-	(*ResetManifestRequest)(nil),                                 // 48: v1.ResetManifestRequest
-	(*RelocateManifestFilesRequest)(nil),                         // 49: v1.RelocateManifestFilesRequest
-	(*StartWorkflowRequest)(nil),                                 // 50: v1.StartWorkflowRequest
-	(*WorkflowResponse)(nil),                                     // 51: v1.WorkflowResponse
-	(*RegisterRequest)(nil),                                      // 52: v1.RegisterRequest
-	(*RegisterResponse)(nil),                                     // 53: v1.RegisterResponse
-	(*DeregisterRequest)(nil),                                    // 54: v1.DeregisterRequest
-	(*DeregisterResponse)(nil),                                   // 55: v1.DeregisterResponse
-	(*Account)(nil),                                              // 56: v1.Account
-	(*Credentials)(nil),                                          // 57: v1.Credentials
-	(*MapRequest)(nil),                                           // 58: v1.MapRequest
-	(*DownloadRequest)(nil),                                      // 59: v1.DownloadRequest
-	(*DownloadDatasetRequest)(nil),                               // 60: v1.DownloadDatasetRequest
-	(*DownloadPackageRequest)(nil),                               // 61: v1.DownloadPackageRequest
-	(*DownloadResponse)(nil),                                     // 62: v1.DownloadResponse
-	(*MapDiffRequest)(nil),                                       // 63: v1.MapDiffRequest
-	(*FileInfo)(nil),                                             // 64: v1.fileInfo
-	(*PackageStatus)(nil),                                        // 65: v1.packageStatus
-	(*MapDiffResponse)(nil),                                      // 66: v1.MapDiffResponse
-	(*UpdateRoleRequest)(nil),                                    // 67: v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),                                   // 68: v1.UpdateRoleResponse
-	(*GetTimeseriesRangeResponse_ChannelInfo)(nil),               // 69: v1.GetTimeseriesRangeResponse.ChannelInfo
-	(*GetTimeseriesRangeResponse_RangeData)(nil),                 // 70: v1.GetTimeseriesRangeResponse.RangeData
-	(*GetTimeseriesRangeResponse_ErrorData)(nil),                 // 71: v1.GetTimeseriesRangeResponse.ErrorData
-	(*SubscribeResponse_EventResponse)(nil),                      // 72: v1.SubscribeResponse.EventResponse
-	(*SubscribeResponse_UploadResponse)(nil),                     // 73: v1.SubscribeResponse.UploadResponse
-	(*SubscribeResponse_DownloadStatusResponse)(nil),             // 74: v1.SubscribeResponse.DownloadStatusResponse
-	(*SubscribeResponse_SyncResponse)(nil),                       // 75: v1.SubscribeResponse.SyncResponse
-	(*ListManifestsResponse_Manifest)(nil),                       // 76: v1.ListManifestsResponse.Manifest
-	(*ListManifestFilesResponse_FileUpload)(nil),                 // 77: v1.ListManifestFilesResponse.FileUpload
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_v1_agent_proto_depIdxs = []int32{
-	16, // 0: v1.GetTimeseriesChannelsResponse.channel:type_name -> v1.TimeseriesChannel
-	0,  // 1: v1.GetTimeseriesRangeResponse.type:type_name -> v1.GetTimeseriesRangeResponse.MessageType
-	71, // 2: v1.GetTimeseriesRangeResponse.error:type_name -> v1.GetTimeseriesRangeResponse.ErrorData
-	70, // 3: v1.GetTimeseriesRangeResponse.data:type_name -> v1.GetTimeseriesRangeResponse.RangeData
-	69, // 4: v1.GetTimeseriesRangeResponse.channel:type_name -> v1.GetTimeseriesRangeResponse.ChannelInfo
-	1,  // 5: v1.SubscribeResponse.type:type_name -> v1.SubscribeResponse.MessageType
-	73, // 6: v1.SubscribeResponse.upload_status:type_name -> v1.SubscribeResponse.UploadResponse
-	72, // 7: v1.SubscribeResponse.event_info:type_name -> v1.SubscribeResponse.EventResponse
-	75, // 8: v1.SubscribeResponse.sync_status:type_name -> v1.SubscribeResponse.SyncResponse
-	74, // 9: v1.SubscribeResponse.download_status:type_name -> v1.SubscribeResponse.DownloadStatusResponse
-	76, // 10: v1.ListManifestsResponse.manifests:type_name -> v1.ListManifestsResponse.Manifest
-	77, // 11: v1.ListManifestFilesResponse.file:type_name -> v1.ListManifestFilesResponse.FileUpload
-	6,  // 12: v1.WorkflowResponse.workflowType:type_name -> v1.WorkflowResponse.WorkflowType
-	56, // 13: v1.RegisterRequest.account:type_name -> v1.Account
-	57, // 14: v1.RegisterRequest.credentials:type_name -> v1.Credentials
-	56, // 15: v1.DeregisterRequest.account:type_name -> v1.Account
-	57, // 16: v1.DeregisterRequest.credentials:type_name -> v1.Credentials
-	7,  // 17: v1.Account.type:type_name -> v1.Account.AccountType
-	8,  // 18: v1.DownloadRequest.type:type_name -> v1.DownloadRequest.DownloadType
-	60, // 19: v1.DownloadRequest.dataset:type_name -> v1.DownloadDatasetRequest
-	61, // 20: v1.DownloadRequest.package:type_name -> v1.DownloadPackageRequest
-	9,  // 21: v1.DownloadResponse.type:type_name -> v1.DownloadResponse.ResponseType
-	64, // 22: v1.packageStatus.content:type_name -> v1.fileInfo
-	10, // 23: v1.packageStatus.changeType:type_name -> v1.packageStatus.StatusType
-	65, // 24: v1.MapDiffResponse.files:type_name -> v1.packageStatus
-	56, // 25: v1.UpdateRoleRequest.account:type_name -> v1.Account
-	57, // 26: v1.UpdateRoleRequest.credentials:type_name -> v1.Credentials
-	2,  // 27: v1.SubscribeResponse.UploadResponse.status:type_name -> v1.SubscribeResponse.UploadResponse.UploadStatus
-	3,  // 28: v1.SubscribeResponse.DownloadStatusResponse.status:type_name -> v1.SubscribeResponse.DownloadStatusResponse.DownloadStatus
-	4,  // 29: v1.SubscribeResponse.SyncResponse.status:type_name -> v1.SubscribeResponse.SyncResponse.SyncStatus
-	5,  // 30: v1.ListManifestFilesResponse.FileUpload.status:type_name -> v1.ListManifestFilesResponse.StatusType
-	24, // 31: v1.Agent.CreateManifest:input_type -> v1.CreateManifestRequest
-	26, // 32: v1.Agent.AddToManifest:input_type -> v1.AddToManifestRequest
-	27, // 33: v1.Agent.RemoveFromManifest:input_type -> v1.RemoveFromManifestRequest
-	36, // 34: v1.Agent.DeleteManifest:input_type -> v1.DeleteManifestRequest
-	34, // 35: v1.Agent.ListManifests:input_type -> v1.ListManifestsRequest
-	37, // 36: v1.Agent.ListManifestFiles:input_type -> v1.ListManifestFilesRequest
-	49, // 37: v1.Agent.RelocateManifestFiles:input_type -> v1.RelocateManifestFilesRequest
-	46, // 38: v1.Agent.SyncManifest:input_type -> v1.SyncManifestRequest
-	48, // 39: v1.Agent.ResetManifest:input_type -> v1.ResetManifestRequest
-	39, // 40: v1.Agent.UploadManifest:input_type -> v1.UploadManifestRequest
-	22, // 41: v1.Agent.CancelUpload:input_type -> v1.CancelUploadRequest
-	59, // 42: v1.Agent.Download:input_type -> v1.DownloadRequest
-	23, // 43: v1.Agent.CancelDownload:input_type -> v1.CancelDownloadRequest
-	58, // 44: v1.Agent.Map:input_type -> v1.MapRequest
-	11, // 45: v1.Agent.Pull:input_type -> v1.PullRequest
-	12, // 46: v1.Agent.Push:input_type -> v1.PushRequest
-	63, // 47: v1.Agent.GetMapDiff:input_type -> v1.MapDiffRequest
-	28, // 48: v1.Agent.Version:input_type -> v1.VersionRequest
-	13, // 49: v1.Agent.Subscribe:input_type -> v1.SubscribeRequest
-	13, // 50: v1.Agent.Unsubscribe:input_type -> v1.SubscribeRequest
-	32, // 51: v1.Agent.Stop:input_type -> v1.StopRequest
-	30, // 52: v1.Agent.Ping:input_type -> v1.PingRequest
-	40, // 53: v1.Agent.GetUser:input_type -> v1.GetUserRequest
-	42, // 54: v1.Agent.SwitchProfile:input_type -> v1.SwitchProfileRequest
-	43, // 55: v1.Agent.ReAuthenticate:input_type -> v1.ReAuthenticateRequest
-	44, // 56: v1.Agent.UseDataset:input_type -> v1.UseDatasetRequest
-	50, // 57: v1.Agent.StartWorkflow:input_type -> v1.StartWorkflowRequest
-	52, // 58: v1.Agent.Register:input_type -> v1.RegisterRequest
-	67, // 59: v1.Agent.UpdateRole:input_type -> v1.UpdateRoleRequest
-	54, // 60: v1.Agent.Deregister:input_type -> v1.DeregisterRequest
-	15, // 61: v1.Agent.GetTimeseriesChannels:input_type -> v1.GetTimeseriesChannelsRequest
-	18, // 62: v1.Agent.GetTimeseriesRangeForChannels:input_type -> v1.GetTimeseriesRangeRequest
-	14, // 63: v1.Agent.ResetCache:input_type -> v1.ResetCacheRequest
-	25, // 64: v1.Agent.CreateManifest:output_type -> v1.CreateManifestResponse
-	21, // 65: v1.Agent.AddToManifest:output_type -> v1.SimpleStatusResponse
-	21, // 66: v1.Agent.RemoveFromManifest:output_type -> v1.SimpleStatusResponse
-	21, // 67: v1.Agent.DeleteManifest:output_type -> v1.SimpleStatusResponse
-	35, // 68: v1.Agent.ListManifests:output_type -> v1.ListManifestsResponse
-	38, // 69: v1.Agent.ListManifestFiles:output_type -> v1.ListManifestFilesResponse
-	21, // 70: v1.Agent.RelocateManifestFiles:output_type -> v1.SimpleStatusResponse
-	47, // 71: v1.Agent.SyncManifest:output_type -> v1.SyncManifestResponse
-	21, // 72: v1.Agent.ResetManifest:output_type -> v1.SimpleStatusResponse
-	21, // 73: v1.Agent.UploadManifest:output_type -> v1.SimpleStatusResponse
-	21, // 74: v1.Agent.CancelUpload:output_type -> v1.SimpleStatusResponse
-	62, // 75: v1.Agent.Download:output_type -> v1.DownloadResponse
-	21, // 76: v1.Agent.CancelDownload:output_type -> v1.SimpleStatusResponse
-	21, // 77: v1.Agent.Map:output_type -> v1.SimpleStatusResponse
-	21, // 78: v1.Agent.Pull:output_type -> v1.SimpleStatusResponse
-	21, // 79: v1.Agent.Push:output_type -> v1.SimpleStatusResponse
-	66, // 80: v1.Agent.GetMapDiff:output_type -> v1.MapDiffResponse
-	29, // 81: v1.Agent.Version:output_type -> v1.VersionResponse
-	20, // 82: v1.Agent.Subscribe:output_type -> v1.SubscribeResponse
-	20, // 83: v1.Agent.Unsubscribe:output_type -> v1.SubscribeResponse
-	33, // 84: v1.Agent.Stop:output_type -> v1.StopResponse
-	31, // 85: v1.Agent.Ping:output_type -> v1.PingResponse
-	41, // 86: v1.Agent.GetUser:output_type -> v1.UserResponse
-	41, // 87: v1.Agent.SwitchProfile:output_type -> v1.UserResponse
-	41, // 88: v1.Agent.ReAuthenticate:output_type -> v1.UserResponse
-	45, // 89: v1.Agent.UseDataset:output_type -> v1.UseDatasetResponse
-	51, // 90: v1.Agent.StartWorkflow:output_type -> v1.WorkflowResponse
-	53, // 91: v1.Agent.Register:output_type -> v1.RegisterResponse
-	68, // 92: v1.Agent.UpdateRole:output_type -> v1.UpdateRoleResponse
-	55, // 93: v1.Agent.Deregister:output_type -> v1.DeregisterResponse
-	17, // 94: v1.Agent.GetTimeseriesChannels:output_type -> v1.GetTimeseriesChannelsResponse
-	19, // 95: v1.Agent.GetTimeseriesRangeForChannels:output_type -> v1.GetTimeseriesRangeResponse
-	21, // 96: v1.Agent.ResetCache:output_type -> v1.SimpleStatusResponse
-	64, // [64:97] is the sub-list for method output_type
-	31, // [31:64] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{70}
 }
 
-func init() { file_api_v1_agent_proto_init() }
-func file_api_v1_agent_proto_init() {
-	if File_api_v1_agent_proto != nil {
-		return
+func (x *RegisterResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_v1_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullRequest); i {
+	return ""
+}
+
+type DeregisterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Force       bool         `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *DeregisterRequest) Reset() {
+	*x = DeregisterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterRequest) ProtoMessage() {}
+
+func (x *DeregisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterRequest.ProtoReflect.Descriptor instead.
+func (*DeregisterRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeregisterRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *DeregisterRequest) GetCredentials() *Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *DeregisterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeregisterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	RoleName  string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+}
+
+func (x *DeregisterResponse) Reset() {
+	*x = DeregisterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResponse) ProtoMessage() {}
+
+func (x *DeregisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResponse.ProtoReflect.Descriptor instead.
+func (*DeregisterResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeregisterResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DeregisterResponse) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Account_AccountType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.Account_AccountType" json:"type,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Account) GetType() Account_AccountType {
+	if x != nil {
+		return x.Type
+	}
+	return Account_AWS
+}
+
+type Credentials struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *Credentials) Reset() {
+	*x = Credentials{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Credentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credentials) ProtoMessage() {}
+
+func (x *Credentials) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credentials.ProtoReflect.Descriptor instead.
+func (*Credentials) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Credentials) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type MapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId    string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	TargetFolder string `protobuf:"bytes,2,opt,name=target_folder,json=targetFolder,proto3" json:"target_folder,omitempty"`
+}
+
+func (x *MapRequest) Reset() {
+	*x = MapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapRequest) ProtoMessage() {}
+
+func (x *MapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapRequest.ProtoReflect.Descriptor instead.
+func (*MapRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MapRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *MapRequest) GetTargetFolder() string {
+	if x != nil {
+		return x.TargetFolder
+	}
+	return ""
+}
+
+type DownloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DownloadRequest_DownloadType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.DownloadRequest_DownloadType" json:"type,omitempty"`
+	// Types that are assignable to Data:
+	//
+	//	*DownloadRequest_Dataset
+	//	*DownloadRequest_Package
+	Data isDownloadRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *DownloadRequest) Reset() {
+	*x = DownloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRequest) ProtoMessage() {}
+
+func (x *DownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRequest.ProtoReflect.Descriptor instead.
+func (*DownloadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DownloadRequest) GetType() DownloadRequest_DownloadType {
+	if x != nil {
+		return x.Type
+	}
+	return DownloadRequest_PACKAGE
+}
+
+func (m *DownloadRequest) GetData() isDownloadRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DownloadRequest) GetDataset() *DownloadDatasetRequest {
+	if x, ok := x.GetData().(*DownloadRequest_Dataset); ok {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *DownloadRequest) GetPackage() *DownloadPackageRequest {
+	if x, ok := x.GetData().(*DownloadRequest_Package); ok {
+		return x.Package
+	}
+	return nil
+}
+
+type isDownloadRequest_Data interface {
+	isDownloadRequest_Data()
+}
+
+type DownloadRequest_Dataset struct {
+	Dataset *DownloadDatasetRequest `protobuf:"bytes,9,opt,name=dataset,proto3,oneof"`
+}
+
+type DownloadRequest_Package struct {
+	Package *DownloadPackageRequest `protobuf:"bytes,10,opt,name=package,proto3,oneof"`
+}
+
+func (*DownloadRequest_Dataset) isDownloadRequest_Data() {}
+
+func (*DownloadRequest_Package) isDownloadRequest_Data() {}
+
+type DownloadDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DatasetId    string `protobuf:"bytes,1,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	TargetFolder string `protobuf:"bytes,2,opt,name=target_folder,json=targetFolder,proto3" json:"target_folder,omitempty"`
+}
+
+func (x *DownloadDatasetRequest) Reset() {
+	*x = DownloadDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDatasetRequest) ProtoMessage() {}
+
+func (x *DownloadDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDatasetRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DownloadDatasetRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *DownloadDatasetRequest) GetTargetFolder() string {
+	if x != nil {
+		return x.TargetFolder
+	}
+	return ""
+}
+
+type DownloadPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageId       string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	GetPresignedUrl bool   `protobuf:"varint,2,opt,name=get_presigned_url,json=getPresignedUrl,proto3" json:"get_presigned_url,omitempty"`
+}
+
+func (x *DownloadPackageRequest) Reset() {
+	*x = DownloadPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadPackageRequest) ProtoMessage() {}
+
+func (x *DownloadPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadPackageRequest.ProtoReflect.Descriptor instead.
+func (*DownloadPackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DownloadPackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *DownloadPackageRequest) GetGetPresignedUrl() bool {
+	if x != nil {
+		return x.GetPresignedUrl
+	}
+	return false
+}
+
+type DownloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   DownloadResponse_ResponseType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.DownloadResponse_ResponseType" json:"type,omitempty"`
+	Status string                        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Url    []string                      `protobuf:"bytes,3,rep,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *DownloadResponse) Reset() {
+	*x = DownloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadResponse) ProtoMessage() {}
+
+func (x *DownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadResponse.ProtoReflect.Descriptor instead.
+func (*DownloadResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DownloadResponse) GetType() DownloadResponse_ResponseType {
+	if x != nil {
+		return x.Type
+	}
+	return DownloadResponse_PRESIGNED_URL
+}
+
+func (x *DownloadResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DownloadResponse) GetUrl() []string {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+type FetchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *FetchRequest) Reset() {
+	*x = FetchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRequest) ProtoMessage() {}
+
+func (x *FetchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRequest.ProtoReflect.Descriptor instead.
+func (*FetchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *FetchRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FetchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Files  []*PackageStatus `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *FetchResponse) Reset() {
+	*x = FetchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchResponse) ProtoMessage() {}
+
+func (x *FetchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchResponse.ProtoReflect.Descriptor instead.
+func (*FetchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FetchResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FetchResponse) GetFiles() []*PackageStatus {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type MapDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string                  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Strength MapDiffRequest_Strength `protobuf:"varint,2,opt,name=strength,proto3,enum=v1.MapDiffRequest_Strength" json:"strength,omitempty"`
+}
+
+func (x *MapDiffRequest) Reset() {
+	*x = MapDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapDiffRequest) ProtoMessage() {}
+
+func (x *MapDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapDiffRequest.ProtoReflect.Descriptor instead.
+func (*MapDiffRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *MapDiffRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *MapDiffRequest) GetStrength() MapDiffRequest_Strength {
+	if x != nil {
+		return x.Strength
+	}
+	return MapDiffRequest_FAST
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Path      string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Message   string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FileInfo) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *FileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PackageStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content    *FileInfo                `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ChangeType PackageStatus_StatusType `protobuf:"varint,2,opt,name=changeType,proto3,enum=v1.PackageStatus_StatusType" json:"changeType,omitempty"`
+}
+
+func (x *PackageStatus) Reset() {
+	*x = PackageStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageStatus) ProtoMessage() {}
+
+func (x *PackageStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageStatus.ProtoReflect.Descriptor instead.
+func (*PackageStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PackageStatus) GetContent() *FileInfo {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PackageStatus) GetChangeType() PackageStatus_StatusType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return PackageStatus_ADDED
+}
+
+type MapDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*PackageStatus `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *MapDiffResponse) Reset() {
+	*x = MapDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapDiffResponse) ProtoMessage() {}
+
+func (x *MapDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapDiffResponse.ProtoReflect.Descriptor instead.
+func (*MapDiffResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MapDiffResponse) GetFiles() []*PackageStatus {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ResolveConflictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string                            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Resolution ResolveConflictRequest_Resolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=v1.ResolveConflictRequest_Resolution" json:"resolution,omitempty"`
+}
+
+func (x *ResolveConflictRequest) Reset() {
+	*x = ResolveConflictRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveConflictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveConflictRequest) ProtoMessage() {}
+
+func (x *ResolveConflictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveConflictRequest.ProtoReflect.Descriptor instead.
+func (*ResolveConflictRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ResolveConflictRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ResolveConflictRequest) GetResolution() ResolveConflictRequest_Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return ResolveConflictRequest_KEEP_LOCAL
+}
+
+type EvictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *EvictRequest) Reset() {
+	*x = EvictRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictRequest) ProtoMessage() {}
+
+func (x *EvictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictRequest.ProtoReflect.Descriptor instead.
+func (*EvictRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *EvictRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type EvictResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string                       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FreedBytes int64                        `protobuf:"varint,2,opt,name=freed_bytes,json=freedBytes,proto3" json:"freed_bytes,omitempty"`
+	Files      []*EvictResponse_EvictedFile `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *EvictResponse) Reset() {
+	*x = EvictResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictResponse) ProtoMessage() {}
+
+func (x *EvictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictResponse.ProtoReflect.Descriptor instead.
+func (*EvictResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *EvictResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EvictResponse) GetFreedBytes() int64 {
+	if x != nil {
+		return x.FreedBytes
+	}
+	return 0
+}
+
+func (x *EvictResponse) GetFiles() []*EvictResponse_EvictedFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account     *Account     `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UpdateRoleRequest) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *UpdateRoleRequest) GetCredentials() *Credentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type UpdateRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	RoleName  string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+}
+
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UpdateRoleResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UpdateRoleResponse) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type GetTimeseriesCacheStatsResponse_PackageCacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageId  string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Blocks     int64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Size       int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                               // Size of cached blocks in bytes
+	LastAccess int64  `protobuf:"varint,4,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty"` // Unix time at which a block of the package was last used
+	Pinned     bool   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`                           // Blocks of pinned packages are never evicted
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) Reset() {
+	*x = GetTimeseriesCacheStatsResponse_PackageCacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesCacheStatsResponse_PackageCacheStats) ProtoMessage() {}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesCacheStatsResponse_PackageCacheStats.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesCacheStatsResponse_PackageCacheStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) GetLastAccess() int64 {
+	if x != nil {
+		return x.LastAccess
+	}
+	return 0
+}
+
+func (x *GetTimeseriesCacheStatsResponse_PackageCacheStats) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type GetTimeseriesGapsResponse_Gap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetTimeseriesGapsResponse_Gap) Reset() {
+	*x = GetTimeseriesGapsResponse_Gap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesGapsResponse_Gap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesGapsResponse_Gap) ProtoMessage() {}
+
+func (x *GetTimeseriesGapsResponse_Gap) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesGapsResponse_Gap.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesGapsResponse_Gap) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetTimeseriesGapsResponse_Gap) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetTimeseriesGapsResponse_Gap) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetTimeseriesGapsResponse_ChannelGaps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId     string                           `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name          string                           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gaps          []*GetTimeseriesGapsResponse_Gap `protobuf:"bytes,3,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	TotalDuration uint64                           `protobuf:"varint,4,opt,name=total_duration,json=totalDuration,proto3" json:"total_duration,omitempty"` // Total duration of the gaps in microseconds
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) Reset() {
+	*x = GetTimeseriesGapsResponse_ChannelGaps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesGapsResponse_ChannelGaps) ProtoMessage() {}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesGapsResponse_ChannelGaps.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesGapsResponse_ChannelGaps) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) GetGaps() []*GetTimeseriesGapsResponse_Gap {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+func (x *GetTimeseriesGapsResponse_ChannelGaps) GetTotalDuration() uint64 {
+	if x != nil {
+		return x.TotalDuration
+	}
+	return 0
+}
+
+type GetTimeseriesAnnotationsResponse_Layer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Color       string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) Reset() {
+	*x = GetTimeseriesAnnotationsResponse_Layer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesAnnotationsResponse_Layer) ProtoMessage() {}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesAnnotationsResponse_Layer.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesAnnotationsResponse_Layer) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Layer) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type GetTimeseriesAnnotationsResponse_Annotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LayerId     int64    `protobuf:"varint,2,opt,name=layer_id,json=layerId,proto3" json:"layer_id,omitempty"`
+	ChannelIds  []string `protobuf:"bytes,3,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"` // Channels of the annotation; all channels if empty
+	Name        string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Label       string   `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	Description string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Start       uint64   `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
+	End         uint64   `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) Reset() {
+	*x = GetTimeseriesAnnotationsResponse_Annotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesAnnotationsResponse_Annotation) ProtoMessage() {}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesAnnotationsResponse_Annotation.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesAnnotationsResponse_Annotation) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetLayerId() int64 {
+	if x != nil {
+		return x.LayerId
+	}
+	return 0
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetTimeseriesAnnotationsResponse_Annotation) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetTimeseriesRangeResponse_ChannelInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string                                   `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name      string                                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit      string                                   `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Rate      float32                                  `protobuf:"fixed32,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Encoding  GetTimeseriesRangeRequest_SampleEncoding `protobuf:"varint,5,opt,name=encoding,proto3,enum=v1.GetTimeseriesRangeRequest_SampleEncoding" json:"encoding,omitempty"` // Encoding of the data of the channel
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) Reset() {
+	*x = GetTimeseriesRangeResponse_ChannelInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesRangeResponse_ChannelInfo) ProtoMessage() {}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeResponse_ChannelInfo.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse_ChannelInfo) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_ChannelInfo) GetEncoding() GetTimeseriesRangeRequest_SampleEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return GetTimeseriesRangeRequest_FLOAT32
+}
+
+type GetTimeseriesRangeResponse_RangeData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start        uint64                                   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End          uint64                                   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Rate         float32                                  `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	ChannelId    string                                   `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Data         []float32                                `protobuf:"fixed32,5,rep,packed,name=data,proto3" json:"data,omitempty"`
+	IsMinMax     bool                                     `protobuf:"varint,6,opt,name=is_min_max,json=isMinMax,proto3" json:"is_min_max,omitempty"`            // Data contains a (min, max) pair per sample period
+	SamplePeriod float64                                  `protobuf:"fixed64,7,opt,name=sample_period,json=samplePeriod,proto3" json:"sample_period,omitempty"` // Period between points in microseconds
+	Timestamps   []uint64                                 `protobuf:"varint,8,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`                   // Time of each point for LTTB decimated data
+	Encoding     GetTimeseriesRangeRequest_SampleEncoding `protobuf:"varint,9,opt,name=encoding,proto3,enum=v1.GetTimeseriesRangeRequest_SampleEncoding" json:"encoding,omitempty"`
+	DataFloat64  []float64                                `protobuf:"fixed64,10,rep,packed,name=data_float64,json=dataFloat64,proto3" json:"data_float64,omitempty"`
+	DataInt      []int32                                  `protobuf:"zigzag32,11,rep,packed,name=data_int,json=dataInt,proto3" json:"data_int,omitempty"` // Point values are offset + data_int * scale
+	Scale        float64                                  `protobuf:"fixed64,12,opt,name=scale,proto3" json:"scale,omitempty"`
+	Offset       float64                                  `protobuf:"fixed64,13,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) Reset() {
+	*x = GetTimeseriesRangeResponse_RangeData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesRangeResponse_RangeData) ProtoMessage() {}
+
+func (x *GetTimeseriesRangeResponse_RangeData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeResponse_RangeData.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse_RangeData) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetData() []float32 {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetIsMinMax() bool {
+	if x != nil {
+		return x.IsMinMax
+	}
+	return false
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetSamplePeriod() float64 {
+	if x != nil {
+		return x.SamplePeriod
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetTimestamps() []uint64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetEncoding() GetTimeseriesRangeRequest_SampleEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return GetTimeseriesRangeRequest_FLOAT32
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetDataFloat64() []float64 {
+	if x != nil {
+		return x.DataFloat64
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetDataInt() []int32 {
+	if x != nil {
+		return x.DataInt
+	}
+	return nil
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_RangeData) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// RangeStatus is sent after all data for the request is sent.
+type GetTimeseriesRangeResponse_RangeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offline bool `protobuf:"varint,1,opt,name=offline,proto3" json:"offline,omitempty"` // Data was only read from the local cache
+	Partial bool `protobuf:"varint,2,opt,name=partial,proto3" json:"partial,omitempty"` // Cached data does not cover the full requested range
+}
+
+func (x *GetTimeseriesRangeResponse_RangeStatus) Reset() {
+	*x = GetTimeseriesRangeResponse_RangeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesRangeResponse_RangeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesRangeResponse_RangeStatus) ProtoMessage() {}
+
+func (x *GetTimeseriesRangeResponse_RangeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeResponse_RangeStatus.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse_RangeStatus) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 2}
+}
+
+func (x *GetTimeseriesRangeResponse_RangeStatus) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *GetTimeseriesRangeResponse_RangeStatus) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// GapData marks a period without recorded data between two ranges of a channel.
+type GetTimeseriesRangeResponse_GapData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Start     uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End       uint64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *GetTimeseriesRangeResponse_GapData) Reset() {
+	*x = GetTimeseriesRangeResponse_GapData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesRangeResponse_GapData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesRangeResponse_GapData) ProtoMessage() {}
+
+func (x *GetTimeseriesRangeResponse_GapData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeResponse_GapData.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse_GapData) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 3}
+}
+
+func (x *GetTimeseriesRangeResponse_GapData) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetTimeseriesRangeResponse_GapData) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetTimeseriesRangeResponse_GapData) GetEnd() uint64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type GetTimeseriesRangeResponse_ErrorData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Info string `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *GetTimeseriesRangeResponse_ErrorData) Reset() {
+	*x = GetTimeseriesRangeResponse_ErrorData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTimeseriesRangeResponse_ErrorData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeseriesRangeResponse_ErrorData) ProtoMessage() {}
+
+func (x *GetTimeseriesRangeResponse_ErrorData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeseriesRangeResponse_ErrorData.ProtoReflect.Descriptor instead.
+func (*GetTimeseriesRangeResponse_ErrorData) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{18, 4}
+}
+
+func (x *GetTimeseriesRangeResponse_ErrorData) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+type SubscribeResponse_EventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Details string `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *SubscribeResponse_EventResponse) Reset() {
+	*x = SubscribeResponse_EventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse_EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse_EventResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse_EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse_EventResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse_EventResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *SubscribeResponse_EventResponse) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type SubscribeResponse_UploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId   string                                        `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Total    int64                                         `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Current  int64                                         `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	WorkerId int32                                         `protobuf:"varint,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Status   SubscribeResponse_UploadResponse_UploadStatus `protobuf:"varint,5,opt,name=status,proto3,enum=v1.SubscribeResponse_UploadResponse_UploadStatus" json:"status,omitempty"`
+}
+
+func (x *SubscribeResponse_UploadResponse) Reset() {
+	*x = SubscribeResponse_UploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse_UploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse_UploadResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse_UploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse_UploadResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse_UploadResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *SubscribeResponse_UploadResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *SubscribeResponse_UploadResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_UploadResponse) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_UploadResponse) GetWorkerId() int32 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_UploadResponse) GetStatus() SubscribeResponse_UploadResponse_UploadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscribeResponse_UploadResponse_INIT
+}
+
+type SubscribeResponse_DownloadStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId  string                                                  `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Total   int64                                                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Current int64                                                   `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Status  SubscribeResponse_DownloadStatusResponse_DownloadStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.SubscribeResponse_DownloadStatusResponse_DownloadStatus" json:"status,omitempty"`
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) Reset() {
+	*x = SubscribeResponse_DownloadStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse_DownloadStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse_DownloadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse_DownloadStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse_DownloadStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_DownloadStatusResponse) GetStatus() SubscribeResponse_DownloadStatusResponse_DownloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscribeResponse_DownloadStatusResponse_INIT
+}
+
+type SubscribeResponse_SyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   SubscribeResponse_SyncResponse_SyncStatus `protobuf:"varint,1,opt,name=status,proto3,enum=v1.SubscribeResponse_SyncResponse_SyncStatus" json:"status,omitempty"`
+	Total    int64                                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NrSynced int64                                     `protobuf:"varint,3,opt,name=nr_synced,json=nrSynced,proto3" json:"nr_synced,omitempty"`
+	WorkerId int32                                     `protobuf:"varint,4,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (x *SubscribeResponse_SyncResponse) Reset() {
+	*x = SubscribeResponse_SyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse_SyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse_SyncResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse_SyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse_SyncResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse_SyncResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (x *SubscribeResponse_SyncResponse) GetStatus() SubscribeResponse_SyncResponse_SyncStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SubscribeResponse_SyncResponse_INIT
+}
+
+func (x *SubscribeResponse_SyncResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_SyncResponse) GetNrSynced() int64 {
+	if x != nil {
+		return x.NrSynced
+	}
+	return 0
+}
+
+func (x *SubscribeResponse_SyncResponse) GetWorkerId() int32 {
+	if x != nil {
+		return x.WorkerId
+	}
+	return 0
+}
+
+type SubscribeResponse_WorkflowStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId  string             `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status WorkflowRun_Status `protobuf:"varint,2,opt,name=status,proto3,enum=v1.WorkflowRun_Status" json:"status,omitempty"`
+	Output string             `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`  // Line of output of the workflow; empty for status changes
+	Stderr bool               `protobuf:"varint,4,opt,name=stderr,proto3" json:"stderr,omitempty"` // True if the output was written to stderr
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) Reset() {
+	*x = SubscribeResponse_WorkflowStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse_WorkflowStatusResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse_WorkflowStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse_WorkflowStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{19, 4}
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) GetStatus() WorkflowRun_Status {
+	if x != nil {
+		return x.Status
+	}
+	return WorkflowRun_PENDING
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *SubscribeResponse_WorkflowStatusResponse) GetStderr() bool {
+	if x != nil {
+		return x.Stderr
+	}
+	return false
+}
+
+type ListManifestsResponse_Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int32            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NodeId           string           `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	UserName         string           `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserId           string           `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrganizationName string           `protobuf:"bytes,5,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	OrganizationId   string           `protobuf:"bytes,6,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DatasetName      string           `protobuf:"bytes,7,opt,name=dataset_name,json=datasetName,proto3" json:"dataset_name,omitempty"`
+	DatasetId        string           `protobuf:"bytes,8,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	Status           string           `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                                                                                                           // Lifecycle state: indexed, syncing, uploading, finalizing, verified, partially_failed or cancelled
+	StatusCounts     map[string]int64 `protobuf:"bytes,10,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"` // Number of files for each file status, such as LOCAL or VERIFIED
+}
+
+func (x *ListManifestsResponse_Manifest) Reset() {
+	*x = ListManifestsResponse_Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManifestsResponse_Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManifestsResponse_Manifest) ProtoMessage() {}
+
+func (x *ListManifestsResponse_Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManifestsResponse_Manifest.ProtoReflect.Descriptor instead.
+func (*ListManifestsResponse_Manifest) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *ListManifestsResponse_Manifest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListManifestsResponse_Manifest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetDatasetName() string {
+	if x != nil {
+		return x.DatasetName
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListManifestsResponse_Manifest) GetStatusCounts() map[string]int64 {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+type ListManifestFilesResponse_FileUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32                                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ManifestId int32                                `protobuf:"varint,2,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	SourcePath string                               `protobuf:"bytes,3,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetPath string                               `protobuf:"bytes,4,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	UploadId   string                               `protobuf:"bytes,5,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Status     ListManifestFilesResponse_StatusType `protobuf:"varint,6,opt,name=status,proto3,enum=v1.ListManifestFilesResponse_StatusType" json:"status,omitempty"`
+}
+
+func (x *ListManifestFilesResponse_FileUpload) Reset() {
+	*x = ListManifestFilesResponse_FileUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManifestFilesResponse_FileUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManifestFilesResponse_FileUpload) ProtoMessage() {}
+
+func (x *ListManifestFilesResponse_FileUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManifestFilesResponse_FileUpload.ProtoReflect.Descriptor instead.
+func (*ListManifestFilesResponse_FileUpload) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetManifestId() int32 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *ListManifestFilesResponse_FileUpload) GetStatus() ListManifestFilesResponse_StatusType {
+	if x != nil {
+		return x.Status
+	}
+	return ListManifestFilesResponse_LOCAL
+}
+
+type ValidateManifestResponse_Issue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Severity   ValidateManifestResponse_Issue_Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=v1.ValidateManifestResponse_Issue_Severity" json:"severity,omitempty"`
+	Code       string                                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message    string                                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Target     string                                  `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"` // Target path and name of the file; empty for issues with the dataset
+	SourcePath string                                  `protobuf:"bytes,5,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+}
+
+func (x *ValidateManifestResponse_Issue) Reset() {
+	*x = ValidateManifestResponse_Issue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateManifestResponse_Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateManifestResponse_Issue) ProtoMessage() {}
+
+func (x *ValidateManifestResponse_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateManifestResponse_Issue.ProtoReflect.Descriptor instead.
+func (*ValidateManifestResponse_Issue) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *ValidateManifestResponse_Issue) GetSeverity() ValidateManifestResponse_Issue_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return ValidateManifestResponse_Issue_ERROR
+}
+
+func (x *ValidateManifestResponse_Issue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ValidateManifestResponse_Issue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidateManifestResponse_Issue) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ValidateManifestResponse_Issue) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+type RelocateManifestFilesResponse_Relocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourcePath        string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	TargetPath        string `protobuf:"bytes,3,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	TargetName        string `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	UpdatedTargetPath string `protobuf:"bytes,5,opt,name=updated_target_path,json=updatedTargetPath,proto3" json:"updated_target_path,omitempty"`
+	UpdatedTargetName string `protobuf:"bytes,6,opt,name=updated_target_name,json=updatedTargetName,proto3" json:"updated_target_name,omitempty"`
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) Reset() {
+	*x = RelocateManifestFilesResponse_Relocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelocateManifestFilesResponse_Relocation) ProtoMessage() {}
+
+func (x *RelocateManifestFilesResponse_Relocation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelocateManifestFilesResponse_Relocation.ProtoReflect.Descriptor instead.
+func (*RelocateManifestFilesResponse_Relocation) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetUpdatedTargetPath() string {
+	if x != nil {
+		return x.UpdatedTargetPath
+	}
+	return ""
+}
+
+func (x *RelocateManifestFilesResponse_Relocation) GetUpdatedTargetName() string {
+	if x != nil {
+		return x.UpdatedTargetName
+	}
+	return ""
+}
+
+type RegisteredWorkflow_Parameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // string, integer, number or boolean
+	Description string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required    bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Default     string   `protobuf:"bytes,5,opt,name=default,proto3" json:"default,omitempty"` // JSON encoded default value; empty if the parameter has no default
+	Enum        []string `protobuf:"bytes,6,rep,name=enum,proto3" json:"enum,omitempty"`       // Allowed values; empty if any value of the type is allowed
+}
+
+func (x *RegisteredWorkflow_Parameter) Reset() {
+	*x = RegisteredWorkflow_Parameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisteredWorkflow_Parameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredWorkflow_Parameter) ProtoMessage() {}
+
+func (x *RegisteredWorkflow_Parameter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredWorkflow_Parameter.ProtoReflect.Descriptor instead.
+func (*RegisteredWorkflow_Parameter) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *RegisteredWorkflow_Parameter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisteredWorkflow_Parameter) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RegisteredWorkflow_Parameter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RegisteredWorkflow_Parameter) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *RegisteredWorkflow_Parameter) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *RegisteredWorkflow_Parameter) GetEnum() []string {
+	if x != nil {
+		return x.Enum
+	}
+	return nil
+}
+
+type EvictResponse_EvictedFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size    int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // Size of the local file
+	Evicted bool   `protobuf:"varint,3,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EvictResponse_EvictedFile) Reset() {
+	*x = EvictResponse_EvictedFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_agent_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictResponse_EvictedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictResponse_EvictedFile) ProtoMessage() {}
+
+func (x *EvictResponse_EvictedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_agent_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictResponse_EvictedFile.ProtoReflect.Descriptor instead.
+func (*EvictResponse_EvictedFile) Descriptor() ([]byte, []int) {
+	return file_api_v1_agent_proto_rawDescGZIP(), []int{88, 0}
+}
+
+func (x *EvictResponse_EvictedFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *EvictResponse_EvictedFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *EvictResponse_EvictedFile) GetEvicted() bool {
+	if x != nil {
+		return x.Evicted
+	}
+	return false
+}
+
+func (x *EvictResponse_EvictedFile) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_api_v1_agent_proto protoreflect.FileDescriptor
+
+var file_api_v1_agent_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0x21, 0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x21, 0x0a, 0x0b, 0x50,
+	0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x22,
+	0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x97, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x22, 0xe6, 0x02, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x30, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x44,
+	0x46, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x50, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x10, 0x03, 0x22, 0x62, 0x0a, 0x18, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x54, 0x0a,
+	0x14, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x15, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x22, 0xd8, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x61,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x61,
+	0x70, 0x73, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x1a, 0x2d, 0x0a, 0x03,
+	0x47, 0x61, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x1a, 0x9e, 0x01, 0x0a, 0x0b,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x04, 0x67, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x70, 0x52,
+	0x04, 0x67, 0x61, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x02, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x87, 0x04, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x51,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x1a, 0x63, 0x0a, 0x05, 0x4c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x1a, 0xcc, 0x01, 0x0a, 0x0a, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22,
+	0x76, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0xc9, 0x02, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0xce, 0x06, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x41, 0x68, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69,
+	0x78, 0x65, 0x6c, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x69, 0x78, 0x65, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x4e, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x71, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x10, 0x44, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0b, 0x0a,
+	0x07, 0x4d, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x54,
+	0x54, 0x42, 0x10, 0x01, 0x22, 0x40, 0x0a, 0x0e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33,
+	0x32, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x31, 0x36, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49,
+	0x4e, 0x54, 0x33, 0x32, 0x10, 0x03, 0x22, 0x90, 0x0a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12,
+	0x3a, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x61, 0x70,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x03, 0x67, 0x61, 0x70, 0x12, 0x44, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x1a, 0xb2, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x08,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x93, 0x03, 0x0a, 0x09, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x61,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4d, 0x69, 0x6e, 0x4d, 0x61,
+	0x78, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x11, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x49, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x0b,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x1a,
+	0x50, 0x0a, 0x07, 0x47, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x1a, 0x1f, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x22, 0x55, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x49,
+	0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x41, 0x50, 0x10, 0x04, 0x42, 0x0e, 0x0a, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x0c, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x0b, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x57, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x1a, 0x29, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x1a, 0xfa, 0x01,
+	0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x37, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x1a, 0xf1, 0x01, 0x0a, 0x16, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x53,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x1a, 0xdc,
+	0x01, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x45, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x72, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6e, 0x72, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x1a, 0x8f, 0x01,
+	0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22,
+	0x8e, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x50,
+	0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
+	0x03, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f,
+	0x41, 0x44, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x57,
+	0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x06,
+	0x42, 0x0e, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x2e, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x55, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x41, 0x6c, 0x6c, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
+	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x41, 0x6c, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x53, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61,
+	0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73,
+	0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x22,
+	0x5d, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x10,
+	0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x48, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x91, 0x04, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73,
+	0x1a, 0xb5, 0x03, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x94, 0x03, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x40,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x28,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x51, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x04, 0x22, 0xf8, 0x03, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0xde, 0x01, 0x0a, 0x0a,
+	0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa5, 0x01, 0x0a,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44,
+	0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x4f, 0x52, 0x50, 0x48,
+	0x41, 0x4e, 0x10, 0x0a, 0x22, 0x59, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x22,
+	0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc4, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x70, 0x69, 0x32, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x70, 0x69, 0x32, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x13,
+	0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x49, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x72, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x72, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x15,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x47, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x62, 0x0a, 0x15, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0x54, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x72,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x22, 0x88, 0x03,
+	0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x72, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6e, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3a,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x1a, 0xdb, 0x01, 0x0a, 0x05, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x22, 0x22, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57,
+	0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x22, 0xfc, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa7, 0x03, 0x0a, 0x1d, 0x52, 0x65, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x72, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x6e, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12,
+	0x4e, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0xdf, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0xed, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x2d, 0x0a, 0x12, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64,
+	0x22, 0x23, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x50, 0x41, 0x54, 0x48, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x41,
+	0x4d, 0x45, 0x44, 0x10, 0x01, 0x22, 0xd6, 0x03, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x75, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x44, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c,
+	0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x65, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x64, 0x65, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x4c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x22, 0x37,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x52,
+	0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e,
+	0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x2e, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x22, 0xdf, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x9f, 0x01, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x22, 0x20, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6f, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x2d, 0x0a, 0x17,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x18, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x6b, 0x0a, 0x0f, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x22, 0x50, 0x0a, 0x12, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x21, 0x0a, 0x0b, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x57,
+	0x53, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x10, 0x01, 0x22, 0x27,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x50, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x22, 0x28, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x41, 0x54, 0x41, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5c, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x16, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x67, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x2f,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x52, 0x45, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x55, 0x52, 0x4c, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x01, 0x22,
+	0x22, 0x0a, 0x0c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x50, 0x0a, 0x0d, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31,
+	0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x37, 0x0a, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x2e, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x41, 0x53, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x46,
+	0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4d, 0x54,
+	0x49, 0x4d, 0x45, 0x10, 0x02, 0x22, 0x6b, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6a, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x5f, 0x52,
+	0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46,
+	0x4c, 0x49, 0x43, 0x54, 0x10, 0x06, 0x22, 0x3a, 0x0a, 0x0f, 0x4d, 0x61, 0x70, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x45, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x45, 0x45, 0x50, 0x5f,
+	0x42, 0x4f, 0x54, 0x48, 0x10, 0x02, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xe8, 0x01, 0x0a, 0x0d, 0x45,
+	0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x0b, 0x45, 0x76,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x22, 0x50, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xd1, 0x1b, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x03, 0x4d,
+	0x61, 0x70, 0x12, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e,
+	0x0a, 0x05, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x33,
+	0x0a, 0x04, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x45, 0x76, 0x69, 0x63, 0x74, 0x12, 0x10, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x12, 0x0f, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x61, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x3c, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b,
+	0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2b, 0x0a, 0x04, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0e, 0x52, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x18,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x22, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1b, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x15, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x62, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x61, 0x70,
+	0x73, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x47, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x47, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0d, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x67, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x6e, 0x73, 0x69, 0x65,
+	0x76, 0x65, 0x2f, 0x70, 0x65, 0x6e, 0x6e, 0x73, 0x69, 0x65, 0x76, 0x65, 0x2d, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v1_agent_proto_rawDescOnce sync.Once
+	file_api_v1_agent_proto_rawDescData = file_api_v1_agent_proto_rawDesc
+)
+
+func file_api_v1_agent_proto_rawDescGZIP() []byte {
+	file_api_v1_agent_proto_rawDescOnce.Do(func() {
+		file_api_v1_agent_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v1_agent_proto_rawDescData)
+	})
+	return file_api_v1_agent_proto_rawDescData
+}
+
+var file_api_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_api_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
+var file_api_v1_agent_proto_goTypes = []interface{}{
+	(ExportTimeseriesRequest_Format)(0),                          // 0: v1.ExportTimeseriesRequest.Format
+	(GetTimeseriesRangeRequest_DecimationMethod)(0),              // 1: v1.GetTimeseriesRangeRequest.DecimationMethod
+	(GetTimeseriesRangeRequest_SampleEncoding)(0),                // 2: v1.GetTimeseriesRangeRequest.SampleEncoding
+	(GetTimeseriesRangeResponse_MessageType)(0),                  // 3: v1.GetTimeseriesRangeResponse.MessageType
+	(SubscribeResponse_MessageType)(0),                           // 4: v1.SubscribeResponse.MessageType
+	(SubscribeResponse_UploadResponse_UploadStatus)(0),           // 5: v1.SubscribeResponse.UploadResponse.UploadStatus
+	(SubscribeResponse_DownloadStatusResponse_DownloadStatus)(0), // 6: v1.SubscribeResponse.DownloadStatusResponse.DownloadStatus
+	(SubscribeResponse_SyncResponse_SyncStatus)(0),               // 7: v1.SubscribeResponse.SyncResponse.SyncStatus
+	(ListManifestFilesRequest_SortField)(0),                      // 8: v1.ListManifestFilesRequest.SortField
+	(ListManifestFilesResponse_StatusType)(0),                    // 9: v1.ListManifestFilesResponse.StatusType
+	(ValidateManifestResponse_Issue_Severity)(0),                 // 10: v1.ValidateManifestResponse.Issue.Severity
+	(WorkflowResponse_WorkflowType)(0),                           // 11: v1.WorkflowResponse.WorkflowType
+	(WorkflowRun_Status)(0),                                      // 12: v1.WorkflowRun.Status
+	(Account_AccountType)(0),                                     // 13: v1.Account.AccountType
+	(DownloadRequest_DownloadType)(0),                            // 14: v1.DownloadRequest.DownloadType
+	(DownloadResponse_ResponseType)(0),                           // 15: v1.DownloadResponse.ResponseType
+	(MapDiffRequest_Strength)(0),                                 // 16: v1.MapDiffRequest.Strength
+	(PackageStatus_StatusType)(0),                                // 17: v1.packageStatus.StatusType
+	(ResolveConflictRequest_Resolution)(0),                       // 18: v1.ResolveConflictRequest.Resolution
+	(*PullRequest)(nil),                                          // 19: v1.PullRequest
+	(*PushRequest)(nil),                                          // 20: v1.PushRequest
+	(*SubscribeRequest)(nil),                                     // 21: v1.SubscribeRequest
+	(*ResetCacheRequest)(nil),                                    // 22: v1.ResetCacheRequest
+	(*GetTimeseriesCacheStatsRequest)(nil),                       // 23: v1.GetTimeseriesCacheStatsRequest
+	(*GetTimeseriesCacheStatsResponse)(nil),                      // 24: v1.GetTimeseriesCacheStatsResponse
+	(*ExportTimeseriesRequest)(nil),                              // 25: v1.ExportTimeseriesRequest
+	(*ExportTimeseriesResponse)(nil),                             // 26: v1.ExportTimeseriesResponse
+	(*PinTimeseriesRequest)(nil),                                 // 27: v1.PinTimeseriesRequest
+	(*PinTimeseriesResponse)(nil),                                // 28: v1.PinTimeseriesResponse
+	(*GetTimeseriesGapsRequest)(nil),                             // 29: v1.GetTimeseriesGapsRequest
+	(*GetTimeseriesGapsResponse)(nil),                            // 30: v1.GetTimeseriesGapsResponse
+	(*GetTimeseriesAnnotationsRequest)(nil),                      // 31: v1.GetTimeseriesAnnotationsRequest
+	(*GetTimeseriesAnnotationsResponse)(nil),                     // 32: v1.GetTimeseriesAnnotationsResponse
+	(*GetTimeseriesChannelsRequest)(nil),                         // 33: v1.GetTimeseriesChannelsRequest
+	(*TimeseriesChannel)(nil),                                    // 34: v1.TimeseriesChannel
+	(*GetTimeseriesChannelsResponse)(nil),                        // 35: v1.GetTimeseriesChannelsResponse
+	(*GetTimeseriesRangeRequest)(nil),                            // 36: v1.GetTimeseriesRangeRequest
+	(*GetTimeseriesRangeResponse)(nil),                           // 37: v1.GetTimeseriesRangeResponse
+	(*SubscribeResponse)(nil),                                    // 38: v1.SubscribeResponse
+	(*SimpleStatusResponse)(nil),                                 // 39: v1.SimpleStatusResponse
+	(*CancelUploadRequest)(nil),                                  // 40: v1.CancelUploadRequest
+	(*CancelDownloadRequest)(nil),                                // 41: v1.CancelDownloadRequest
+	(*CreateManifestRequest)(nil),                                // 42: v1.CreateManifestRequest
+	(*CreateManifestResponse)(nil),                               // 43: v1.CreateManifestResponse
+	(*AddToManifestRequest)(nil),                                 // 44: v1.AddToManifestRequest
+	(*RemoveFromManifestRequest)(nil),                            // 45: v1.RemoveFromManifestRequest
+	(*VersionRequest)(nil),                                       // 46: v1.VersionRequest
+	(*VersionResponse)(nil),                                      // 47: v1.VersionResponse
+	(*PingRequest)(nil),                                          // 48: v1.PingRequest
+	(*PingResponse)(nil),                                         // 49: v1.PingResponse
+	(*StopRequest)(nil),                                          // 50: v1.StopRequest
+	(*StopResponse)(nil),                                         // 51: v1.StopResponse
+	(*ListManifestsRequest)(nil),                                 // 52: v1.ListManifestsRequest
+	(*ListManifestsResponse)(nil),                                // 53: v1.ListManifestsResponse
+	(*DeleteManifestRequest)(nil),                                // 54: v1.DeleteManifestRequest
+	(*ListManifestFilesRequest)(nil),                             // 55: v1.ListManifestFilesRequest
+	(*ListManifestFilesResponse)(nil),                            // 56: v1.ListManifestFilesResponse
+	(*UploadManifestRequest)(nil),                                // 57: v1.UploadManifestRequest
+	(*GetUserRequest)(nil),                                       // 58: v1.GetUserRequest
+	(*UserResponse)(nil),                                         // 59: v1.UserResponse
+	(*SwitchProfileRequest)(nil),                                 // 60: v1.SwitchProfileRequest
+	(*ReAuthenticateRequest)(nil),                                // 61: v1.ReAuthenticateRequest
+	(*UseDatasetRequest)(nil),                                    // 62: v1.UseDatasetRequest
+	(*UseDatasetResponse)(nil),                                   // 63: v1.UseDatasetResponse
+	(*SyncManifestRequest)(nil),                                  // 64: v1.SyncManifestRequest
+	(*SyncManifestResponse)(nil),                                 // 65: v1.SyncManifestResponse
+	(*ResetManifestRequest)(nil),                                 // 66: v1.ResetManifestRequest
+	(*ExportManifestRequest)(nil),                                // 67: v1.ExportManifestRequest
+	(*ExportManifestResponse)(nil),                               // 68: v1.ExportManifestResponse
+	(*ImportManifestRequest)(nil),                                // 69: v1.ImportManifestRequest
+	(*ImportManifestResponse)(nil),                               // 70: v1.ImportManifestResponse
+	(*ValidateManifestRequest)(nil),                              // 71: v1.ValidateManifestRequest
+	(*ValidateManifestResponse)(nil),                             // 72: v1.ValidateManifestResponse
+	(*RelocateManifestFilesRequest)(nil),                         // 73: v1.RelocateManifestFilesRequest
+	(*RelocateManifestFilesResponse)(nil),                        // 74: v1.RelocateManifestFilesResponse
+	(*StartWorkflowRequest)(nil),                                 // 75: v1.StartWorkflowRequest
+	(*WorkflowResponse)(nil),                                     // 76: v1.WorkflowResponse
+	(*WorkflowRun)(nil),                                          // 77: v1.WorkflowRun
+	(*ListWorkflowsRequest)(nil),                                 // 78: v1.ListWorkflowsRequest
+	(*ListWorkflowsResponse)(nil),                                // 79: v1.ListWorkflowsResponse
+	(*GetWorkflowStatusRequest)(nil),                             // 80: v1.GetWorkflowStatusRequest
+	(*GetWorkflowStatusResponse)(nil),                            // 81: v1.GetWorkflowStatusResponse
+	(*CancelWorkflowRequest)(nil),                                // 82: v1.CancelWorkflowRequest
+	(*RegisteredWorkflow)(nil),                                   // 83: v1.RegisteredWorkflow
+	(*ListRegisteredWorkflowsRequest)(nil),                       // 84: v1.ListRegisteredWorkflowsRequest
+	(*ListRegisteredWorkflowsResponse)(nil),                      // 85: v1.ListRegisteredWorkflowsResponse
+	(*DescribeWorkflowRequest)(nil),                              // 86: v1.DescribeWorkflowRequest
+	(*DescribeWorkflowResponse)(nil),                             // 87: v1.DescribeWorkflowResponse
+	(*RegisterRequest)(nil),                                      // 88: v1.RegisterRequest
+	(*RegisterResponse)(nil),                                     // 89: v1.RegisterResponse
+	(*DeregisterRequest)(nil),                                    // 90: v1.DeregisterRequest
+	(*DeregisterResponse)(nil),                                   // 91: v1.DeregisterResponse
+	(*Account)(nil),                                              // 92: v1.Account
+	(*Credentials)(nil),                                          // 93: v1.Credentials
+	(*MapRequest)(nil),                                           // 94: v1.MapRequest
+	(*DownloadRequest)(nil),                                      // 95: v1.DownloadRequest
+	(*DownloadDatasetRequest)(nil),                               // 96: v1.DownloadDatasetRequest
+	(*DownloadPackageRequest)(nil),                               // 97: v1.DownloadPackageRequest
+	(*DownloadResponse)(nil),                                     // 98: v1.DownloadResponse
+	(*FetchRequest)(nil),                                         // 99: v1.FetchRequest
+	(*FetchResponse)(nil),                                        // 100: v1.FetchResponse
+	(*MapDiffRequest)(nil),                                       // 101: v1.MapDiffRequest
+	(*FileInfo)(nil),                                             // 102: v1.fileInfo
+	(*PackageStatus)(nil),                                        // 103: v1.packageStatus
+	(*MapDiffResponse)(nil),                                      // 104: v1.MapDiffResponse
+	(*ResolveConflictRequest)(nil),                               // 105: v1.ResolveConflictRequest
+	(*EvictRequest)(nil),                                         // 106: v1.EvictRequest
+	(*EvictResponse)(nil),                                        // 107: v1.EvictResponse
+	(*UpdateRoleRequest)(nil),                                    // 108: v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                                   // 109: v1.UpdateRoleResponse
+	(*GetTimeseriesCacheStatsResponse_PackageCacheStats)(nil),    // 110: v1.GetTimeseriesCacheStatsResponse.PackageCacheStats
+	(*GetTimeseriesGapsResponse_Gap)(nil),                        // 111: v1.GetTimeseriesGapsResponse.Gap
+	(*GetTimeseriesGapsResponse_ChannelGaps)(nil),                // 112: v1.GetTimeseriesGapsResponse.ChannelGaps
+	(*GetTimeseriesAnnotationsResponse_Layer)(nil),               // 113: v1.GetTimeseriesAnnotationsResponse.Layer
+	(*GetTimeseriesAnnotationsResponse_Annotation)(nil),          // 114: v1.GetTimeseriesAnnotationsResponse.Annotation
+	nil, // 115: v1.TimeseriesChannel.PropertiesEntry
+	nil, // 116: v1.GetTimeseriesRangeRequest.ChannelEncodingsEntry
+	(*GetTimeseriesRangeResponse_ChannelInfo)(nil),   // 117: v1.GetTimeseriesRangeResponse.ChannelInfo
+	(*GetTimeseriesRangeResponse_RangeData)(nil),     // 118: v1.GetTimeseriesRangeResponse.RangeData
+	(*GetTimeseriesRangeResponse_RangeStatus)(nil),   // 119: v1.GetTimeseriesRangeResponse.RangeStatus
+	(*GetTimeseriesRangeResponse_GapData)(nil),       // 120: v1.GetTimeseriesRangeResponse.GapData
+	(*GetTimeseriesRangeResponse_ErrorData)(nil),     // 121: v1.GetTimeseriesRangeResponse.ErrorData
+	(*SubscribeResponse_EventResponse)(nil),          // 122: v1.SubscribeResponse.EventResponse
+	(*SubscribeResponse_UploadResponse)(nil),         // 123: v1.SubscribeResponse.UploadResponse
+	(*SubscribeResponse_DownloadStatusResponse)(nil), // 124: v1.SubscribeResponse.DownloadStatusResponse
+	(*SubscribeResponse_SyncResponse)(nil),           // 125: v1.SubscribeResponse.SyncResponse
+	(*SubscribeResponse_WorkflowStatusResponse)(nil), // 126: v1.SubscribeResponse.WorkflowStatusResponse
+	(*ListManifestsResponse_Manifest)(nil),           // 127: v1.ListManifestsResponse.Manifest
+	nil,                                              // 128: v1.ListManifestsResponse.Manifest.StatusCountsEntry
+	(*ListManifestFilesResponse_FileUpload)(nil),     // 129: v1.ListManifestFilesResponse.FileUpload
+	(*ValidateManifestResponse_Issue)(nil),           // 130: v1.ValidateManifestResponse.Issue
+	(*RelocateManifestFilesResponse_Relocation)(nil), // 131: v1.RelocateManifestFilesResponse.Relocation
+	nil,                                  // 132: v1.StartWorkflowRequest.ParamsEntry
+	(*RegisteredWorkflow_Parameter)(nil), // 133: v1.RegisteredWorkflow.Parameter
+	(*EvictResponse_EvictedFile)(nil),    // 134: v1.EvictResponse.EvictedFile
+}
+var file_api_v1_agent_proto_depIdxs = []int32{
+	110, // 0: v1.GetTimeseriesCacheStatsResponse.packages:type_name -> v1.GetTimeseriesCacheStatsResponse.PackageCacheStats
+	0,   // 1: v1.ExportTimeseriesRequest.format:type_name -> v1.ExportTimeseriesRequest.Format
+	112, // 2: v1.GetTimeseriesGapsResponse.channels:type_name -> v1.GetTimeseriesGapsResponse.ChannelGaps
+	113, // 3: v1.GetTimeseriesAnnotationsResponse.layers:type_name -> v1.GetTimeseriesAnnotationsResponse.Layer
+	114, // 4: v1.GetTimeseriesAnnotationsResponse.annotations:type_name -> v1.GetTimeseriesAnnotationsResponse.Annotation
+	115, // 5: v1.TimeseriesChannel.properties:type_name -> v1.TimeseriesChannel.PropertiesEntry
+	34,  // 6: v1.GetTimeseriesChannelsResponse.channel:type_name -> v1.TimeseriesChannel
+	1,   // 7: v1.GetTimeseriesRangeRequest.decimation:type_name -> v1.GetTimeseriesRangeRequest.DecimationMethod
+	2,   // 8: v1.GetTimeseriesRangeRequest.encoding:type_name -> v1.GetTimeseriesRangeRequest.SampleEncoding
+	116, // 9: v1.GetTimeseriesRangeRequest.channel_encodings:type_name -> v1.GetTimeseriesRangeRequest.ChannelEncodingsEntry
+	3,   // 10: v1.GetTimeseriesRangeResponse.type:type_name -> v1.GetTimeseriesRangeResponse.MessageType
+	121, // 11: v1.GetTimeseriesRangeResponse.error:type_name -> v1.GetTimeseriesRangeResponse.ErrorData
+	118, // 12: v1.GetTimeseriesRangeResponse.data:type_name -> v1.GetTimeseriesRangeResponse.RangeData
+	117, // 13: v1.GetTimeseriesRangeResponse.channel:type_name -> v1.GetTimeseriesRangeResponse.ChannelInfo
+	120, // 14: v1.GetTimeseriesRangeResponse.gap:type_name -> v1.GetTimeseriesRangeResponse.GapData
+	119, // 15: v1.GetTimeseriesRangeResponse.status:type_name -> v1.GetTimeseriesRangeResponse.RangeStatus
+	4,   // 16: v1.SubscribeResponse.type:type_name -> v1.SubscribeResponse.MessageType
+	123, // 17: v1.SubscribeResponse.upload_status:type_name -> v1.SubscribeResponse.UploadResponse
+	122, // 18: v1.SubscribeResponse.event_info:type_name -> v1.SubscribeResponse.EventResponse
+	125, // 19: v1.SubscribeResponse.sync_status:type_name -> v1.SubscribeResponse.SyncResponse
+	124, // 20: v1.SubscribeResponse.download_status:type_name -> v1.SubscribeResponse.DownloadStatusResponse
+	126, // 21: v1.SubscribeResponse.workflow_status:type_name -> v1.SubscribeResponse.WorkflowStatusResponse
+	127, // 22: v1.ListManifestsResponse.manifests:type_name -> v1.ListManifestsResponse.Manifest
+	9,   // 23: v1.ListManifestFilesRequest.status:type_name -> v1.ListManifestFilesResponse.StatusType
+	8,   // 24: v1.ListManifestFilesRequest.sort_by:type_name -> v1.ListManifestFilesRequest.SortField
+	129, // 25: v1.ListManifestFilesResponse.file:type_name -> v1.ListManifestFilesResponse.FileUpload
+	130, // 26: v1.ValidateManifestResponse.issues:type_name -> v1.ValidateManifestResponse.Issue
+	131, // 27: v1.RelocateManifestFilesResponse.relocations:type_name -> v1.RelocateManifestFilesResponse.Relocation
+	132, // 28: v1.StartWorkflowRequest.params:type_name -> v1.StartWorkflowRequest.ParamsEntry
+	11,  // 29: v1.WorkflowResponse.workflowType:type_name -> v1.WorkflowResponse.WorkflowType
+	12,  // 30: v1.WorkflowRun.status:type_name -> v1.WorkflowRun.Status
+	77,  // 31: v1.ListWorkflowsResponse.runs:type_name -> v1.WorkflowRun
+	77,  // 32: v1.GetWorkflowStatusResponse.run:type_name -> v1.WorkflowRun
+	133, // 33: v1.RegisteredWorkflow.parameters:type_name -> v1.RegisteredWorkflow.Parameter
+	83,  // 34: v1.ListRegisteredWorkflowsResponse.workflows:type_name -> v1.RegisteredWorkflow
+	83,  // 35: v1.DescribeWorkflowResponse.workflow:type_name -> v1.RegisteredWorkflow
+	92,  // 36: v1.RegisterRequest.account:type_name -> v1.Account
+	93,  // 37: v1.RegisterRequest.credentials:type_name -> v1.Credentials
+	92,  // 38: v1.DeregisterRequest.account:type_name -> v1.Account
+	93,  // 39: v1.DeregisterRequest.credentials:type_name -> v1.Credentials
+	13,  // 40: v1.Account.type:type_name -> v1.Account.AccountType
+	14,  // 41: v1.DownloadRequest.type:type_name -> v1.DownloadRequest.DownloadType
+	96,  // 42: v1.DownloadRequest.dataset:type_name -> v1.DownloadDatasetRequest
+	97,  // 43: v1.DownloadRequest.package:type_name -> v1.DownloadPackageRequest
+	15,  // 44: v1.DownloadResponse.type:type_name -> v1.DownloadResponse.ResponseType
+	103, // 45: v1.FetchResponse.files:type_name -> v1.packageStatus
+	16,  // 46: v1.MapDiffRequest.strength:type_name -> v1.MapDiffRequest.Strength
+	102, // 47: v1.packageStatus.content:type_name -> v1.fileInfo
+	17,  // 48: v1.packageStatus.changeType:type_name -> v1.packageStatus.StatusType
+	103, // 49: v1.MapDiffResponse.files:type_name -> v1.packageStatus
+	18,  // 50: v1.ResolveConflictRequest.resolution:type_name -> v1.ResolveConflictRequest.Resolution
+	134, // 51: v1.EvictResponse.files:type_name -> v1.EvictResponse.EvictedFile
+	92,  // 52: v1.UpdateRoleRequest.account:type_name -> v1.Account
+	93,  // 53: v1.UpdateRoleRequest.credentials:type_name -> v1.Credentials
+	111, // 54: v1.GetTimeseriesGapsResponse.ChannelGaps.gaps:type_name -> v1.GetTimeseriesGapsResponse.Gap
+	2,   // 55: v1.GetTimeseriesRangeRequest.ChannelEncodingsEntry.value:type_name -> v1.GetTimeseriesRangeRequest.SampleEncoding
+	2,   // 56: v1.GetTimeseriesRangeResponse.ChannelInfo.encoding:type_name -> v1.GetTimeseriesRangeRequest.SampleEncoding
+	2,   // 57: v1.GetTimeseriesRangeResponse.RangeData.encoding:type_name -> v1.GetTimeseriesRangeRequest.SampleEncoding
+	5,   // 58: v1.SubscribeResponse.UploadResponse.status:type_name -> v1.SubscribeResponse.UploadResponse.UploadStatus
+	6,   // 59: v1.SubscribeResponse.DownloadStatusResponse.status:type_name -> v1.SubscribeResponse.DownloadStatusResponse.DownloadStatus
+	7,   // 60: v1.SubscribeResponse.SyncResponse.status:type_name -> v1.SubscribeResponse.SyncResponse.SyncStatus
+	12,  // 61: v1.SubscribeResponse.WorkflowStatusResponse.status:type_name -> v1.WorkflowRun.Status
+	128, // 62: v1.ListManifestsResponse.Manifest.status_counts:type_name -> v1.ListManifestsResponse.Manifest.StatusCountsEntry
+	9,   // 63: v1.ListManifestFilesResponse.FileUpload.status:type_name -> v1.ListManifestFilesResponse.StatusType
+	10,  // 64: v1.ValidateManifestResponse.Issue.severity:type_name -> v1.ValidateManifestResponse.Issue.Severity
+	42,  // 65: v1.Agent.CreateManifest:input_type -> v1.CreateManifestRequest
+	44,  // 66: v1.Agent.AddToManifest:input_type -> v1.AddToManifestRequest
+	45,  // 67: v1.Agent.RemoveFromManifest:input_type -> v1.RemoveFromManifestRequest
+	54,  // 68: v1.Agent.DeleteManifest:input_type -> v1.DeleteManifestRequest
+	52,  // 69: v1.Agent.ListManifests:input_type -> v1.ListManifestsRequest
+	55,  // 70: v1.Agent.ListManifestFiles:input_type -> v1.ListManifestFilesRequest
+	73,  // 71: v1.Agent.RelocateManifestFiles:input_type -> v1.RelocateManifestFilesRequest
+	64,  // 72: v1.Agent.SyncManifest:input_type -> v1.SyncManifestRequest
+	66,  // 73: v1.Agent.ResetManifest:input_type -> v1.ResetManifestRequest
+	67,  // 74: v1.Agent.ExportManifest:input_type -> v1.ExportManifestRequest
+	69,  // 75: v1.Agent.ImportManifest:input_type -> v1.ImportManifestRequest
+	71,  // 76: v1.Agent.ValidateManifest:input_type -> v1.ValidateManifestRequest
+	57,  // 77: v1.Agent.UploadManifest:input_type -> v1.UploadManifestRequest
+	40,  // 78: v1.Agent.CancelUpload:input_type -> v1.CancelUploadRequest
+	95,  // 79: v1.Agent.Download:input_type -> v1.DownloadRequest
+	41,  // 80: v1.Agent.CancelDownload:input_type -> v1.CancelDownloadRequest
+	94,  // 81: v1.Agent.Map:input_type -> v1.MapRequest
+	99,  // 82: v1.Agent.Fetch:input_type -> v1.FetchRequest
+	19,  // 83: v1.Agent.Pull:input_type -> v1.PullRequest
+	106, // 84: v1.Agent.Evict:input_type -> v1.EvictRequest
+	20,  // 85: v1.Agent.Push:input_type -> v1.PushRequest
+	101, // 86: v1.Agent.GetMapDiff:input_type -> v1.MapDiffRequest
+	105, // 87: v1.Agent.ResolveConflict:input_type -> v1.ResolveConflictRequest
+	46,  // 88: v1.Agent.Version:input_type -> v1.VersionRequest
+	21,  // 89: v1.Agent.Subscribe:input_type -> v1.SubscribeRequest
+	21,  // 90: v1.Agent.Unsubscribe:input_type -> v1.SubscribeRequest
+	50,  // 91: v1.Agent.Stop:input_type -> v1.StopRequest
+	48,  // 92: v1.Agent.Ping:input_type -> v1.PingRequest
+	58,  // 93: v1.Agent.GetUser:input_type -> v1.GetUserRequest
+	60,  // 94: v1.Agent.SwitchProfile:input_type -> v1.SwitchProfileRequest
+	61,  // 95: v1.Agent.ReAuthenticate:input_type -> v1.ReAuthenticateRequest
+	62,  // 96: v1.Agent.UseDataset:input_type -> v1.UseDatasetRequest
+	75,  // 97: v1.Agent.StartWorkflow:input_type -> v1.StartWorkflowRequest
+	78,  // 98: v1.Agent.ListWorkflows:input_type -> v1.ListWorkflowsRequest
+	80,  // 99: v1.Agent.GetWorkflowStatus:input_type -> v1.GetWorkflowStatusRequest
+	82,  // 100: v1.Agent.CancelWorkflow:input_type -> v1.CancelWorkflowRequest
+	84,  // 101: v1.Agent.ListRegisteredWorkflows:input_type -> v1.ListRegisteredWorkflowsRequest
+	86,  // 102: v1.Agent.DescribeWorkflow:input_type -> v1.DescribeWorkflowRequest
+	88,  // 103: v1.Agent.Register:input_type -> v1.RegisterRequest
+	108, // 104: v1.Agent.UpdateRole:input_type -> v1.UpdateRoleRequest
+	90,  // 105: v1.Agent.Deregister:input_type -> v1.DeregisterRequest
+	33,  // 106: v1.Agent.GetTimeseriesChannels:input_type -> v1.GetTimeseriesChannelsRequest
+	36,  // 107: v1.Agent.GetTimeseriesRangeForChannels:input_type -> v1.GetTimeseriesRangeRequest
+	22,  // 108: v1.Agent.ResetCache:input_type -> v1.ResetCacheRequest
+	23,  // 109: v1.Agent.GetTimeseriesCacheStats:input_type -> v1.GetTimeseriesCacheStatsRequest
+	25,  // 110: v1.Agent.ExportTimeseries:input_type -> v1.ExportTimeseriesRequest
+	29,  // 111: v1.Agent.GetTimeseriesGaps:input_type -> v1.GetTimeseriesGapsRequest
+	27,  // 112: v1.Agent.PinTimeseries:input_type -> v1.PinTimeseriesRequest
+	27,  // 113: v1.Agent.UnpinTimeseries:input_type -> v1.PinTimeseriesRequest
+	31,  // 114: v1.Agent.GetTimeseriesAnnotations:input_type -> v1.GetTimeseriesAnnotationsRequest
+	43,  // 115: v1.Agent.CreateManifest:output_type -> v1.CreateManifestResponse
+	39,  // 116: v1.Agent.AddToManifest:output_type -> v1.SimpleStatusResponse
+	39,  // 117: v1.Agent.RemoveFromManifest:output_type -> v1.SimpleStatusResponse
+	39,  // 118: v1.Agent.DeleteManifest:output_type -> v1.SimpleStatusResponse
+	53,  // 119: v1.Agent.ListManifests:output_type -> v1.ListManifestsResponse
+	56,  // 120: v1.Agent.ListManifestFiles:output_type -> v1.ListManifestFilesResponse
+	74,  // 121: v1.Agent.RelocateManifestFiles:output_type -> v1.RelocateManifestFilesResponse
+	65,  // 122: v1.Agent.SyncManifest:output_type -> v1.SyncManifestResponse
+	39,  // 123: v1.Agent.ResetManifest:output_type -> v1.SimpleStatusResponse
+	68,  // 124: v1.Agent.ExportManifest:output_type -> v1.ExportManifestResponse
+	70,  // 125: v1.Agent.ImportManifest:output_type -> v1.ImportManifestResponse
+	72,  // 126: v1.Agent.ValidateManifest:output_type -> v1.ValidateManifestResponse
+	39,  // 127: v1.Agent.UploadManifest:output_type -> v1.SimpleStatusResponse
+	39,  // 128: v1.Agent.CancelUpload:output_type -> v1.SimpleStatusResponse
+	98,  // 129: v1.Agent.Download:output_type -> v1.DownloadResponse
+	39,  // 130: v1.Agent.CancelDownload:output_type -> v1.SimpleStatusResponse
+	39,  // 131: v1.Agent.Map:output_type -> v1.SimpleStatusResponse
+	100, // 132: v1.Agent.Fetch:output_type -> v1.FetchResponse
+	39,  // 133: v1.Agent.Pull:output_type -> v1.SimpleStatusResponse
+	107, // 134: v1.Agent.Evict:output_type -> v1.EvictResponse
+	39,  // 135: v1.Agent.Push:output_type -> v1.SimpleStatusResponse
+	104, // 136: v1.Agent.GetMapDiff:output_type -> v1.MapDiffResponse
+	39,  // 137: v1.Agent.ResolveConflict:output_type -> v1.SimpleStatusResponse
+	47,  // 138: v1.Agent.Version:output_type -> v1.VersionResponse
+	38,  // 139: v1.Agent.Subscribe:output_type -> v1.SubscribeResponse
+	38,  // 140: v1.Agent.Unsubscribe:output_type -> v1.SubscribeResponse
+	51,  // 141: v1.Agent.Stop:output_type -> v1.StopResponse
+	49,  // 142: v1.Agent.Ping:output_type -> v1.PingResponse
+	59,  // 143: v1.Agent.GetUser:output_type -> v1.UserResponse
+	59,  // 144: v1.Agent.SwitchProfile:output_type -> v1.UserResponse
+	59,  // 145: v1.Agent.ReAuthenticate:output_type -> v1.UserResponse
+	63,  // 146: v1.Agent.UseDataset:output_type -> v1.UseDatasetResponse
+	76,  // 147: v1.Agent.StartWorkflow:output_type -> v1.WorkflowResponse
+	79,  // 148: v1.Agent.ListWorkflows:output_type -> v1.ListWorkflowsResponse
+	81,  // 149: v1.Agent.GetWorkflowStatus:output_type -> v1.GetWorkflowStatusResponse
+	39,  // 150: v1.Agent.CancelWorkflow:output_type -> v1.SimpleStatusResponse
+	85,  // 151: v1.Agent.ListRegisteredWorkflows:output_type -> v1.ListRegisteredWorkflowsResponse
+	87,  // 152: v1.Agent.DescribeWorkflow:output_type -> v1.DescribeWorkflowResponse
+	89,  // 153: v1.Agent.Register:output_type -> v1.RegisterResponse
+	109, // 154: v1.Agent.UpdateRole:output_type -> v1.UpdateRoleResponse
+	91,  // 155: v1.Agent.Deregister:output_type -> v1.DeregisterResponse
+	35,  // 156: v1.Agent.GetTimeseriesChannels:output_type -> v1.GetTimeseriesChannelsResponse
+	37,  // 157: v1.Agent.GetTimeseriesRangeForChannels:output_type -> v1.GetTimeseriesRangeResponse
+	39,  // 158: v1.Agent.ResetCache:output_type -> v1.SimpleStatusResponse
+	24,  // 159: v1.Agent.GetTimeseriesCacheStats:output_type -> v1.GetTimeseriesCacheStatsResponse
+	26,  // 160: v1.Agent.ExportTimeseries:output_type -> v1.ExportTimeseriesResponse
+	30,  // 161: v1.Agent.GetTimeseriesGaps:output_type -> v1.GetTimeseriesGapsResponse
+	28,  // 162: v1.Agent.PinTimeseries:output_type -> v1.PinTimeseriesResponse
+	39,  // 163: v1.Agent.UnpinTimeseries:output_type -> v1.SimpleStatusResponse
+	32,  // 164: v1.Agent.GetTimeseriesAnnotations:output_type -> v1.GetTimeseriesAnnotationsResponse
+	115, // [115:165] is the sub-list for method output_type
+	65,  // [65:115] is the sub-list for method input_type
+	65,  // [65:65] is the sub-list for extension type_name
+	65,  // [65:65] is the sub-list for extension extendee
+	0,   // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_agent_proto_init() }
+func file_api_v1_agent_proto_init() {
+	if File_api_v1_agent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v1_agent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesCacheStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesCacheStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTimeseriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTimeseriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinTimeseriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinTimeseriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesGapsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesGapsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesAnnotationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesAnnotationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeseriesChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDownloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddToManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFromManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManifestFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5429,8 +10370,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushRequest); i {
+		file_api_v1_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5441,8 +10382,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+		file_api_v1_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5453,8 +10394,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetCacheRequest); i {
+		file_api_v1_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5465,8 +10406,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimeseriesChannelsRequest); i {
+		file_api_v1_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReAuthenticateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5477,8 +10418,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeseriesChannel); i {
+		file_api_v1_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseDatasetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5489,8 +10430,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimeseriesChannelsResponse); i {
+		file_api_v1_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseDatasetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5501,8 +10442,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimeseriesRangeRequest); i {
+		file_api_v1_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5513,8 +10454,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTimeseriesRangeResponse); i {
+		file_api_v1_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncManifestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5525,8 +10466,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeResponse); i {
+		file_api_v1_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5537,8 +10478,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleStatusResponse); i {
+		file_api_v1_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5549,8 +10490,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelUploadRequest); i {
+		file_api_v1_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportManifestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5561,8 +10502,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelDownloadRequest); i {
+		file_api_v1_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5573,8 +10514,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportManifestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5585,8 +10526,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateManifestResponse); i {
+		file_api_v1_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateManifestRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5597,8 +10538,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddToManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateManifestResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5609,8 +10550,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveFromManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateManifestFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5621,8 +10562,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
+		file_api_v1_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateManifestFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5633,8 +10574,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionResponse); i {
+		file_api_v1_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5645,8 +10586,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_api_v1_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkflowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5657,8 +10598,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_api_v1_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkflowRun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5669,8 +10610,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopRequest); i {
+		file_api_v1_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkflowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5681,8 +10622,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopResponse); i {
+		file_api_v1_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkflowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5693,8 +10634,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListManifestsRequest); i {
+		file_api_v1_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkflowStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5705,8 +10646,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListManifestsResponse); i {
+		file_api_v1_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkflowStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5717,8 +10658,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5729,8 +10670,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListManifestFilesRequest); i {
+		file_api_v1_agent_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredWorkflow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5741,8 +10682,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListManifestFilesResponse); i {
+		file_api_v1_agent_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegisteredWorkflowsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5753,8 +10694,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRegisteredWorkflowsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5765,8 +10706,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+		file_api_v1_agent_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5777,8 +10718,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserResponse); i {
+		file_api_v1_agent_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeWorkflowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5789,8 +10730,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwitchProfileRequest); i {
+		file_api_v1_agent_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5801,8 +10742,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReAuthenticateRequest); i {
+		file_api_v1_agent_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5813,8 +10754,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UseDatasetRequest); i {
+		file_api_v1_agent_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5825,8 +10766,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UseDatasetResponse); i {
+		file_api_v1_agent_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5837,8 +10778,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5849,8 +10790,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncManifestResponse); i {
+		file_api_v1_agent_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Credentials); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5861,8 +10802,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetManifestRequest); i {
+		file_api_v1_agent_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5873,8 +10814,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelocateManifestFilesRequest); i {
+		file_api_v1_agent_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5885,8 +10826,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartWorkflowRequest); i {
+		file_api_v1_agent_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadDatasetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5897,8 +10838,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkflowResponse); i {
+		file_api_v1_agent_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadPackageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5909,8 +10850,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_api_v1_agent_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5921,8 +10862,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_api_v1_agent_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5933,8 +10874,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterRequest); i {
+		file_api_v1_agent_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5945,8 +10886,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterResponse); i {
+		file_api_v1_agent_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapDiffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5957,8 +10898,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
+		file_api_v1_agent_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5969,8 +10910,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Credentials); i {
+		file_api_v1_agent_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5981,8 +10922,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapRequest); i {
+		file_api_v1_agent_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapDiffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5993,8 +10934,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadRequest); i {
+		file_api_v1_agent_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveConflictRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6005,8 +10946,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadDatasetRequest); i {
+		file_api_v1_agent_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6017,8 +10958,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadPackageRequest); i {
+		file_api_v1_agent_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6029,8 +10970,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadResponse); i {
+		file_api_v1_agent_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6041,8 +10982,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapDiffRequest); i {
+		file_api_v1_agent_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6053,8 +10994,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileInfo); i {
+		file_api_v1_agent_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesCacheStatsResponse_PackageCacheStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6065,8 +11006,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PackageStatus); i {
+		file_api_v1_agent_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesGapsResponse_Gap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6077,8 +11018,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MapDiffResponse); i {
+		file_api_v1_agent_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesGapsResponse_ChannelGaps); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6089,8 +11030,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleRequest); i {
+		file_api_v1_agent_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesAnnotationsResponse_Layer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6101,8 +11042,8 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleResponse); i {
+		file_api_v1_agent_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesAnnotationsResponse_Annotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6113,7 +11054,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeseriesRangeResponse_ChannelInfo); i {
 			case 0:
 				return &v.state
@@ -6125,7 +11066,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeseriesRangeResponse_RangeData); i {
 			case 0:
 				return &v.state
@@ -6137,7 +11078,31 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesRangeResponse_RangeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTimeseriesRangeResponse_GapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTimeseriesRangeResponse_ErrorData); i {
 			case 0:
 				return &v.state
@@ -6149,7 +11114,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeResponse_EventResponse); i {
 			case 0:
 				return &v.state
@@ -6161,7 +11126,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeResponse_UploadResponse); i {
 			case 0:
 				return &v.state
@@ -6173,7 +11138,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeResponse_DownloadStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6185,7 +11150,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeResponse_SyncResponse); i {
 			case 0:
 				return &v.state
@@ -6197,7 +11162,19 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse_WorkflowStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListManifestsResponse_Manifest); i {
 			case 0:
 				return &v.state
@@ -6209,7 +11186,7 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_agent_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_agent_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListManifestFilesResponse_FileUpload); i {
 			case 0:
 				return &v.state
@@ -6221,21 +11198,72 @@ func file_api_v1_agent_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_agent_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateManifestResponse_Issue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelocateManifestFilesResponse_Relocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisteredWorkflow_Parameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_agent_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictResponse_EvictedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_api_v1_agent_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_api_v1_agent_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_api_v1_agent_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*GetTimeseriesRangeResponse_Error)(nil),
 		(*GetTimeseriesRangeResponse_Data)(nil),
 		(*GetTimeseriesRangeResponse_Channel)(nil),
+		(*GetTimeseriesRangeResponse_Gap)(nil),
+		(*GetTimeseriesRangeResponse_Status)(nil),
 	}
-	file_api_v1_agent_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_api_v1_agent_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*SubscribeResponse_UploadStatus)(nil),
 		(*SubscribeResponse_EventInfo)(nil),
 		(*SubscribeResponse_SyncStatus)(nil),
 		(*SubscribeResponse_DownloadStatus)(nil),
+		(*SubscribeResponse_WorkflowStatus)(nil),
 	}
-	file_api_v1_agent_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_api_v1_agent_proto_msgTypes[48].OneofWrappers = []interface{}{
+	file_api_v1_agent_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_api_v1_agent_proto_msgTypes[76].OneofWrappers = []interface{}{
 		(*DownloadRequest_Dataset)(nil),
 		(*DownloadRequest_Package)(nil),
 	}
@@ -6244,8 +11272,8 @@ func file_api_v1_agent_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_agent_proto_rawDesc,
-			NumEnums:      11,
-			NumMessages:   67,
+			NumEnums:      19,
+			NumMessages:   116,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,9 +29,12 @@ type AgentClient interface {
 	DeleteManifest(ctx context.Context, in *DeleteManifestRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
 	ListManifests(ctx context.Context, in *ListManifestsRequest, opts ...grpc.CallOption) (*ListManifestsResponse, error)
 	ListManifestFiles(ctx context.Context, in *ListManifestFilesRequest, opts ...grpc.CallOption) (*ListManifestFilesResponse, error)
-	RelocateManifestFiles(ctx context.Context, in *RelocateManifestFilesRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	RelocateManifestFiles(ctx context.Context, in *RelocateManifestFilesRequest, opts ...grpc.CallOption) (*RelocateManifestFilesResponse, error)
 	SyncManifest(ctx context.Context, in *SyncManifestRequest, opts ...grpc.CallOption) (*SyncManifestResponse, error)
 	ResetManifest(ctx context.Context, in *ResetManifestRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	ExportManifest(ctx context.Context, in *ExportManifestRequest, opts ...grpc.CallOption) (*ExportManifestResponse, error)
+	ImportManifest(ctx context.Context, in *ImportManifestRequest, opts ...grpc.CallOption) (*ImportManifestResponse, error)
+	ValidateManifest(ctx context.Context, in *ValidateManifestRequest, opts ...grpc.CallOption) (*ValidateManifestResponse, error)
 	// Upload Endpoints
 	UploadManifest(ctx context.Context, in *UploadManifestRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
 	CancelUpload(ctx context.Context, in *CancelUploadRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
@@ -39,9 +42,12 @@ type AgentClient interface {
 	Download(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (*DownloadResponse, error)
 	CancelDownload(ctx context.Context, in *CancelDownloadRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
 	Map(ctx context.Context, in *MapRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error)
 	Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	Evict(ctx context.Context, in *EvictRequest, opts ...grpc.CallOption) (*EvictResponse, error)
 	Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
 	GetMapDiff(ctx context.Context, in *MapDiffRequest, opts ...grpc.CallOption) (*MapDiffResponse, error)
+	ResolveConflict(ctx context.Context, in *ResolveConflictRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
 	// Server Endpoints
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Agent_SubscribeClient, error)
@@ -56,6 +62,11 @@ type AgentClient interface {
 	UseDataset(ctx context.Context, in *UseDatasetRequest, opts ...grpc.CallOption) (*UseDatasetResponse, error)
 	// Workflow Endpoints
 	StartWorkflow(ctx context.Context, in *StartWorkflowRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
+	ListWorkflows(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error)
+	GetWorkflowStatus(ctx context.Context, in *GetWorkflowStatusRequest, opts ...grpc.CallOption) (*GetWorkflowStatusResponse, error)
+	CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	ListRegisteredWorkflows(ctx context.Context, in *ListRegisteredWorkflowsRequest, opts ...grpc.CallOption) (*ListRegisteredWorkflowsResponse, error)
+	DescribeWorkflow(ctx context.Context, in *DescribeWorkflowRequest, opts ...grpc.CallOption) (*DescribeWorkflowResponse, error)
 	// Account Endpoints
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...grpc.CallOption) (*UpdateRoleResponse, error)
@@ -64,6 +75,12 @@ type AgentClient interface {
 	GetTimeseriesChannels(ctx context.Context, in *GetTimeseriesChannelsRequest, opts ...grpc.CallOption) (*GetTimeseriesChannelsResponse, error)
 	GetTimeseriesRangeForChannels(ctx context.Context, in *GetTimeseriesRangeRequest, opts ...grpc.CallOption) (Agent_GetTimeseriesRangeForChannelsClient, error)
 	ResetCache(ctx context.Context, in *ResetCacheRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	GetTimeseriesCacheStats(ctx context.Context, in *GetTimeseriesCacheStatsRequest, opts ...grpc.CallOption) (*GetTimeseriesCacheStatsResponse, error)
+	ExportTimeseries(ctx context.Context, in *ExportTimeseriesRequest, opts ...grpc.CallOption) (*ExportTimeseriesResponse, error)
+	GetTimeseriesGaps(ctx context.Context, in *GetTimeseriesGapsRequest, opts ...grpc.CallOption) (*GetTimeseriesGapsResponse, error)
+	PinTimeseries(ctx context.Context, in *PinTimeseriesRequest, opts ...grpc.CallOption) (*PinTimeseriesResponse, error)
+	UnpinTimeseries(ctx context.Context, in *PinTimeseriesRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error)
+	GetTimeseriesAnnotations(ctx context.Context, in *GetTimeseriesAnnotationsRequest, opts ...grpc.CallOption) (*GetTimeseriesAnnotationsResponse, error)
 }
 
 type agentClient struct {
@@ -128,8 +145,8 @@ func (c *agentClient) ListManifestFiles(ctx context.Context, in *ListManifestFil
 	return out, nil
 }
 
-func (c *agentClient) RelocateManifestFiles(ctx context.Context, in *RelocateManifestFilesRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
-	out := new(SimpleStatusResponse)
+func (c *agentClient) RelocateManifestFiles(ctx context.Context, in *RelocateManifestFilesRequest, opts ...grpc.CallOption) (*RelocateManifestFilesResponse, error) {
+	out := new(RelocateManifestFilesResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/RelocateManifestFiles", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -155,6 +172,33 @@ func (c *agentClient) ResetManifest(ctx context.Context, in *ResetManifestReques
 	return out, nil
 }
 
+func (c *agentClient) ExportManifest(ctx context.Context, in *ExportManifestRequest, opts ...grpc.CallOption) (*ExportManifestResponse, error) {
+	out := new(ExportManifestResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ExportManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ImportManifest(ctx context.Context, in *ImportManifestRequest, opts ...grpc.CallOption) (*ImportManifestResponse, error) {
+	out := new(ImportManifestResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ImportManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ValidateManifest(ctx context.Context, in *ValidateManifestRequest, opts ...grpc.CallOption) (*ValidateManifestResponse, error) {
+	out := new(ValidateManifestResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ValidateManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) UploadManifest(ctx context.Context, in *UploadManifestRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
 	out := new(SimpleStatusResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/UploadManifest", in, out, opts...)
@@ -200,6 +244,15 @@ func (c *agentClient) Map(ctx context.Context, in *MapRequest, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *agentClient) Fetch(ctx context.Context, in *FetchRequest, opts ...grpc.CallOption) (*FetchResponse, error) {
+	out := new(FetchResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/Fetch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) Pull(ctx context.Context, in *PullRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
 	out := new(SimpleStatusResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/Pull", in, out, opts...)
@@ -209,6 +262,15 @@ func (c *agentClient) Pull(ctx context.Context, in *PullRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *agentClient) Evict(ctx context.Context, in *EvictRequest, opts ...grpc.CallOption) (*EvictResponse, error) {
+	out := new(EvictResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/Evict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) Push(ctx context.Context, in *PushRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
 	out := new(SimpleStatusResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/Push", in, out, opts...)
@@ -227,6 +289,15 @@ func (c *agentClient) GetMapDiff(ctx context.Context, in *MapDiffRequest, opts .
 	return out, nil
 }
 
+func (c *agentClient) ResolveConflict(ctx context.Context, in *ResolveConflictRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
+	out := new(SimpleStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ResolveConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
 	out := new(VersionResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/Version", in, out, opts...)
@@ -340,6 +411,51 @@ func (c *agentClient) StartWorkflow(ctx context.Context, in *StartWorkflowReques
 	return out, nil
 }
 
+func (c *agentClient) ListWorkflows(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*ListWorkflowsResponse, error) {
+	out := new(ListWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ListWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetWorkflowStatus(ctx context.Context, in *GetWorkflowStatusRequest, opts ...grpc.CallOption) (*GetWorkflowStatusResponse, error) {
+	out := new(GetWorkflowStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/GetWorkflowStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
+	out := new(SimpleStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ListRegisteredWorkflows(ctx context.Context, in *ListRegisteredWorkflowsRequest, opts ...grpc.CallOption) (*ListRegisteredWorkflowsResponse, error) {
+	out := new(ListRegisteredWorkflowsResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ListRegisteredWorkflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) DescribeWorkflow(ctx context.Context, in *DescribeWorkflowRequest, opts ...grpc.CallOption) (*DescribeWorkflowResponse, error) {
+	out := new(DescribeWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/DescribeWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
 	out := new(RegisterResponse)
 	err := c.cc.Invoke(ctx, "/v1.Agent/Register", in, out, opts...)
@@ -417,6 +533,60 @@ func (c *agentClient) ResetCache(ctx context.Context, in *ResetCacheRequest, opt
 	return out, nil
 }
 
+func (c *agentClient) GetTimeseriesCacheStats(ctx context.Context, in *GetTimeseriesCacheStatsRequest, opts ...grpc.CallOption) (*GetTimeseriesCacheStatsResponse, error) {
+	out := new(GetTimeseriesCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/GetTimeseriesCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ExportTimeseries(ctx context.Context, in *ExportTimeseriesRequest, opts ...grpc.CallOption) (*ExportTimeseriesResponse, error) {
+	out := new(ExportTimeseriesResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/ExportTimeseries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetTimeseriesGaps(ctx context.Context, in *GetTimeseriesGapsRequest, opts ...grpc.CallOption) (*GetTimeseriesGapsResponse, error) {
+	out := new(GetTimeseriesGapsResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/GetTimeseriesGaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) PinTimeseries(ctx context.Context, in *PinTimeseriesRequest, opts ...grpc.CallOption) (*PinTimeseriesResponse, error) {
+	out := new(PinTimeseriesResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/PinTimeseries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) UnpinTimeseries(ctx context.Context, in *PinTimeseriesRequest, opts ...grpc.CallOption) (*SimpleStatusResponse, error) {
+	out := new(SimpleStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/UnpinTimeseries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetTimeseriesAnnotations(ctx context.Context, in *GetTimeseriesAnnotationsRequest, opts ...grpc.CallOption) (*GetTimeseriesAnnotationsResponse, error) {
+	out := new(GetTimeseriesAnnotationsResponse)
+	err := c.cc.Invoke(ctx, "/v1.Agent/GetTimeseriesAnnotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -428,9 +598,12 @@ type AgentServer interface {
 	DeleteManifest(context.Context, *DeleteManifestRequest) (*SimpleStatusResponse, error)
 	ListManifests(context.Context, *ListManifestsRequest) (*ListManifestsResponse, error)
 	ListManifestFiles(context.Context, *ListManifestFilesRequest) (*ListManifestFilesResponse, error)
-	RelocateManifestFiles(context.Context, *RelocateManifestFilesRequest) (*SimpleStatusResponse, error)
+	RelocateManifestFiles(context.Context, *RelocateManifestFilesRequest) (*RelocateManifestFilesResponse, error)
 	SyncManifest(context.Context, *SyncManifestRequest) (*SyncManifestResponse, error)
 	ResetManifest(context.Context, *ResetManifestRequest) (*SimpleStatusResponse, error)
+	ExportManifest(context.Context, *ExportManifestRequest) (*ExportManifestResponse, error)
+	ImportManifest(context.Context, *ImportManifestRequest) (*ImportManifestResponse, error)
+	ValidateManifest(context.Context, *ValidateManifestRequest) (*ValidateManifestResponse, error)
 	// Upload Endpoints
 	UploadManifest(context.Context, *UploadManifestRequest) (*SimpleStatusResponse, error)
 	CancelUpload(context.Context, *CancelUploadRequest) (*SimpleStatusResponse, error)
@@ -438,9 +611,12 @@ type AgentServer interface {
 	Download(context.Context, *DownloadRequest) (*DownloadResponse, error)
 	CancelDownload(context.Context, *CancelDownloadRequest) (*SimpleStatusResponse, error)
 	Map(context.Context, *MapRequest) (*SimpleStatusResponse, error)
+	Fetch(context.Context, *FetchRequest) (*FetchResponse, error)
 	Pull(context.Context, *PullRequest) (*SimpleStatusResponse, error)
+	Evict(context.Context, *EvictRequest) (*EvictResponse, error)
 	Push(context.Context, *PushRequest) (*SimpleStatusResponse, error)
 	GetMapDiff(context.Context, *MapDiffRequest) (*MapDiffResponse, error)
+	ResolveConflict(context.Context, *ResolveConflictRequest) (*SimpleStatusResponse, error)
 	// Server Endpoints
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	Subscribe(*SubscribeRequest, Agent_SubscribeServer) error
@@ -455,6 +631,11 @@ type AgentServer interface {
 	UseDataset(context.Context, *UseDatasetRequest) (*UseDatasetResponse, error)
 	// Workflow Endpoints
 	StartWorkflow(context.Context, *StartWorkflowRequest) (*WorkflowResponse, error)
+	ListWorkflows(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error)
+	GetWorkflowStatus(context.Context, *GetWorkflowStatusRequest) (*GetWorkflowStatusResponse, error)
+	CancelWorkflow(context.Context, *CancelWorkflowRequest) (*SimpleStatusResponse, error)
+	ListRegisteredWorkflows(context.Context, *ListRegisteredWorkflowsRequest) (*ListRegisteredWorkflowsResponse, error)
+	DescribeWorkflow(context.Context, *DescribeWorkflowRequest) (*DescribeWorkflowResponse, error)
 	// Account Endpoints
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
@@ -463,6 +644,12 @@ type AgentServer interface {
 	GetTimeseriesChannels(context.Context, *GetTimeseriesChannelsRequest) (*GetTimeseriesChannelsResponse, error)
 	GetTimeseriesRangeForChannels(*GetTimeseriesRangeRequest, Agent_GetTimeseriesRangeForChannelsServer) error
 	ResetCache(context.Context, *ResetCacheRequest) (*SimpleStatusResponse, error)
+	GetTimeseriesCacheStats(context.Context, *GetTimeseriesCacheStatsRequest) (*GetTimeseriesCacheStatsResponse, error)
+	ExportTimeseries(context.Context, *ExportTimeseriesRequest) (*ExportTimeseriesResponse, error)
+	GetTimeseriesGaps(context.Context, *GetTimeseriesGapsRequest) (*GetTimeseriesGapsResponse, error)
+	PinTimeseries(context.Context, *PinTimeseriesRequest) (*PinTimeseriesResponse, error)
+	UnpinTimeseries(context.Context, *PinTimeseriesRequest) (*SimpleStatusResponse, error)
+	GetTimeseriesAnnotations(context.Context, *GetTimeseriesAnnotationsRequest) (*GetTimeseriesAnnotationsResponse, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -488,7 +675,7 @@ func (UnimplementedAgentServer) ListManifests(context.Context, *ListManifestsReq
 func (UnimplementedAgentServer) ListManifestFiles(context.Context, *ListManifestFilesRequest) (*ListManifestFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListManifestFiles not implemented")
 }
-func (UnimplementedAgentServer) RelocateManifestFiles(context.Context, *RelocateManifestFilesRequest) (*SimpleStatusResponse, error) {
+func (UnimplementedAgentServer) RelocateManifestFiles(context.Context, *RelocateManifestFilesRequest) (*RelocateManifestFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RelocateManifestFiles not implemented")
 }
 func (UnimplementedAgentServer) SyncManifest(context.Context, *SyncManifestRequest) (*SyncManifestResponse, error) {
@@ -497,6 +684,15 @@ func (UnimplementedAgentServer) SyncManifest(context.Context, *SyncManifestReque
 func (UnimplementedAgentServer) ResetManifest(context.Context, *ResetManifestRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetManifest not implemented")
 }
+func (UnimplementedAgentServer) ExportManifest(context.Context, *ExportManifestRequest) (*ExportManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportManifest not implemented")
+}
+func (UnimplementedAgentServer) ImportManifest(context.Context, *ImportManifestRequest) (*ImportManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportManifest not implemented")
+}
+func (UnimplementedAgentServer) ValidateManifest(context.Context, *ValidateManifestRequest) (*ValidateManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateManifest not implemented")
+}
 func (UnimplementedAgentServer) UploadManifest(context.Context, *UploadManifestRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadManifest not implemented")
 }
@@ -512,15 +708,24 @@ func (UnimplementedAgentServer) CancelDownload(context.Context, *CancelDownloadR
 func (UnimplementedAgentServer) Map(context.Context, *MapRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Map not implemented")
 }
+func (UnimplementedAgentServer) Fetch(context.Context, *FetchRequest) (*FetchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fetch not implemented")
+}
 func (UnimplementedAgentServer) Pull(context.Context, *PullRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pull not implemented")
 }
+func (UnimplementedAgentServer) Evict(context.Context, *EvictRequest) (*EvictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evict not implemented")
+}
 func (UnimplementedAgentServer) Push(context.Context, *PushRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Push not implemented")
 }
 func (UnimplementedAgentServer) GetMapDiff(context.Context, *MapDiffRequest) (*MapDiffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMapDiff not implemented")
 }
+func (UnimplementedAgentServer) ResolveConflict(context.Context, *ResolveConflictRequest) (*SimpleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveConflict not implemented")
+}
 func (UnimplementedAgentServer) Version(context.Context, *VersionRequest) (*VersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -551,6 +756,21 @@ func (UnimplementedAgentServer) UseDataset(context.Context, *UseDatasetRequest)
 func (UnimplementedAgentServer) StartWorkflow(context.Context, *StartWorkflowRequest) (*WorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartWorkflow not implemented")
 }
+func (UnimplementedAgentServer) ListWorkflows(context.Context, *ListWorkflowsRequest) (*ListWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflows not implemented")
+}
+func (UnimplementedAgentServer) GetWorkflowStatus(context.Context, *GetWorkflowStatusRequest) (*GetWorkflowStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowStatus not implemented")
+}
+func (UnimplementedAgentServer) CancelWorkflow(context.Context, *CancelWorkflowRequest) (*SimpleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (UnimplementedAgentServer) ListRegisteredWorkflows(context.Context, *ListRegisteredWorkflowsRequest) (*ListRegisteredWorkflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegisteredWorkflows not implemented")
+}
+func (UnimplementedAgentServer) DescribeWorkflow(context.Context, *DescribeWorkflowRequest) (*DescribeWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorkflow not implemented")
+}
 func (UnimplementedAgentServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
@@ -569,6 +789,24 @@ func (UnimplementedAgentServer) GetTimeseriesRangeForChannels(*GetTimeseriesRang
 func (UnimplementedAgentServer) ResetCache(context.Context, *ResetCacheRequest) (*SimpleStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetCache not implemented")
 }
+func (UnimplementedAgentServer) GetTimeseriesCacheStats(context.Context, *GetTimeseriesCacheStatsRequest) (*GetTimeseriesCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeseriesCacheStats not implemented")
+}
+func (UnimplementedAgentServer) ExportTimeseries(context.Context, *ExportTimeseriesRequest) (*ExportTimeseriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTimeseries not implemented")
+}
+func (UnimplementedAgentServer) GetTimeseriesGaps(context.Context, *GetTimeseriesGapsRequest) (*GetTimeseriesGapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeseriesGaps not implemented")
+}
+func (UnimplementedAgentServer) PinTimeseries(context.Context, *PinTimeseriesRequest) (*PinTimeseriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinTimeseries not implemented")
+}
+func (UnimplementedAgentServer) UnpinTimeseries(context.Context, *PinTimeseriesRequest) (*SimpleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinTimeseries not implemented")
+}
+func (UnimplementedAgentServer) GetTimeseriesAnnotations(context.Context, *GetTimeseriesAnnotationsRequest) (*GetTimeseriesAnnotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimeseriesAnnotations not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -744,6 +982,60 @@ func _Agent_ResetManifest_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ExportManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ExportManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ExportManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ExportManifest(ctx, req.(*ExportManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ImportManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ImportManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ImportManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ImportManifest(ctx, req.(*ImportManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ValidateManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ValidateManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ValidateManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ValidateManifest(ctx, req.(*ValidateManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_UploadManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadManifestRequest)
 	if err := dec(in); err != nil {
@@ -834,6 +1126,24 @@ func _Agent_Map_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_Fetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Fetch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/Fetch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Fetch(ctx, req.(*FetchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_Pull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PullRequest)
 	if err := dec(in); err != nil {
@@ -852,6 +1162,24 @@ func _Agent_Pull_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_Evict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Evict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/Evict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Evict(ctx, req.(*EvictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_Push_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PushRequest)
 	if err := dec(in); err != nil {
@@ -888,6 +1216,24 @@ func _Agent_GetMapDiff_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ResolveConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ResolveConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ResolveConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ResolveConflict(ctx, req.(*ResolveConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionRequest)
 	if err := dec(in); err != nil {
@@ -1071,6 +1417,96 @@ func _Agent_StartWorkflow_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ListWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ListWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListWorkflows(ctx, req.(*ListWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetWorkflowStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetWorkflowStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/GetWorkflowStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetWorkflowStatus(ctx, req.(*GetWorkflowStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CancelWorkflow(ctx, req.(*CancelWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ListRegisteredWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegisteredWorkflowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListRegisteredWorkflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ListRegisteredWorkflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListRegisteredWorkflows(ctx, req.(*ListRegisteredWorkflowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_DescribeWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).DescribeWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/DescribeWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).DescribeWorkflow(ctx, req.(*DescribeWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterRequest)
 	if err := dec(in); err != nil {
@@ -1182,6 +1618,114 @@ func _Agent_ResetCache_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_GetTimeseriesCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeseriesCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetTimeseriesCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/GetTimeseriesCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetTimeseriesCacheStats(ctx, req.(*GetTimeseriesCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ExportTimeseries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTimeseriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ExportTimeseries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/ExportTimeseries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ExportTimeseries(ctx, req.(*ExportTimeseriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetTimeseriesGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeseriesGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetTimeseriesGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/GetTimeseriesGaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetTimeseriesGaps(ctx, req.(*GetTimeseriesGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_PinTimeseries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinTimeseriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).PinTimeseries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/PinTimeseries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).PinTimeseries(ctx, req.(*PinTimeseriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_UnpinTimeseries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinTimeseriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).UnpinTimeseries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/UnpinTimeseries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).UnpinTimeseries(ctx, req.(*PinTimeseriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetTimeseriesAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimeseriesAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetTimeseriesAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.Agent/GetTimeseriesAnnotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetTimeseriesAnnotations(ctx, req.(*GetTimeseriesAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1225,6 +1769,18 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetManifest",
 			Handler:    _Agent_ResetManifest_Handler,
 		},
+		{
+			MethodName: "ExportManifest",
+			Handler:    _Agent_ExportManifest_Handler,
+		},
+		{
+			MethodName: "ImportManifest",
+			Handler:    _Agent_ImportManifest_Handler,
+		},
+		{
+			MethodName: "ValidateManifest",
+			Handler:    _Agent_ValidateManifest_Handler,
+		},
 		{
 			MethodName: "UploadManifest",
 			Handler:    _Agent_UploadManifest_Handler,
@@ -1245,10 +1801,18 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Map",
 			Handler:    _Agent_Map_Handler,
 		},
+		{
+			MethodName: "Fetch",
+			Handler:    _Agent_Fetch_Handler,
+		},
 		{
 			MethodName: "Pull",
 			Handler:    _Agent_Pull_Handler,
 		},
+		{
+			MethodName: "Evict",
+			Handler:    _Agent_Evict_Handler,
+		},
 		{
 			MethodName: "Push",
 			Handler:    _Agent_Push_Handler,
@@ -1257,6 +1821,10 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMapDiff",
 			Handler:    _Agent_GetMapDiff_Handler,
 		},
+		{
+			MethodName: "ResolveConflict",
+			Handler:    _Agent_ResolveConflict_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Agent_Version_Handler,
@@ -1293,6 +1861,26 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartWorkflow",
 			Handler:    _Agent_StartWorkflow_Handler,
 		},
+		{
+			MethodName: "ListWorkflows",
+			Handler:    _Agent_ListWorkflows_Handler,
+		},
+		{
+			MethodName: "GetWorkflowStatus",
+			Handler:    _Agent_GetWorkflowStatus_Handler,
+		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _Agent_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "ListRegisteredWorkflows",
+			Handler:    _Agent_ListRegisteredWorkflows_Handler,
+		},
+		{
+			MethodName: "DescribeWorkflow",
+			Handler:    _Agent_DescribeWorkflow_Handler,
+		},
 		{
 			MethodName: "Register",
 			Handler:    _Agent_Register_Handler,
@@ -1313,6 +1901,30 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCache",
 			Handler:    _Agent_ResetCache_Handler,
 		},
+		{
+			MethodName: "GetTimeseriesCacheStats",
+			Handler:    _Agent_GetTimeseriesCacheStats_Handler,
+		},
+		{
+			MethodName: "ExportTimeseries",
+			Handler:    _Agent_ExportTimeseries_Handler,
+		},
+		{
+			MethodName: "GetTimeseriesGaps",
+			Handler:    _Agent_GetTimeseriesGaps_Handler,
+		},
+		{
+			MethodName: "PinTimeseries",
+			Handler:    _Agent_PinTimeseries_Handler,
+		},
+		{
+			MethodName: "UnpinTimeseries",
+			Handler:    _Agent_UnpinTimeseries_Handler,
+		},
+		{
+			MethodName: "GetTimeseriesAnnotations",
+			Handler:    _Agent_GetTimeseriesAnnotations_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -11,13 +11,19 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 var port int32
 var stopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Stop Agent",
-	Long:  `Stops the Pennsieve agent if it is running in the background.`,
+	Long: `Stops the Pennsieve agent if it is running in the background.
+
+By default, the agent stops accepting new uploads and waits for active uploads to finish
+before it stops. Uploads that do not finish within the timeout are cancelled; their
+remaining files are uploaded the next time the manifest is uploaded.
+Use --force to stop the agent immediately.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		port, _ := cmd.Flags().GetString("port")
@@ -26,7 +32,9 @@ var stopCmd = &cobra.Command{
 			fmt.Printf("Stopping port: %s\n", port)
 		}
 
-		req := api.StopRequest{}
+		force, _ := cmd.Flags().GetBool("force")
+		timeout, _ := cmd.Flags().GetInt32("timeout")
+		req := api.StopRequest{Force: force, Timeout: timeout}
 
 		conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
@@ -48,6 +56,7 @@ var stopCmd = &cobra.Command{
 			default:
 				shared.HandleAgentError(err, "Unknown error while stopping Pennsieve Agent Server.")
 			}
+			return
 		}
 
 		// Close the server on that port
@@ -60,15 +69,60 @@ var stopCmd = &cobra.Command{
 			default:
 				shared.HandleAgentError(err, "Unknown error while stopping Pennsieve Agent Server.")
 			}
+			return
 		}
 
 		if resp.Success {
+			if !force {
+				fmt.Println("Waiting for active uploads to finish...")
+			}
+
+			shutdownTimeout := time.Duration(timeout) * time.Second
+			if shutdownTimeout <= 0 {
+				shutdownTimeout = time.Duration(viper.GetInt("agent.shutdown_timeout")) * time.Second
+			}
+			if !waitForStop(client, stopDeadline(shutdownTimeout)) {
+				fmt.Println("Pennsieve Agent did not stop in time; check the agent log for active uploads.")
+				return
+			}
 			fmt.Println("Pennsieve Agent successfully stopped.")
 		}
 
 	},
 }
 
+// stopFlushTimeout is the time the agent takes at most to flush the status of cancelled uploads.
+const stopFlushTimeout = 30 * time.Second
+
+// stopDeadline returns how long the agent may take to stop with the provided shutdown timeout. The
+// agent waits up to the timeout for active uploads, flushes cancelled uploads and then waits up to
+// the timeout again for open requests.
+func stopDeadline(shutdownTimeout time.Duration) time.Duration {
+	return 2*shutdownTimeout + stopFlushTimeout + 5*time.Second
+}
+
+// waitForStop blocks until the agent no longer responds and returns false if it still responds after
+// the deadline.
+func waitForStop(client api.AgentClient, deadline time.Duration) bool {
+	expired := time.After(deadline)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := client.Ping(ctx, &api.PingRequest{})
+		cancel()
+		if err != nil {
+			return true
+		}
+
+		select {
+		case <-expired:
+			return false
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
 func init() {
 	stopCmd.Flags().StringP("port", "p", "", "Agent Port")
+	stopCmd.Flags().BoolP("force", "f", false, "Stop immediately without waiting for active uploads")
+	stopCmd.Flags().Int32("timeout", 0, "Seconds to wait for active uploads (default: agent.shutdown_timeout)")
 }
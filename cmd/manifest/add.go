@@ -9,14 +9,22 @@ import (
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"path/filepath"
 	"strconv"
 )
 
 var AddCmd = &cobra.Command{
 	Use:   "add [manifest-id] [PATH]",
 	Short: "Add to manifest for upload.",
-	Long:  `Add to manifest for upload.`,
-	Args:  cobra.MinimumNArgs(2),
+	Long: `Add to manifest for upload.
+
+Use --metadata to attach key/value metadata, such as subject ID or session, to the added
+files. The metadata is stored with the files in the local manifest; it is not sent to
+Pennsieve when the manifest is uploaded.
+The metadata file is a CSV file with a path column and a column for each key, or a JSON
+file that maps each path to an object with keys and values. Relative paths are relative
+to PATH.`,
+	Args: cobra.MinimumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
 
 		i, err := strconv.ParseInt(args[0], 10, 32)
@@ -29,12 +37,21 @@ var AddCmd = &cobra.Command{
 
 		targetBasePath, _ := cmd.Flags().GetString("target_path")
 		recursive, _ := cmd.Flags().GetBool("recursive")
+		metadataPath, _ := cmd.Flags().GetString("metadata")
+		if metadataPath != "" {
+			metadataPath, err = filepath.Abs(metadataPath)
+			if err != nil {
+				fmt.Println("Error: Invalid metadata path:", err)
+				return
+			}
+		}
 
 		req := api.AddToManifestRequest{
 			ManifestId:     manifestId,
 			BasePath:       localBasePath,
 			TargetBasePath: targetBasePath,
 			Recursive:      recursive,
+			MetadataPath:   metadataPath,
 		}
 
 		port := viper.GetString("agent.port")
@@ -63,4 +80,7 @@ func init() {
 
 	AddCmd.Flags().BoolP("recursive", "r",
 		true, "Set indexing to be recursive")
+
+	AddCmd.Flags().String("metadata",
+		"", "CSV or JSON file with key/value metadata of the files")
 }
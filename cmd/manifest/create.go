@@ -16,7 +16,11 @@ import (
 var CreateCmd = &cobra.Command{
 	Use:   "create [flags] [PATH] [...PATH]",
 	Short: "Creates manifest for upload.",
-	Long:  `Creates manifest for upload.`,
+	Long: `Creates manifest for upload.
+
+Use --validate bids to check the target paths and names of the files against the core BIDS
+rules once the manifest is created. Errors block "pennsieve upload manifest --validate bids"
+unless --force is set.`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		targetBasePath, _ := cmd.Flags().GetString("target_path")
@@ -69,6 +73,11 @@ var CreateCmd = &cobra.Command{
 		}
 
 		fmt.Println("Manifest ID:", manifestResponse.ManifestId, "Message:", manifestResponse.Message)
+
+		if validate, _ := cmd.Flags().GetString("validate"); validate != "" {
+			fmt.Println()
+			shared.ValidateManifest(client, manifestResponse.ManifestId, validate)
+		}
 	},
 }
 
@@ -79,4 +88,7 @@ func init() {
 	CreateCmd.Flags().BoolP("auto_path", "a",
 		false, "Automatically creates the base path for dataset using the last folder in the given path")
 
+	CreateCmd.Flags().String("validate",
+		"", "Validate the layout of the manifest against a data standard: bids")
+
 }
@@ -0,0 +1,69 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var ExportCmd = &cobra.Command{
+	Use:   "export MANIFEST-ID",
+	Short: "Exports a manifest to a CSV or JSON file.",
+	Long: `Writes the files of a manifest to a CSV or JSON file with the source path, target path,
+target name, status, upload ID and size of each file.
+
+The file is written to manifest-<MANIFEST-ID>.<format> in the current folder, unless
+--output is provided. The size is empty if a file does not exist anymore.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		manifestId, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			fmt.Println("Error: Invalid manifest ID:", args[0])
+			return
+		}
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		if output == "" {
+			output = fmt.Sprintf("manifest-%d.%s", manifestId, format)
+		}
+		output, err = filepath.Abs(output)
+		if err != nil {
+			fmt.Println("Error: Invalid output path:", err)
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.ExportManifest(context.Background(), &api.ExportManifestRequest{
+			ManifestId: int32(manifestId),
+			Path:       output,
+			Format:     format,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to export manifest: %v", err))
+			return
+		}
+
+		fmt.Printf("Exported %d files of manifest %d to %s\n", response.NrFiles, manifestId, response.Path)
+	},
+}
+
+func init() {
+	ExportCmd.Flags().String("format", "csv", "Format of the file: csv or json")
+	ExportCmd.Flags().StringP("output", "o", "", "File that the manifest is written to")
+}
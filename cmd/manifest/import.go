@@ -0,0 +1,63 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var ImportCmd = &cobra.Command{
+	Use:   "import FILE --dataset DATASET-ID",
+	Short: "Creates a manifest from a CSV or JSON file.",
+	Long: `Creates a manifest for a dataset from a file written by "pennsieve manifest export", or any
+CSV or JSON file with the same fields. Only source_path is required; the target name defaults
+to the name of the file. Relative source paths are relative to the folder of FILE.
+
+All files must exist on this machine. The status and upload ID in the file are ignored, so
+the files are uploaded as new files.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		datasetId, _ := cmd.Flags().GetString("dataset")
+		format, _ := cmd.Flags().GetString("format")
+		input, err := filepath.Abs(args[0])
+		if err != nil {
+			fmt.Println("Error: Invalid path:", err)
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.ImportManifest(context.Background(), &api.ImportManifestRequest{
+			Path:      input,
+			DatasetId: datasetId,
+			Format:    format,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to import manifest: %v", err))
+			return
+		}
+
+		fmt.Printf("Created manifest %d with %d files.\n", response.ManifestId, response.NrFiles)
+		fmt.Printf("\nUse \"pennsieve upload manifest %d\" to upload the files.\n", response.ManifestId)
+	},
+}
+
+func init() {
+	ImportCmd.Flags().String("dataset", "", "Dataset that the files are uploaded to")
+	ImportCmd.Flags().String("format", "", "Format of the file: csv or json (default: from the extension)")
+	ImportCmd.MarkFlagRequired("dataset")
+}
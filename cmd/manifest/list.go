@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
@@ -18,8 +19,13 @@ import (
 var ListCmd = &cobra.Command{
 	Use:   "list [flags] <manifestId> [limit] [offset]",
 	Short: "lists files for a manifest.",
-	Long:  `Lists files for a manifest.`,
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Lists files for a manifest.
+
+Files can be filtered by status, by path in the dataset and by source path, for example:
+
+  pennsieve manifest list 1 --status failed --path 'sub-03/*'
+  pennsieve manifest list 1 --source-path /data/eeg/`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 
 		limit := int32(100)
@@ -46,10 +52,35 @@ var ListCmd = &cobra.Command{
 			limit = int32(i)
 		}
 
+		statuses, _ := cmd.Flags().GetStringSlice("status")
+		pathFilter, _ := cmd.Flags().GetString("path")
+		sourcePathFilter, _ := cmd.Flags().GetString("source-path")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		descending, _ := cmd.Flags().GetBool("desc")
+
 		req := api.ListManifestFilesRequest{
 			ManifestId: manifestId,
 			Offset:     offset,
 			Limit:      limit,
+			Path:       pathFilter,
+			SourcePath: sourcePathFilter,
+			Descending: descending,
+		}
+		for _, st := range statuses {
+			value, ok := api.ListManifestFilesResponse_StatusType_value[strings.ToUpper(st)]
+			if !ok {
+				fmt.Printf("Error: unknown status %q\n", st)
+				return
+			}
+			req.Status = append(req.Status, api.ListManifestFilesResponse_StatusType(value))
+		}
+		if sortBy != "" {
+			value, ok := api.ListManifestFilesRequest_SortField_value[strings.ToUpper(strings.ReplaceAll(sortBy, "-", "_"))]
+			if !ok {
+				fmt.Printf("Error: unknown sort field %q\n", sortBy)
+				return
+			}
+			req.SortBy = api.ListManifestFilesRequest_SortField(value)
 		}
 
 		port := viper.GetString("agent.port")
@@ -76,6 +107,16 @@ var ListCmd = &cobra.Command{
 func init() {
 	ListCmd.Flags().BoolP("full", "f",
 		false, "Show expanded information")
+	ListCmd.Flags().StringSlice("status", nil,
+		"Only list files with one of the statuses, such as local, failed or verified")
+	ListCmd.Flags().String("path", "",
+		"Only list files whose path in the dataset matches a glob (sub-03/*) or starts with a prefix")
+	ListCmd.Flags().String("source-path", "",
+		"Only list files whose source path matches a glob (/data/*.edf) or starts with a prefix")
+	ListCmd.Flags().String("sort", "",
+		"Sort files by id, source-path, target-path, status or updated-at")
+	ListCmd.Flags().Bool("desc", false,
+		"Sort files in descending order")
 }
 
 // PrettyPrint renders a table with current userinfo to terminal
@@ -83,6 +124,7 @@ func PrettyPrint(files *api.ListManifestFilesResponse, manifestID string, showFu
 	t := table.NewWriter()
 	t.SetOutputMirror(os.Stdout)
 	t.SetTitle(fmt.Sprintf("Files for upload manifest: %s", manifestID))
+	t.SetCaption(fmt.Sprintf("Showing %d of %d files.", len(files.File), files.Total))
 	if showFull {
 		t.AppendHeader(table.Row{"id", "Upload ID", "Source Path", "Target Path", "Status"})
 		for _, path := range files.File {
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"os"
+	"sort"
+	"strings"
 	"unicode"
 )
 
@@ -42,11 +44,11 @@ This list includes only upload manifests that are initiated from the current mac
 
 		t := table.NewWriter()
 		t.SetOutputMirror(os.Stdout)
-		t.AppendHeader(table.Row{"Upload Manifest", "User Name", "Organization Name", "Dataset ID", "Status", "nodeId"})
+		t.AppendHeader(table.Row{"Upload Manifest", "User Name", "Organization Name", "Dataset ID", "Status", "Files", "nodeId"})
 		for _, s := range manifestResponse.Manifests {
 			const maxLength = 100
 			dsName := trimName(s.DatasetName, maxLength)
-			t.AppendRow([]interface{}{s.Id, s.UserName, s.OrganizationName, dsName, s.Status, s.NodeId})
+			t.AppendRow([]interface{}{s.Id, s.UserName, s.OrganizationName, dsName, s.Status, formatStatusCounts(s.StatusCounts), s.NodeId})
 		}
 
 		t.Render()
@@ -61,6 +63,26 @@ func init() {
 	ManifestCmd.AddCommand(DeleteCmd)
 	ManifestCmd.AddCommand(SyncCmd)
 	ManifestCmd.AddCommand(ResetCmd)
+	ManifestCmd.AddCommand(MoveCmd)
+	ManifestCmd.AddCommand(RenameCmd)
+	ManifestCmd.AddCommand(RetargetCmd)
+	ManifestCmd.AddCommand(ExportCmd)
+	ManifestCmd.AddCommand(ImportCmd)
+}
+
+// formatStatusCounts returns the number of files for each status on a line per status, sorted by status.
+func formatStatusCounts(counts map[string]int64) string {
+	statuses := make([]string, 0, len(counts))
+	for st := range counts {
+		statuses = append(statuses, st)
+	}
+	sort.Strings(statuses)
+
+	lines := make([]string, 0, len(statuses))
+	for _, st := range statuses {
+		lines = append(lines, fmt.Sprintf("%s: %d", st, counts[st]))
+	}
+	return strings.Join(lines, "\n")
 }
 
 func trimName(str string, max int) string {
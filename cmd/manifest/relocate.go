@@ -0,0 +1,130 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var MoveCmd = &cobra.Command{
+	Use:   "move -m MANIFEST-ID TARGET-PATH NEW-TARGET-PATH",
+	Short: "Moves files in a manifest to another target path.",
+	Long: `Moves the files in a manifest with a target path in TARGET-PATH to NEW-TARGET-PATH.
+Sub-folders of TARGET-PATH are moved with their files. Use "" to move all files of the manifest.
+
+Files can be moved until they are uploaded. Files that are registered with Pennsieve are
+synchronized again with their new target path.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		relocate(cmd, &api.RelocateManifestFilesRequest{
+			Path:        args[0],
+			UpdatedPath: args[1],
+		})
+	},
+}
+
+var RenameCmd = &cobra.Command{
+	Use:   "rename -m MANIFEST-ID PATH NEW-NAME",
+	Short: "Renames a file in a manifest.",
+	Long: `Changes the name under which a file in a manifest is uploaded. PATH is the source path
+of the file on this machine, or its target path and name in the dataset.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		relocate(cmd, &api.RelocateManifestFilesRequest{
+			Path:       args[0],
+			TargetName: args[1],
+		})
+	},
+}
+
+var RetargetCmd = &cobra.Command{
+	Use:   "retarget -m MANIFEST-ID --pattern REGEX --template TEMPLATE",
+	Short: "Sets the target path of files from a template.",
+	Long: `Sets the target path of the files in a manifest whose source path matches the regular
+expression REGEX. Fields in TEMPLATE are replaced by the named or numbered groups of the match.
+
+For example, to organize files by subject and session:
+
+  pennsieve manifest retarget -m 1 \
+    --pattern '(?P<subject>\d+)_(?P<session>\d+)\.edf$' \
+    --template 'sub-{subject}/ses-{session}'`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		pattern, _ := cmd.Flags().GetString("pattern")
+		template, _ := cmd.Flags().GetString("template")
+		relocate(cmd, &api.RelocateManifestFilesRequest{
+			SourcePattern: pattern,
+			PathTemplate:  template,
+		})
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{MoveCmd, RenameCmd, RetargetCmd} {
+		cmd.Flags().Int32P("manifest_id", "m", 0, "Manifest ID.")
+		cmd.MarkFlagRequired("manifest_id")
+		cmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	}
+	RetargetCmd.Flags().String("pattern", "", "Regular expression matched against the source paths of files")
+	RetargetCmd.Flags().String("template", "", "Target path template, such as sub-{subject}/ses-{session}")
+	RetargetCmd.MarkFlagRequired("pattern")
+	RetargetCmd.MarkFlagRequired("template")
+}
+
+// relocate sends the relocate request for the manifest and prints the result.
+func relocate(cmd *cobra.Command, req *api.RelocateManifestFilesRequest) {
+	req.ManifestId, _ = cmd.Flags().GetInt32("manifest_id")
+	req.DryRun, _ = cmd.Flags().GetBool("dry-run")
+
+	port := viper.GetString("agent.port")
+	conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Println("Error connecting to GRPC Server: ", err)
+		return
+	}
+	defer conn.Close()
+
+	client := api.NewAgentClient(conn)
+	response, err := client.RelocateManifestFiles(context.Background(), req)
+	if err != nil {
+		shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to relocate files: %v", err))
+		return
+	}
+
+	if req.DryRun {
+		if len(response.Relocations) == 0 {
+			fmt.Println("No files would be changed.")
+			return
+		}
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Source Path", "Current Target", "New Target"})
+		for _, r := range response.Relocations {
+			t.AppendRow([]interface{}{r.SourcePath, joinTarget(r.TargetPath, r.TargetName),
+				joinTarget(r.UpdatedTargetPath, r.UpdatedTargetName)})
+		}
+		t.Render()
+		fmt.Printf("%d files would be changed.\n", response.NrFilesUpdated)
+		return
+	}
+
+	fmt.Printf("Updated %d files in manifest %d.\n", response.NrFilesUpdated, req.ManifestId)
+	if response.NrFilesResynced > 0 {
+		fmt.Printf("Synchronizing %d registered files with their new target.\n", response.NrFilesResynced)
+	}
+}
+
+func joinTarget(targetPath string, targetName string) string {
+	if targetPath == "" {
+		return targetName
+	}
+	return targetPath + "/" + targetName
+}
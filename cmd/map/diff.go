@@ -5,6 +5,7 @@ import (
     "fmt"
     "github.com/jedib0t/go-pretty/v6/table"
     api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+    pkgShared "github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
     "github.com/spf13/cobra"
     "github.com/spf13/viper"
     "google.golang.org/grpc"
@@ -24,12 +25,32 @@ var DiffCmd = &cobra.Command{
   mapped dataset compared to the last time the dataset was fetched from
   the Pennsieve servers. Users will be notified of ADDED, RENAMED, MOVED,
   DELETED and CHANGED files.
+
+  The '--strength' flag determines how file contents are compared:
+    fast        CRC32 over the entire file (default)
+    full        SHA-256 over the entire file
+    size+mtime  File size and modification time only
+
+  The default can be set with 'diff_strength' in the agent section of
+  the configuration file.
   `,
     Args: cobra.MinimumNArgs(1),
     Run: func(cmd *cobra.Command, args []string) {
 
+        strengthValue := viper.GetString("agent.diff_strength")
+        if cmd.Flags().Changed("strength") {
+            strengthValue, _ = cmd.Flags().GetString("strength")
+        }
+
+        strength, err := pkgShared.ParseDiffStrength(strengthValue)
+        if err != nil {
+            fmt.Println("Error: ", err)
+            return
+        }
+
         statusRequest := api.MapDiffRequest{
-            Path: filepath.ToSlash(args[0]),
+            Path:     filepath.ToSlash(args[0]),
+            Strength: strength,
         }
 
         port := viper.GetString("agent.port")
@@ -76,5 +97,5 @@ func statusArrayToString(statusArr []api.PackageStatus_StatusType) string {
 }
 
 func init() {
-
+    DiffCmd.Flags().String("strength", "fast", "Content comparison: fast, full or size+mtime")
 }
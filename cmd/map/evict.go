@@ -0,0 +1,85 @@
+package _map
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var EvictCmd = &cobra.Command{
+	Use:   "evict [path]",
+	Short: "Replace pulled files with placeholders to free up space.",
+	Long: `
+  [BETA] This feature is in Beta mode and is currently still undergoing
+  testing and optimization.
+
+  The "evict" command reverses the "pull" command. Pulled files in the
+  provided file or folder are replaced by placeholders representing the
+  file on Pennsieve, which frees up space on your local machine. Use
+  "pull" to download the files again.
+
+  Only files that are unchanged since they were pulled are evicted.
+  Locally modified files are never evicted; use "push" to upload the
+  changes first.
+  `,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Check and make path absolute
+		absPath, err := shared.GetAbsolutePath(args[0])
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to parse provided path: %v", err))
+			return
+		}
+
+		evictRequest := api.EvictRequest{
+			Path: absPath,
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		evictResponse, err := client.Evict(context.Background(), &evictRequest)
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to complete Evict command: %v", err))
+			return
+		}
+		if evictResponse.Status != "Success" {
+			fmt.Println("Unable to complete evict command: ", evictResponse.Status)
+			log.Errorf("Unable to complete evict command: %v", evictResponse.Status)
+			return
+		}
+
+		if len(evictResponse.Files) == 0 {
+			fmt.Println("No pulled files found in: ", args[0])
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Path", "Size", "Result"})
+		for _, f := range evictResponse.Files {
+			t.AppendRow([]interface{}{f.Path, shared.FormatBytes(f.Size), f.Message})
+		}
+
+		t.Render()
+		fmt.Println("Freed: ", shared.FormatBytes(evictResponse.FreedBytes))
+	},
+}
@@ -1,58 +1,84 @@
 package _map
 
 import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 var FetchCmd = &cobra.Command{
-	Use:   "fetch [dataset_id] [target_path]",
+	Use:   "fetch [path]",
 	Short: "Fetch remote state to locally mapped dataset",
 	Long: `
   [BETA] This feature is in Beta mode and is currently still undergoing
   testing and optimization.
 
-  Currently unimplemented
+  The "fetch" command updates a mapped dataset with the latest state
+  of the dataset on the Pennsieve platform. Placeholders are created
+  for files that were added on Pennsieve, and placeholders for files
+  that were moved, renamed or deleted on Pennsieve are updated.
+
+  Files that were pulled to your local machine are never modified.
+  Remote changes to these files are listed so you can resolve them.
   `,
-	Args: cobra.MinimumNArgs(0),
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		//datasetId := args[0]
-		//
-		//folder := args[1]
-		//
-		//// Check and make path absolute
-		//absPath, err := shared.GetAbsolutePath(folder)
-		//if err != nil {
-		//	fmt.Println(err)
-		//	shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to parse provided path: %v", err))
-		//	return
-		//}
-		//
-		//fetchRequest := api.FetchRequest{
-		//	DatasetId:    datasetId,
-		//	TargetFolder: absPath,
-		//}
-		//
-		//port := viper.GetString("agent.port")
-		//conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
-		//if err != nil {
-		//	fmt.Println("Error connecting to GRPC Server: ", err)
-		//	return
-		//}
-		//defer conn.Close()
-		//
-		//client := api.NewAgentClient(conn)
-		//fetchResponse, err := client.Fetch(context.Background(), &fetchRequest)
-		//if err != nil {
-		//	fmt.Println(err)
-		//	shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to complete Fetch command: %v", err))
-		//	return
-		//}
-		//if fetchResponse.Status == "Success" {
-		//	fmt.Println("Requested Fetch of dataset: ", datasetId)
-		//} else {
-		//	fmt.Println("Unable to request download command: ", fetchResponse.Status)
-		//	log.Errorf("Unable to request download command: %v", fetchResponse.Status)
-		//}
+
+		// Check and make path absolute
+		absPath, err := shared.GetAbsolutePath(args[0])
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to parse provided path: %v", err))
+			return
+		}
+
+		fetchRequest := api.FetchRequest{
+			Path: absPath,
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		fetchResponse, err := client.Fetch(context.Background(), &fetchRequest)
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to complete Fetch command: %v", err))
+			return
+		}
+		if fetchResponse.Status != "Success" {
+			fmt.Println("Unable to complete fetch command: ", fetchResponse.Status)
+			log.Errorf("Unable to complete fetch command: %v", fetchResponse.Status)
+			return
+		}
+
+		if len(fetchResponse.Files) == 0 {
+			fmt.Println("Mapped dataset is up to date.")
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Path", "File Name", "Remote Update", "Result"})
+		for _, s := range fetchResponse.Files {
+			t.AppendRow([]interface{}{s.Content.Path, s.Content.Name, s.ChangeType, s.Content.Message})
+		}
+
+		t.Render()
 	},
 }
 
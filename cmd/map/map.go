@@ -82,5 +82,7 @@ func init() {
 	MapCmd.AddCommand(PullCmd)
 	MapCmd.AddCommand(DiffCmd)
 	MapCmd.AddCommand(PushCmd)
+	MapCmd.AddCommand(ResolveCmd)
+	MapCmd.AddCommand(EvictCmd)
 
 }
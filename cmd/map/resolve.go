@@ -0,0 +1,101 @@
+package _map
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var ResolveCmd = &cobra.Command{
+	Use:   "resolve [path]",
+	Short: "Resolve a conflict between a local and a remote file.",
+	Long: `
+  [BETA] This feature is in Beta mode and is currently still undergoing
+  testing and optimization.
+
+  The "resolve" command resolves a file that is marked as CONFLICT by
+  the "diff" command. A file is in conflict when both the local file and
+  the file on Pennsieve changed since the file was pulled.
+
+  Provide exactly one of the following flags:
+    --keep-local   Keep the local file. The local changes are treated as
+                   changes to the latest version of the file on Pennsieve.
+    --keep-remote  Replace the local file with the file on Pennsieve.
+    --keep-both    Rename the local file to "<name> (local)" and pull the
+                   file on Pennsieve.
+  `,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		keepLocal, _ := cmd.Flags().GetBool("keep-local")
+		keepRemote, _ := cmd.Flags().GetBool("keep-remote")
+		keepBoth, _ := cmd.Flags().GetBool("keep-both")
+
+		var resolution api.ResolveConflictRequest_Resolution
+		nrFlags := 0
+		if keepLocal {
+			resolution = api.ResolveConflictRequest_KEEP_LOCAL
+			nrFlags++
+		}
+		if keepRemote {
+			resolution = api.ResolveConflictRequest_KEEP_REMOTE
+			nrFlags++
+		}
+		if keepBoth {
+			resolution = api.ResolveConflictRequest_KEEP_BOTH
+			nrFlags++
+		}
+		if nrFlags != 1 {
+			fmt.Println("Error: provide exactly one of --keep-local, --keep-remote or --keep-both.")
+			return
+		}
+
+		// Check and make path absolute
+		absPath, err := shared.GetAbsolutePath(args[0])
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to parse provided path: %v", err))
+			return
+		}
+
+		resolveRequest := api.ResolveConflictRequest{
+			Path:       absPath,
+			Resolution: resolution,
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			fmt.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		resolveResponse, err := client.ResolveConflict(context.Background(), &resolveRequest)
+		if err != nil {
+			fmt.Println(err)
+			shared.HandleAgentError(err, fmt.Sprintf("Error: Unable to complete Resolve command: %v", err))
+			return
+		}
+		if resolveResponse.Status == "Success" {
+			fmt.Println("Resolved conflict: ", args[0])
+		} else {
+			fmt.Println("Unable to resolve conflict: ", resolveResponse.Status)
+			log.Errorf("Unable to resolve conflict: %v", resolveResponse.Status)
+		}
+	},
+}
+
+func init() {
+	ResolveCmd.Flags().Bool("keep-local", false, "Keep the local file")
+	ResolveCmd.Flags().Bool("keep-remote", false, "Replace the local file with the file on Pennsieve")
+	ResolveCmd.Flags().Bool("keep-both", false, "Rename the local file and pull the file on Pennsieve")
+}
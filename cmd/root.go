@@ -24,6 +24,7 @@ import (
 	"github.com/pennsieve/pennsieve-agent/v2/cmd/download"
 	_map "github.com/pennsieve/pennsieve-agent/v2/cmd/map"
 	"github.com/pennsieve/pennsieve-agent/v2/cmd/timeseries"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/workflow"
 
 	"github.com/pennsieve/pennsieve-agent/v2/cmd/account"
 	"github.com/pennsieve/pennsieve-agent/v2/cmd/agent"
@@ -83,6 +84,7 @@ func init() {
 	rootCmd.AddCommand(download.DownloadCmd)
 	rootCmd.AddCommand(_map.MapCmd)
 	rootCmd.AddCommand(timeseries.TimeseriesCmd)
+	rootCmd.AddCommand(workflow.WorkflowCmd)
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "",
 		"config file (default is $HOME/.pennsieve/config.ini)")
 
@@ -146,6 +148,27 @@ func initViper() error {
 		viper.SetDefault("agent.upload_chunk_size", "32")
 	}
 
+	viper.SetDefault("agent.diff_strength", "fast")            // fast, full or size+mtime
+	viper.SetDefault("agent.timeseries_cache_size", "10240")   // Maximum timeseries cache size in MB; 0 is unlimited
+	viper.SetDefault("agent.timeseries_download_workers", "8") // Number of concurrent block downloads per range request
+	viper.SetDefault("agent.shutdown_timeout", "60")           // Seconds to wait for active uploads when stopping the agent
+
+	// Workflows
+	viper.SetDefault("agent.workflow_executor", "nextflow")                                     // local, nextflow, podman or apptainer
+	viper.SetDefault("agent.workflow_container_engine", "docker")                               // Container engine of nextflow: docker, podman or apptainer
+	viper.SetDefault("agent.workflow_image", "")                                                // Container image of the podman and apptainer executors
+	viper.SetDefault("agent.workflow_registry", filepath.Join(home, ".pennsieve", "workflows")) // Folder of named workflows
+	viper.SetDefault("agent.workflow_allow_local", false)                                       // Allow the local executor, which runs workflows without a container
+	viper.SetDefault("agent.workflow_local_paths", []string{})                                  // Workflow files, or folders of them, that the local executor may run
+
+	// HTTP gateway for browser-based clients
+	viper.SetDefault("agent.gateway_enabled", false)
+	viper.SetDefault("agent.gateway_host", "localhost")
+	viper.SetDefault("agent.gateway_port", "9001")
+	viper.SetDefault("agent.gateway_origins", []string{})                              // Origins from which browsers may call the gateway
+	viper.SetDefault("agent.gateway_hosts", []string{"localhost", "127.0.0.1", "::1"}) // Host names under which the gateway may be called
+	viper.SetDefault("agent.auth_token", "")                                           // Token required for gateway requests and gRPC requests from other machines
+
 	apiKey := os.Getenv("PENNSIEVE_API_KEY")
 	// use API Key and TOKEN from ENV vars if they exist
 	if len(apiKey) > 0 {
@@ -38,3 +38,30 @@ func GetAbsolutePath(folderStr string) (string, error) {
 
 	return folder, nil
 }
+
+// WorkflowArgument returns the workflow of a command as it is sent to the agent. Workflow files are sent as
+// absolute paths, as the agent does not run in the working directory of the command. Other values name a
+// workflow in the registry and are sent as is.
+func WorkflowArgument(workflow string) (string, error) {
+	if _, err := os.Stat(workflow); err != nil && !strings.ContainsAny(workflow, `/\`) {
+		return workflow, nil
+	}
+
+	return filepath.Abs(workflow)
+}
+
+// FormatBytes returns a human-readable representation of a number of bytes.
+func FormatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
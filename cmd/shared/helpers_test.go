@@ -5,6 +5,8 @@ import (
 )
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -17,6 +19,25 @@ func (h *HelpersTestSuite) TestStubFunction() {
 	h.Equal(true, true)
 }
 
+func (h *HelpersTestSuite) TestWorkflowArgument() {
+	wd, err := os.Getwd()
+	h.Require().NoError(err)
+
+	// Names of registered workflows are sent as is
+	workflow, err := WorkflowArgument("count")
+	h.NoError(err)
+	h.Equal("count", workflow)
+
+	// Workflow files are sent as absolute paths
+	workflow, err = WorkflowArgument("helpers.go")
+	h.NoError(err)
+	h.Equal(filepath.Join(wd, "helpers.go"), workflow)
+
+	workflow, err = WorkflowArgument("workflows/missing.nf")
+	h.NoError(err)
+	h.Equal(filepath.Join(wd, "workflows", "missing.nf"), workflow)
+}
+
 func TestDatasetsSuite(t *testing.T) {
 	suite.Run(t, new(HelpersTestSuite))
 }
@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+)
+
+// ValidateManifest validates the layout of a manifest against the data standard and prints the errors and
+// warnings for each file. It returns nil if the manifest could not be validated.
+func ValidateManifest(client api.AgentClient, manifestId int32, standard string) *api.ValidateManifestResponse {
+	response, err := client.ValidateManifest(context.Background(), &api.ValidateManifestRequest{
+		ManifestId: manifestId,
+		Standard:   standard,
+	})
+	if err != nil {
+		HandleAgentError(err, fmt.Sprintf("Error: Unable to validate manifest: %v", err))
+		return nil
+	}
+
+	if len(response.Issues) > 0 {
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Severity", "Target", "Issue"})
+		for _, issue := range response.Issues {
+			target := issue.Target
+			if target == "" {
+				target = "(dataset)"
+			}
+			t.AppendRow(table.Row{strings.ToLower(issue.Severity.String()), target, issue.Message})
+		}
+		t.Render()
+	}
+	fmt.Printf("Validated manifest %d against %s: %d errors, %d warnings.\n",
+		manifestId, strings.ToUpper(standard), response.NrErrors, response.NrWarnings)
+	return response
+}
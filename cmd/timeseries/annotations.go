@@ -0,0 +1,138 @@
+package timeseries
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/config"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var annotationsCmd = &cobra.Command{
+	Use:   "annotations <package-id> [<start-time> <end-time>]",
+	Short: "Lists the annotations and events of a timeseries package.",
+	Long: `This function lists the annotations and events on a timeseries package, grouped by
+annotation layer. Annotations are cached by the agent, and are available when Pennsieve
+cannot be reached once they have been cached. Use the [--refresh] flag to fetch the latest
+annotations from Pennsieve.
+
+All annotations of the package are listed, unless a time range is provided. By default the
+specified start- and endtimes are defined in uUTC time. By setting the [-r | --relative_time]
+flag, the start- and stoptime are interpreted as relative to the start time of the channels.
+`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 && len(args) != 3 {
+			return fmt.Errorf("accepts a package id and an optional start and end time")
+		}
+		return nil
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+
+		packageId := args[0]
+		var startTimestamp, endTimestamp float64
+		if len(args) == 3 {
+			var err error
+			startTimestamp, err = strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				fmt.Println("Error: Invalid start time:", args[1])
+				return
+			}
+			endTimestamp, err = strconv.ParseFloat(args[2], 64)
+			if err != nil {
+				fmt.Println("Error: Invalid end time:", args[2])
+				return
+			}
+		}
+
+		layerIds, _ := cmd.Flags().GetInt64Slice("layers")
+		channelIds, _ := cmd.Flags().GetStringSlice("channels")
+		doRelativeTime, _ := cmd.Flags().GetBool("relative_time")
+		doRefresh, _ := cmd.Flags().GetBool("refresh")
+
+		db, _ := config.InitializeDB()
+		userSettingsStore := store.NewUserSettingsStore(db)
+		s, _ := userSettingsStore.Get()
+		if len(s.UseDatasetId) == 0 {
+			fmt.Println("\nError: No dataset specified; use 'pennsieve dataset use <node-id>' to set active dataset.")
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+		response, err := client.GetTimeseriesAnnotations(ctx, &api.GetTimeseriesAnnotationsRequest{
+			DatasetId:    s.UseDatasetId,
+			PackageId:    packageId,
+			LayerIds:     layerIds,
+			ChannelIds:   channelIds,
+			StartTime:    startTimestamp,
+			EndTime:      endTimestamp,
+			RelativeTime: doRelativeTime,
+			Refresh:      doRefresh,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error retrieving annotations: %v", err))
+			return
+		}
+
+		if response.Offline {
+			fmt.Println("Pennsieve could not be reached; showing cached annotations.")
+		}
+
+		annotationsByLayer := make(map[int64][]*api.GetTimeseriesAnnotationsResponse_Annotation)
+		for _, a := range response.Annotations {
+			annotationsByLayer[a.LayerId] = append(annotationsByLayer[a.LayerId], a)
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.SetTitle(fmt.Sprintf("Annotations - %s", packageId))
+		t.AppendHeader(table.Row{"Layer", "Label", "Start", "Duration", "Channels", "Description"})
+		for _, layer := range response.Layers {
+			annotations := annotationsByLayer[layer.Id]
+			if len(annotations) == 0 {
+				t.AppendRow(table.Row{layer.Name, "-", "-", "-", "-", "no annotations"})
+			}
+			for _, a := range annotations {
+				channels := "all"
+				if len(a.ChannelIds) > 0 {
+					channels = strings.Join(a.ChannelIds, ", ")
+				}
+				t.AppendRow(table.Row{layer.Name, a.Label, a.Start, formatMicros(a.End - a.Start), channels, a.Description})
+			}
+			t.AppendSeparator()
+		}
+		t.Render()
+	},
+}
+
+func init() {
+	annotationsCmd.Flags().Int64Slice("layers", nil,
+		"Comma-separated list of annotation layer ids to list (default all layers)")
+	annotationsCmd.Flags().StringSlice("channels", nil,
+		"Comma-separated list of channel ids; only annotations on these channels are listed (default all channels)")
+	annotationsCmd.Flags().BoolP("relative_time", "r",
+		false, "Use relative time from start of channels in sec.")
+	annotationsCmd.Flags().Bool("refresh", false, "Fetch the latest annotations from Pennsieve")
+}
@@ -0,0 +1,80 @@
+package timeseries
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache [command]",
+	Short: "Manage the local cache of timeseries blocks",
+	Long: `Timeseries blocks are cached on the local machine when they are requested. The cache
+is limited to the size set by 'timeseries_cache_size' (in MB) in the agent section of the
+configuration file; the least recently used blocks are removed when the cache exceeds
+this size. A size of 0 disables the limit. Blocks of packages that are pinned with
+'pennsieve timeseries pin' are never removed.
+`,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Shows the usage of the local timeseries cache per package",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		response, err := client.GetTimeseriesCacheStats(ctx, &api.GetTimeseriesCacheStatsRequest{})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error retrieving cache stats: %v", err))
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Package", "Blocks", "Size", "Last Access", "Pinned"})
+		for _, p := range response.Packages {
+			lastAccess := "-"
+			if p.LastAccess > 0 {
+				lastAccess = time.Unix(p.LastAccess, 0).Format(time.RFC822)
+			}
+			pinned := ""
+			if p.Pinned {
+				pinned = "yes"
+			}
+			t.AppendRow(table.Row{p.PackageId, p.Blocks, shared.FormatBytes(p.Size), lastAccess, pinned})
+		}
+
+		maxSize := "unlimited"
+		if response.MaxSize > 0 {
+			maxSize = shared.FormatBytes(response.MaxSize)
+		}
+		t.AppendFooter(table.Row{"Total", "", shared.FormatBytes(response.TotalSize), "max: " + maxSize, ""})
+		t.Render()
+	},
+}
+
+func init() {
+	cacheCmd.AddCommand(cacheStatsCmd)
+}
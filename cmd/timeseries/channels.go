@@ -79,9 +79,9 @@ func PrettyPrintList(ch []*api.TimeseriesChannel, pkg string) {
 	t := table.NewWriter()
 	t.SetOutputMirror(os.Stdout)
 	t.SetTitle(fmt.Sprintf("Channels - %s", pkg))
-	t.AppendHeader(table.Row{"NAME", "RATE", "UNIT", "NODE ID", "START TIME", "END TIME"})
+	t.AppendHeader(table.Row{"NAME", "GROUP", "RATE", "UNIT", "NODE ID", "START TIME", "END TIME"})
 	for _, d := range ch {
-		t.AppendRow([]interface{}{d.Name, d.Rate, d.Unit, d.Id, d.StartTime, d.EndTime})
+		t.AppendRow([]interface{}{d.Name, d.Group, d.Rate, d.Unit, d.Id, d.StartTime, d.EndTime})
 	}
 
 	t.Render()
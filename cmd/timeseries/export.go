@@ -0,0 +1,133 @@
+package timeseries
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/config"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <package-id> <start-time> <end-time> [<target>]",
+	Short: "Exports a range of a timeseries package to an EDF, CSV, NumPy or Parquet file.",
+	Long: `This function exports the data of a range of a timeseries package to a single file that
+can be opened by analysis tools such as MNE, EEGLAB, pandas or MATLAB. All channels of the
+package are exported, unless a subset is selected with the [--channels] flag.
+
+Supported formats are:
+  edf      16-bit EDF file with the channel labels, units and sampling rates in the header.
+  csv      CSV file with a timestamp column (uUTC) and a column per channel.
+  npy      NumPy structured array with a timestamp field (uUTC) and a field per channel.
+  parquet  Parquet file with a timestamp column and a column per channel.
+
+CSV and NumPy exports include a JSON file with the units and sampling rates of the channels.
+Samples that are missing for a channel at a timestamp are empty, or NaN, in tabular formats.
+
+Users can provide an optional target file-name. If no target is specified, the file name will
+include the package and the time-range of the request.
+
+By default the specified start- and endtimes are defined in uUTC time. By setting the [-r | --relative_time]
+flag, the start- and stoptime are interpreted as relative to the start time of the channels.
+`,
+	Args: cobra.RangeArgs(3, 4),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		packageId := args[0]
+		startTimestamp, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			fmt.Println("Error: Invalid start time:", args[1])
+			return
+		}
+		endTimestamp, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			fmt.Println("Error: Invalid end time:", args[2])
+			return
+		}
+
+		formatFlag, _ := cmd.Flags().GetString("format")
+		format, ok := api.ExportTimeseriesRequest_Format_value[strings.ToUpper(formatFlag)]
+		if !ok {
+			fmt.Printf("Error: Unsupported export format: %s\n", formatFlag)
+			return
+		}
+
+		channelIds, _ := cmd.Flags().GetStringSlice("channels")
+		doRelativeTime, _ := cmd.Flags().GetBool("relative_time")
+
+		target := fmt.Sprintf("%s_%s_%s.%s", packageId[max(len(packageId)-8, 0):],
+			args[1], args[2], strings.ToLower(formatFlag))
+		if len(args) > 3 {
+			target = args[3]
+		}
+
+		target, err = filepath.Abs(target)
+		if err != nil {
+			fmt.Println("Error: Invalid target:", err)
+			return
+		}
+
+		db, _ := config.InitializeDB()
+		userSettingsStore := store.NewUserSettingsStore(db)
+		s, _ := userSettingsStore.Get()
+		if len(s.UseDatasetId) == 0 {
+			fmt.Println("\nError: No dataset specified; use 'pennsieve dataset use <node-id>' to set active dataset.")
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		// Exports can take a long time as blocks that are not cached are downloaded first.
+		response, err := client.ExportTimeseries(context.Background(), &api.ExportTimeseriesRequest{
+			DatasetId:    s.UseDatasetId,
+			PackageId:    packageId,
+			ChannelIds:   channelIds,
+			StartTime:    startTimestamp,
+			EndTime:      endTimestamp,
+			RelativeTime: doRelativeTime,
+			Format:       api.ExportTimeseriesRequest_Format(format),
+			TargetPath:   target,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error exporting timeseries: %v", err))
+			return
+		}
+
+		if response.Status != "Success" {
+			fmt.Println(response.Status)
+			return
+		}
+
+		fmt.Printf("Exported %d samples to:\n", response.Samples)
+		for _, f := range response.Files {
+			fmt.Println("  " + f)
+		}
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringSlice("channels", nil,
+		"Comma-separated list of channel ids to export (default all channels)")
+	exportCmd.Flags().String("format", "edf",
+		"Export format: edf, csv, npy or parquet")
+	exportCmd.Flags().BoolP("relative_time", "r",
+		false, "Use relative time from start of channels in sec.")
+}
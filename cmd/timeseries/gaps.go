@@ -0,0 +1,111 @@
+package timeseries
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/config"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var gapsCmd = &cobra.Command{
+	Use:   "gaps <package-id> <start-time> <end-time>",
+	Short: "Lists the gaps in the recording of a range of a timeseries package.",
+	Long: `This function lists the periods without recorded data between the blocks of each
+channel of a timeseries package. All channels of the package are checked, unless a subset
+is selected with the [--channels] flag. No data is downloaded.
+
+By default the specified start- and endtimes are defined in uUTC time. By setting the [-r | --relative_time]
+flag, the start- and stoptime are interpreted as relative to the start time of the channels.
+`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		packageId := args[0]
+		startTimestamp, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			fmt.Println("Error: Invalid start time:", args[1])
+			return
+		}
+		endTimestamp, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			fmt.Println("Error: Invalid end time:", args[2])
+			return
+		}
+
+		channelIds, _ := cmd.Flags().GetStringSlice("channels")
+		doRelativeTime, _ := cmd.Flags().GetBool("relative_time")
+
+		db, _ := config.InitializeDB()
+		userSettingsStore := store.NewUserSettingsStore(db)
+		s, _ := userSettingsStore.Get()
+		if len(s.UseDatasetId) == 0 {
+			fmt.Println("\nError: No dataset specified; use 'pennsieve dataset use <node-id>' to set active dataset.")
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		response, err := client.GetTimeseriesGaps(ctx, &api.GetTimeseriesGapsRequest{
+			DatasetId:    s.UseDatasetId,
+			PackageId:    packageId,
+			ChannelIds:   channelIds,
+			StartTime:    startTimestamp,
+			EndTime:      endTimestamp,
+			RelativeTime: doRelativeTime,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error retrieving gaps: %v", err))
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"Channel", "Gap Start", "Gap End", "Duration"})
+		for _, ch := range response.Channels {
+			if len(ch.Gaps) == 0 {
+				t.AppendRow(table.Row{ch.Name, "-", "-", "no gaps"})
+				continue
+			}
+			for _, gap := range ch.Gaps {
+				t.AppendRow(table.Row{ch.Name, gap.Start, gap.End, formatMicros(gap.End - gap.Start)})
+			}
+			t.AppendRow(table.Row{"", "", "Total", formatMicros(ch.TotalDuration)})
+			t.AppendSeparator()
+		}
+		t.Render()
+	},
+}
+
+// formatMicros returns a duration in microseconds as a human-readable string.
+func formatMicros(d uint64) string {
+	return (time.Duration(d) * time.Microsecond).String()
+}
+
+func init() {
+	gapsCmd.Flags().StringSlice("channels", nil,
+		"Comma-separated list of channel ids to check (default all channels)")
+	gapsCmd.Flags().BoolP("relative_time", "r",
+		false, "Use relative time from start of channels in sec.")
+}
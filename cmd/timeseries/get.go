@@ -31,6 +31,9 @@ include the channel name and the time-range of the request.
 By default the specified start- and endtimes are defined in uUTC time. By setting the [-r | --relative_time] 
 flag, the start- and stoptime are interpreted as relative to the start time of the channel. 
 
+By setting the [--offline] flag, only data in the local cache is used. The agent also uses the local cache 
+when Pennsieve cannot be reached, and reports when the cached data does not cover the full range.
+
 `,
 	Args: cobra.MinimumNArgs(4),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -53,6 +56,8 @@ flag, the start- and stoptime are interpreted as relative to the start time of t
 			return
 		}
 
+		offline, _ := cmd.Flags().GetBool("offline")
+
 		db, _ := config.InitializeDB()
 		userSettingsStore := store.NewUserSettingsStore(db)
 		s, _ := userSettingsStore.Get()
@@ -77,6 +82,8 @@ flag, the start- and stoptime are interpreted as relative to the start time of t
 			EndTime:      float32(endTimestamp),
 			Refresh:      false,
 			RelativeTime: doRelativeTime,
+			Offline:      offline,
+			Encoding:     api.GetTimeseriesRangeRequest_FLOAT64,
 		})
 		if err != nil {
 			log.Error("Error getting timeseries range: ", err)
@@ -124,7 +131,7 @@ flag, the start- and stoptime are interpreted as relative to the start time of t
 				break
 			case api.GetTimeseriesRangeResponse_RANGE_DATA:
 				d := req.GetData()
-				data := d.GetData()
+				data := d.GetDataFloat64()
 
 				timeStamp := d.Start
 				if doRelativeTime {
@@ -137,12 +144,19 @@ flag, the start- and stoptime are interpreted as relative to the start time of t
 				record := make([]string, 2)
 				for i, value := range data {
 					record[0] = strconv.FormatFloat(float64(timeStamp)+(float64(1000000)/float64(d.Rate))*float64(i), 'f', -1, 64)
-					record[1] = strconv.FormatFloat(float64(value), 'f', -1, 64)
+					record[1] = strconv.FormatFloat(value, 'f', -1, 64)
 					writer.Write(record)
 
 				}
 
 				break
+			case api.GetTimeseriesRangeResponse_RANGE_STATUS:
+				status := req.GetStatus()
+				if status.GetPartial() {
+					fmt.Println("Warning: the local cache does not cover the full range; the file contains partial data.")
+				} else if status.GetOffline() {
+					log.Info("Range was read from the local cache.")
+				}
 			case api.GetTimeseriesRangeResponse_ERROR:
 				err := req.GetError()
 				log.Error(err.GetInfo())
@@ -155,5 +169,7 @@ func init() {
 
 	getCmd.Flags().BoolP("relative_time", "r",
 		false, "Use relative time from start of channel in sec.")
+	getCmd.Flags().Bool("offline",
+		false, "Only use data from the local cache.")
 
 }
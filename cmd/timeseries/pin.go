@@ -0,0 +1,92 @@
+package timeseries
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/config"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var pinCmd = &cobra.Command{
+	Use:   "pin <package-id>",
+	Short: "Downloads all data of a timeseries package for offline use.",
+	Long: `This function downloads all blocks of all channels of a timeseries package into the
+local cache and protects them from being removed when the cache exceeds its maximum size.
+
+Requests for the package are answered from the local cache when Pennsieve cannot be reached,
+or when the data is explicitly requested offline. Use 'pennsieve timeseries unpin' to allow
+the data to be removed from the cache again.
+
+Downloads run in the agent; you can check progress by running the agent subscriber.
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		db, _ := config.InitializeDB()
+		userSettingsStore := store.NewUserSettingsStore(db)
+		s, _ := userSettingsStore.Get()
+		if len(s.UseDatasetId) == 0 {
+			fmt.Println("\nError: No dataset specified; use 'pennsieve dataset use <node-id>' to set active dataset.")
+			return
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		// Pinning can take a long time as all blocks of the package are downloaded.
+		response, err := client.PinTimeseries(context.Background(), &api.PinTimeseriesRequest{
+			DatasetId: s.UseDatasetId,
+			PackageId: args[0],
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error pinning package: %v", err))
+			return
+		}
+
+		fmt.Printf("Pinned %s for offline use: downloaded %d of %d block(s).\n", args[0], response.Downloaded, response.Blocks)
+	},
+}
+
+var unpinCmd = &cobra.Command{
+	Use:   "unpin <package-id>",
+	Short: "Allows the cached data of a pinned timeseries package to be removed.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, err = client.UnpinTimeseries(ctx, &api.PinTimeseriesRequest{PackageId: args[0]})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error unpinning package: %v", err))
+			return
+		}
+
+		fmt.Printf("Unpinned %s.\n", args[0])
+	},
+}
@@ -19,4 +19,10 @@ func init() {
 	TimeseriesCmd.AddCommand(ChannelsCmd)
 	TimeseriesCmd.AddCommand(getCmd)
 	TimeseriesCmd.AddCommand(resetCmd)
+	TimeseriesCmd.AddCommand(cacheCmd)
+	TimeseriesCmd.AddCommand(exportCmd)
+	TimeseriesCmd.AddCommand(gapsCmd)
+	TimeseriesCmd.AddCommand(pinCmd)
+	TimeseriesCmd.AddCommand(unpinCmd)
+	TimeseriesCmd.AddCommand(annotationsCmd)
 }
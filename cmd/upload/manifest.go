@@ -61,6 +61,13 @@ var ManifestCmd = &cobra.Command{
 		if err != nil {
 			log.Println("Workflow error: ", err)
 		}
+		if workflowPath != "" {
+			workflowPath, err = shared.WorkflowArgument(workflowPath)
+			if err != nil {
+				log.Println("Workflow error: ", err)
+				return
+			}
+		}
 
 		onConflict, _ := cmd.Flags().GetString("on-conflict")
 		switch onConflict {
@@ -70,19 +77,45 @@ var ManifestCmd = &cobra.Command{
 			log.Printf("Error: --on-conflict must be one of: keepBoth, replace (got %q)", onConflict)
 			return
 		}
+		if validate, _ := cmd.Flags().GetString("validate"); validate != "" {
+			response := shared.ValidateManifest(client, manifestId, validate)
+			if response == nil {
+				return
+			}
+			if !response.Valid {
+				if force, _ := cmd.Flags().GetBool("force"); !force {
+					log.Println("Validation failed. Stopping upload. Fix the errors, or use --force to upload anyway.")
+					return
+				}
+				log.Println("Uploading despite validation errors (--force).")
+			}
+		}
+
+		uploadDerivatives, _ := cmd.Flags().GetBool("upload-derivatives")
+		derivativesTarget, _ := cmd.Flags().GetString("derivatives-target")
+		executor, _ := cmd.Flags().GetString("executor")
+		image, _ := cmd.Flags().GetString("image")
 		WrkFlwReq := api.StartWorkflowRequest{
-			ManifestId:   manifestId,
-			WorkflowFlag: workflowPath,
+			ManifestId:            manifestId,
+			WorkflowFlag:          workflowPath,
+			UploadDerivatives:     uploadDerivatives,
+			DerivativesTargetPath: derivativesTarget,
+			Executor:              executor,
+			Image:                 image,
 		}
 		if workflowPath != "" {
 			log.Println("STARTING WORKFLOW")
 			workflowResponse, err := client.StartWorkflow(context.Background(), &WrkFlwReq)
-			log.Println("Workflow Response")
-			log.Println(workflowResponse)
+			if err != nil || workflowResponse.Success == false {
+				log.Println("Workflow failed. Stopping upload", err)
+				return
+			}
 
 			// Stop upload if workflow fails
-			if workflowResponse.Success == false || err != nil {
-				log.Println("Workflow failed. Stopping upload", err)
+			log.Printf("Waiting for workflow run %s to finish.\n", workflowResponse.RunId)
+			if run := waitForWorkflow(client, workflowResponse.RunId); run == nil || run.Status != api.WorkflowRun_SUCCEEDED {
+				log.Println("Workflow failed. Stopping upload")
+				log.Printf("Use \"pennsieve workflow status %s\" to see the log of the workflow.\n", workflowResponse.RunId)
 				return
 			}
 		}
@@ -115,3 +148,20 @@ var ManifestCmd = &cobra.Command{
 		})
 	},
 }
+
+// waitForWorkflow polls the status of a workflow run until it has finished.
+func waitForWorkflow(client api.AgentClient, runId string) *api.WorkflowRun {
+	for {
+		response, err := client.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: runId})
+		if err != nil {
+			log.Println("Unable to get status of workflow run: ", err)
+			return nil
+		}
+
+		switch response.Run.Status {
+		case api.WorkflowRun_SUCCEEDED, api.WorkflowRun_FAILED, api.WorkflowRun_CANCELLED:
+			return response.Run
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
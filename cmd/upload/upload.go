@@ -36,6 +36,12 @@ func init() {
 	UploadCmd.AddCommand(ManifestCmd)
 	ManifestCmd.Flags().String("workflow", "", "Add a workflow to the upload process")
 	ManifestCmd.Flags().String("workflowOpts", "", "Pass in workflow options")
+	ManifestCmd.Flags().String("executor", "", "Executor that runs the workflow: local (requires agent.workflow_allow_local), nextflow, podman or apptainer (default from agent.workflow_executor)")
+	ManifestCmd.Flags().String("image", "", "Container image of the podman and apptainer executors (default from agent.workflow_image)")
+	ManifestCmd.Flags().Bool("upload-derivatives", false, "Upload the derivatives of the workflow to the dataset of the manifest")
+	ManifestCmd.Flags().String("derivatives-target", "", "Target path of the derivatives; {workflow} and {run} are replaced (default \"derivatives/{workflow}/{run}\")")
+	ManifestCmd.Flags().String("validate", "", "Validate the layout of the manifest against a data standard before uploading: bids")
+	ManifestCmd.Flags().Bool("force", false, "Upload even if the validation finds errors")
 	// on-conflict controls server-side name-collision resolution during
 	// finalize. Values: keepBoth (default) | replace. Empty == keepBoth.
 	ManifestCmd.Flags().String("on-conflict", "", "How to resolve name collisions with existing packages: keepBoth (default) or replace")
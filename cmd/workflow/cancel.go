@@ -0,0 +1,39 @@
+package workflow
+
+import (
+	"fmt"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var cancelCmd = &cobra.Command{
+	Use:   "cancel <run-id>",
+	Short: "Cancels an active workflow run.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.CancelWorkflow(context.Background(), &api.CancelWorkflowRequest{RunId: args[0]})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error cancelling workflow run: %v", err))
+			return
+		}
+
+		fmt.Println(response.Status)
+	},
+}
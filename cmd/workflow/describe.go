@@ -0,0 +1,63 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var describeCmd = &cobra.Command{
+	Use:   "describe <name>",
+	Short: "Shows a named workflow and its parameters.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.DescribeWorkflow(context.Background(), &api.DescribeWorkflowRequest{Name: args[0]})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error getting workflow: %v", err))
+			return
+		}
+
+		w := response.Workflow
+		fmt.Printf("Name:        %s\n", w.Name)
+		fmt.Printf("Version:     %s\n", w.Version)
+		fmt.Printf("Description: %s\n", w.Description)
+		fmt.Printf("Entrypoint:  %s\n", w.Entrypoint)
+		fmt.Printf("Executor:    %s\n", w.Executor)
+		fmt.Printf("Image:       %s\n", w.Image)
+		fmt.Printf("Folder:      %s\n", w.Folder)
+
+		if len(w.Parameters) == 0 {
+			fmt.Println("\nThis workflow has no parameters.")
+			return
+		}
+
+		fmt.Println()
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"PARAMETER", "TYPE", "REQUIRED", "DEFAULT", "VALUES", "DESCRIPTION"})
+		for _, p := range w.Parameters {
+			t.AppendRow([]interface{}{p.Name, p.Type, p.Required, p.Default, strings.Join(p.Enum, ", "), p.Description})
+		}
+		t.Render()
+	},
+}
@@ -0,0 +1,56 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the named workflows in the workflow registry.",
+	Long: `Lists the named workflows in the workflow registry.
+
+  Each folder in the registry (~/.pennsieve/workflows by default) that contains
+  a workflow.json manifest is a named workflow.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.ListRegisteredWorkflows(context.Background(), &api.ListRegisteredWorkflowsRequest{})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error listing workflows: %v", err))
+			return
+		}
+
+		if len(response.Workflows) == 0 {
+			fmt.Printf("No workflows found in %s.\n", response.Folder)
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"NAME", "VERSION", "EXECUTOR", "PARAMETERS", "DESCRIPTION"})
+		for _, w := range response.Workflows {
+			t.AppendRow([]interface{}{w.Name, w.Version, w.Executor, len(w.Parameters), w.Description})
+		}
+		t.Render()
+	},
+}
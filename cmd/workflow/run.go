@@ -0,0 +1,90 @@
+package workflow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run <name> <manifest-id> [--param key=value ...]",
+	Short: "Runs a named workflow on the files of a manifest.",
+	Long: `Runs a named workflow from the workflow registry on the files of an upload manifest.
+
+  Parameters are validated against the schema of the workflow before the run starts.
+  Use "pennsieve workflow describe <name>" to see the parameters of a workflow.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		manifestId, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			fmt.Println("Error: <manifest-id> should be an integer.")
+			return
+		}
+
+		paramFlags, _ := cmd.Flags().GetStringArray("param")
+		params := map[string]string{}
+		for _, p := range paramFlags {
+			key, value, ok := strings.Cut(p, "=")
+			if !ok || key == "" {
+				fmt.Printf("Error: parameter %q should be formatted as key=value.\n", p)
+				return
+			}
+			params[key] = value
+		}
+
+		workflow, err := shared.WorkflowArgument(args[0])
+		if err != nil {
+			fmt.Printf("Error: invalid workflow %q: %v\n", args[0], err)
+			return
+		}
+
+		executor, _ := cmd.Flags().GetString("executor")
+		image, _ := cmd.Flags().GetString("image")
+		uploadDerivatives, _ := cmd.Flags().GetBool("upload-derivatives")
+		derivativesTarget, _ := cmd.Flags().GetString("derivatives-target")
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.StartWorkflow(context.Background(), &api.StartWorkflowRequest{
+			ManifestId:            int32(manifestId),
+			WorkflowFlag:          workflow,
+			Params:                params,
+			Executor:              executor,
+			Image:                 image,
+			UploadDerivatives:     uploadDerivatives,
+			DerivativesTargetPath: derivativesTarget,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error starting workflow: %v", err))
+			return
+		}
+
+		fmt.Printf("Started workflow run %s.\n", response.RunId)
+		fmt.Printf("Use \"pennsieve workflow status %s\" to follow the run.\n", response.RunId)
+	},
+}
+
+func init() {
+	runCmd.Flags().StringArrayP("param", "p", nil, "Parameter of the workflow as key=value; can be repeated")
+	runCmd.Flags().String("executor", "", "Executor that runs the workflow (default from the workflow or agent.workflow_executor)")
+	runCmd.Flags().String("image", "", "Container image of the podman and apptainer executors (default from the workflow)")
+	runCmd.Flags().Bool("upload-derivatives", false, "Upload the derivatives of the workflow to the dataset of the manifest")
+	runCmd.Flags().String("derivatives-target", "", "Target path of the derivatives; {workflow} and {run} are replaced (default \"derivatives/{workflow}/{run}\")")
+}
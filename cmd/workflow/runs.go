@@ -0,0 +1,65 @@
+package workflow
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var runsCmd = &cobra.Command{
+	Use:   "runs [manifest-id]",
+	Short: "Lists workflow runs.",
+	Long:  `Lists the workflow runs for an upload manifest, or all runs if no manifest is provided.`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		var manifestId int32
+		if len(args) > 0 {
+			i, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				fmt.Println("Error: <manifest-id> should be an integer.")
+				return
+			}
+			manifestId = int32(i)
+		}
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.ListWorkflows(context.Background(), &api.ListWorkflowsRequest{ManifestId: manifestId})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error listing workflow runs: %v", err))
+			return
+		}
+
+		if len(response.Runs) == 0 {
+			fmt.Println("No workflow runs found.")
+			return
+		}
+
+		t := table.NewWriter()
+		t.SetOutputMirror(os.Stdout)
+		t.AppendHeader(table.Row{"RUN ID", "MANIFEST", "WORKFLOW", "STATUS", "CREATED", "FINISHED"})
+		for _, run := range response.Runs {
+			t.AppendRow([]interface{}{run.Id, run.ManifestId, run.Workflow, formatStatus(run),
+				formatTime(run.CreatedAt), formatTime(run.FinishedAt)})
+		}
+		t.Render()
+	},
+}
@@ -0,0 +1,70 @@
+package workflow
+
+import (
+	"fmt"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/cmd/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status <run-id>",
+	Short: "Shows the status and log of a workflow run.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		logLines, _ := cmd.Flags().GetInt32("log")
+
+		port := viper.GetString("agent.port")
+		conn, err := grpc.NewClient(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Println("Error connecting to GRPC Server: ", err)
+			return
+		}
+		defer conn.Close()
+
+		client := api.NewAgentClient(conn)
+		response, err := client.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{
+			RunId:    args[0],
+			LogLines: logLines,
+		})
+		if err != nil {
+			shared.HandleAgentError(err, fmt.Sprintf("Error getting workflow run: %v", err))
+			return
+		}
+
+		run := response.Run
+		fmt.Printf("Run:       %s\n", run.Id)
+		fmt.Printf("Manifest:  %d\n", run.ManifestId)
+		fmt.Printf("Workflow:  %s\n", run.Workflow)
+		fmt.Printf("Status:    %s\n", formatStatus(run))
+		if run.Error != "" {
+			fmt.Printf("Error:     %s\n", run.Error)
+		}
+		fmt.Printf("Created:   %s\n", formatTime(run.CreatedAt))
+		fmt.Printf("Started:   %s\n", formatTime(run.StartedAt))
+		fmt.Printf("Finished:  %s\n", formatTime(run.FinishedAt))
+		fmt.Printf("Folder:    %s\n", run.JobDir)
+		fmt.Printf("Log:       %s\n", run.LogPath)
+		if run.DerivativesManifestId > 0 {
+			fmt.Printf("Derivatives: manifest %d\n", run.DerivativesManifestId)
+		}
+
+		if len(response.Log) > 0 {
+			fmt.Println()
+			for _, line := range response.Log {
+				fmt.Println(line)
+			}
+		}
+	},
+}
+
+func init() {
+	statusCmd.Flags().Int32("log", 20, "Number of lines at the end of the log to show")
+}
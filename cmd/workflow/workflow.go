@@ -0,0 +1,46 @@
+package workflow
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/spf13/cobra"
+)
+
+var WorkflowCmd = &cobra.Command{
+	Use:   "workflow [command] [...Args]",
+	Short: "Run and track local workflows",
+	Long: `
+  Workflows run in the agent on the files of an upload manifest. Runs continue
+  in the background; you can follow their output by running the agent subscriber.
+
+  Named workflows are shared in the workflow registry (~/.pennsieve/workflows by
+  default). Each workflow has a folder with a workflow.json manifest that lists its
+  entrypoint, container image and a JSON schema of its parameters.`,
+}
+
+func init() {
+	WorkflowCmd.AddCommand(listCmd)
+	WorkflowCmd.AddCommand(describeCmd)
+	WorkflowCmd.AddCommand(runCmd)
+	WorkflowCmd.AddCommand(runsCmd)
+	WorkflowCmd.AddCommand(statusCmd)
+	WorkflowCmd.AddCommand(cancelCmd)
+}
+
+// formatTime formats a unix time for display; 0 is shown as empty.
+func formatTime(t int64) string {
+	if t == 0 {
+		return ""
+	}
+	return time.Unix(t, 0).Format(time.DateTime)
+}
+
+// formatStatus formats the status of a run for display.
+func formatStatus(run *api.WorkflowRun) string {
+	if run.Status == api.WorkflowRun_FAILED && run.ExitCode != 0 {
+		return fmt.Sprintf("%s (exit code %d)", run.Status, run.ExitCode)
+	}
+	return run.Status.String()
+}
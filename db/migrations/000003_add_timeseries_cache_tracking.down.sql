@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS ts_range_last_access_idx;
+
+ALTER TABLE ts_range DROP COLUMN last_access;
+
+ALTER TABLE ts_range DROP COLUMN size;
@@ -0,0 +1,5 @@
+ALTER TABLE ts_range ADD COLUMN size INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE ts_range ADD COLUMN last_access INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS ts_range_last_access_idx ON ts_range(last_access);
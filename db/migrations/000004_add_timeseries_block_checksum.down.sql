@@ -0,0 +1 @@
+ALTER TABLE ts_range DROP COLUMN checksum;
@@ -0,0 +1 @@
+ALTER TABLE ts_range ADD COLUMN checksum INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS ts_pinned_package (
+    package_id VARCHAR(255) PRIMARY KEY NOT NULL,
+    pinned_at INTEGER NOT NULL
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS ts_annotation_time_idx;
+DROP TABLE IF EXISTS ts_annotation;
+DROP TABLE IF EXISTS ts_annotation_layer;
+DROP TABLE IF EXISTS ts_annotation_package;
+
+ALTER TABLE ts_channel DROP COLUMN properties;
+ALTER TABLE ts_channel DROP COLUMN channel_type;
+ALTER TABLE ts_channel DROP COLUMN channel_group;
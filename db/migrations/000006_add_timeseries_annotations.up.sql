@@ -0,0 +1,34 @@
+ALTER TABLE ts_channel ADD COLUMN channel_group VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE ts_channel ADD COLUMN channel_type VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE ts_channel ADD COLUMN properties TEXT NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS ts_annotation_package (
+    package_id VARCHAR(255) PRIMARY KEY NOT NULL,
+    fetched_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS ts_annotation_layer (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    layer_id INTEGER UNIQUE NOT NULL,
+    package_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    color VARCHAR(255) NOT NULL,
+    FOREIGN KEY(package_id) REFERENCES ts_annotation_package(package_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS ts_annotation (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    annotation_id INTEGER UNIQUE NOT NULL,
+    layer_id INTEGER NOT NULL,
+    package_id VARCHAR(255) NOT NULL,
+    channel_ids TEXT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    start_time INTEGER NOT NULL,
+    end_time INTEGER NOT NULL,
+    FOREIGN KEY(layer_id) REFERENCES ts_annotation_layer(layer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS ts_annotation_time_idx ON ts_annotation(package_id, start_time);
@@ -0,0 +1,2 @@
+ALTER TABLE ts_range DROP COLUMN scale;
+ALTER TABLE ts_range DROP COLUMN encoding;
@@ -0,0 +1,2 @@
+ALTER TABLE ts_range ADD COLUMN encoding VARCHAR(255) NOT NULL DEFAULT 'float64';
+ALTER TABLE ts_range ADD COLUMN scale REAL NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_workflow_runs_manifest;
+DROP TABLE IF EXISTS workflow_runs;
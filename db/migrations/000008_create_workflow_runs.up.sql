@@ -0,0 +1,21 @@
+-- Workflow_runs tracks workflows that are run locally on the files of a manifest.
+-- Id: unique id of the run, also used as the name of the job folder
+-- Workflow: path or name of the workflow
+-- Status: pending, running, succeeded, failed or cancelled
+-- Job_dir: folder containing the input, configuration and output of the run
+-- Log_path: file containing the stdout and stderr of the run
+CREATE TABLE IF NOT EXISTS workflow_runs (
+    id VARCHAR(255) PRIMARY KEY NOT NULL,
+    manifest_id INTEGER NOT NULL,
+    workflow TEXT NOT NULL,
+    status VARCHAR(255) NOT NULL,
+    job_dir TEXT NOT NULL,
+    log_path TEXT NOT NULL,
+    exit_code INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    started_at INTEGER NOT NULL DEFAULT 0,
+    finished_at INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_workflow_runs_manifest ON workflow_runs (manifest_id, created_at);
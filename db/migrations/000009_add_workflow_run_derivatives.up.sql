@@ -0,0 +1,2 @@
+-- Derivatives_manifest_id: manifest through which the derivatives of the run are uploaded; 0 if none
+ALTER TABLE workflow_runs ADD COLUMN derivatives_manifest_id INTEGER NOT NULL DEFAULT 0;
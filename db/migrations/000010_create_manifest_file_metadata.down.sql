@@ -0,0 +1 @@
+DROP TABLE IF EXISTS manifest_file_metadata;
@@ -0,0 +1,15 @@
+-- Manifest_file_metadata contains key/value metadata of files in a manifest, which is sent to Pennsieve
+-- as the properties of the package of the file when the manifest is synchronized.
+-- Manifest_file_id: file that the metadata belongs to
+-- Key: name of the property, such as subject_id or session
+-- Value: value of the property
+CREATE TABLE IF NOT EXISTS manifest_file_metadata (
+    manifest_file_id INTEGER NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (manifest_file_id, key),
+    CONSTRAINT fk_manifest_file_id
+        FOREIGN KEY (manifest_file_id)
+            REFERENCES manifest_files(id)
+            ON DELETE CASCADE
+);
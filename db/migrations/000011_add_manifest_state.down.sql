@@ -0,0 +1 @@
+ALTER TABLE manifests DROP COLUMN state;
@@ -0,0 +1,16 @@
+-- State: lifecycle state of the manifest, derived from the status of its files and the sync and upload
+-- sessions of the agent. The status column holds the status that is sent to the server.
+ALTER TABLE manifests ADD COLUMN state VARCHAR(255) NOT NULL DEFAULT 'indexed';
+
+-- Derive the state of existing manifests from the status of their files.
+UPDATE manifests SET state = CASE
+    WHEN NOT EXISTS (SELECT 1 FROM manifest_files f WHERE f.manifest_id = manifests.id AND f.status != 'Removed')
+        THEN 'indexed'
+    WHEN NOT EXISTS (SELECT 1 FROM manifest_files f WHERE f.manifest_id = manifests.id AND f.status NOT IN ('Removed', 'Verified'))
+        THEN 'verified'
+    WHEN EXISTS (SELECT 1 FROM manifest_files f WHERE f.manifest_id = manifests.id AND f.status IN ('Uploaded', 'Imported', 'Finalized'))
+        THEN 'finalizing'
+    WHEN EXISTS (SELECT 1 FROM manifest_files f WHERE f.manifest_id = manifests.id AND f.status IN ('Failed', 'FailedOrphan'))
+        THEN 'partially_failed'
+    ELSE 'indexed'
+END;
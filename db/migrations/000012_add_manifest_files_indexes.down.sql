@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_manifest_files_status;
+DROP INDEX IF EXISTS idx_manifest_files_source_path;
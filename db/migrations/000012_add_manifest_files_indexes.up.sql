@@ -0,0 +1,3 @@
+-- Indexes for listing, filtering and counting the files in a manifest by status or by source path.
+CREATE INDEX IF NOT EXISTS idx_manifest_files_status ON manifest_files (manifest_id, status);
+CREATE INDEX IF NOT EXISTS idx_manifest_files_source_path ON manifest_files (manifest_id, source_path);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_manifest_files_dataset_path;
+ALTER TABLE manifest_files DROP COLUMN dataset_path;
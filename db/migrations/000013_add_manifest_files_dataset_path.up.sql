@@ -0,0 +1,7 @@
+-- Dataset_Path: path of the file in the dataset, including its name and without a leading slash. It is generated
+-- from the target path and name, and indexed to filter and sort the files in a manifest by their dataset path.
+ALTER TABLE manifest_files ADD COLUMN dataset_path TEXT GENERATED ALWAYS AS (
+    ltrim(CASE WHEN ifnull(target_path, '') = '' THEN target_name
+        ELSE rtrim(target_path, '/') || '/' || target_name END, '/')
+) VIRTUAL;
+CREATE INDEX IF NOT EXISTS idx_manifest_files_dataset_path ON manifest_files (manifest_id, dataset_path);
@@ -0,0 +1,314 @@
+// Package bids checks the layout of files against the core rules of the Brain Imaging Data Structure (BIDS).
+//
+// Only the paths of the files are checked, not their content. The root of the BIDS dataset is the folder
+// with the dataset_description.json file that is closest to the root of the dataset on Pennsieve.
+package bids
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Severity is the severity of an issue.
+type Severity int
+
+const (
+	Error Severity = iota
+	Warning
+)
+
+func (s Severity) String() string {
+	if s == Warning {
+		return "warning"
+	}
+	return "error"
+}
+
+// Codes of the issues.
+const (
+	MissingDatasetDescription = "MISSING_DATASET_DESCRIPTION"
+	MissingReadme             = "MISSING_README"
+	MissingParticipants       = "MISSING_PARTICIPANTS"
+	UnknownRootFile           = "UNKNOWN_ROOT_FILE"
+	InvalidSubjectName        = "INVALID_SUBJECT_NAME"
+	InvalidSessionName        = "INVALID_SESSION_NAME"
+	UnknownDatatype           = "UNKNOWN_DATATYPE"
+	FileOutsideDatatype       = "FILE_OUTSIDE_DATATYPE"
+	InvalidFilename           = "INVALID_FILENAME"
+	EntityMismatch            = "ENTITY_MISMATCH"
+	MissingTask               = "MISSING_TASK"
+	MissingSidecar            = "MISSING_SIDECAR"
+)
+
+// DatasetDescription is the name of the file that marks the root of a BIDS dataset.
+const DatasetDescription = "dataset_description.json"
+
+// File is a file in the dataset. Path is the path of the file in the dataset, including its name.
+type File struct {
+	Path       string
+	SourcePath string
+}
+
+// Issue is a problem with the layout of a file, or with the dataset if Path is empty.
+type Issue struct {
+	Severity   Severity
+	Code       string
+	Message    string
+	Path       string
+	SourcePath string
+}
+
+var (
+	labelPattern    = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	subjectPattern  = regexp.MustCompile(`^sub-[a-zA-Z0-9]+$`)
+	sessionPattern  = regexp.MustCompile(`^ses-[a-zA-Z0-9]+$`)
+	entityPattern   = regexp.MustCompile(`^([a-zA-Z0-9]+)-([a-zA-Z0-9]+)$`)
+	rootFilePattern = regexp.MustCompile(`^(README(\.md|\.rst|\.txt)?|CHANGES|LICENSE|CITATION\.cff|\.bidsignore|` +
+		`dataset_description\.json|genetic_info\.json|(participants|samples)\.(tsv|json)|` +
+		`(task|acq|ce|rec|dir|run|echo|part|space|trc|recording|tracer|sample|stain|chunk)-[a-zA-Z0-9]+(_[a-zA-Z0-9-]+)*\.json)$`)
+)
+
+// rootFolders are the folders other than subject folders that are allowed in the root of a dataset. Their
+// content is not validated.
+var rootFolders = []string{"derivatives", "sourcedata", "code", "stimuli", "phenotype"}
+
+// datatypes are the folders that contain the data files of a subject or session.
+var datatypes = []string{"anat", "func", "dwi", "fmap", "perf", "eeg", "ieeg", "meg", "beh", "pet", "micr", "nirs", "motion", "mrs"}
+
+// sidecarRequired are the datatypes whose data files require a JSON sidecar. The sidecar is recommended
+// for the data files of other datatypes.
+var sidecarRequired = []string{"func", "eeg", "ieeg", "meg", "pet", "nirs", "motion"}
+
+// taskRequired are the datatypes whose data files require a task entity.
+var taskRequired = []string{"func", "eeg", "ieeg", "meg", "nirs", "motion"}
+
+// dataExtensions are the extensions of data files, which are described by a JSON sidecar.
+var dataExtensions = []string{".nii", ".nii.gz", ".edf", ".bdf", ".vhdr", ".set", ".fif", ".snirf", ".nwb", ".mefd", ".con", ".sqd"}
+
+// filename is a BIDS filename: key-value entities, a suffix and an extension.
+type filename struct {
+	entities  map[string]string
+	keys      []string
+	suffix    string
+	extension string
+}
+
+// parseFilename splits a filename into its entities, suffix and extension.
+func parseFilename(name string) (*filename, error) {
+	stem, extension := name, ""
+	if i := strings.Index(name, "."); i >= 0 {
+		stem, extension = name[:i], name[i:]
+	}
+
+	parts := strings.Split(stem, "_")
+	f := &filename{entities: map[string]string{}, suffix: parts[len(parts)-1], extension: extension}
+	if !labelPattern.MatchString(f.suffix) {
+		return nil, fmt.Errorf("%q is not a valid suffix", f.suffix)
+	}
+	for _, part := range parts[:len(parts)-1] {
+		match := entityPattern.FindStringSubmatch(part)
+		if match == nil {
+			return nil, fmt.Errorf("%q is not a valid key-value entity", part)
+		}
+		if _, ok := f.entities[match[1]]; ok {
+			return nil, fmt.Errorf("entity %q is repeated", match[1])
+		}
+		f.entities[match[1]] = match[2]
+		f.keys = append(f.keys, match[1])
+	}
+	return f, nil
+}
+
+// Validate checks the paths of the files against the core BIDS rules, and returns the issues sorted by path.
+func Validate(files []File) []Issue {
+	root, ok := datasetRoot(files)
+
+	var issues []Issue
+	if !ok {
+		issues = append(issues, Issue{Severity: Error, Code: MissingDatasetDescription,
+			Message: DatasetDescription + " is missing in the root of the dataset"})
+	}
+
+	paths := map[string]bool{}
+	for _, file := range files {
+		paths[file.Path] = true
+	}
+	if !slices.ContainsFunc(files, func(f File) bool {
+		return dirOf(f.Path) == root && strings.HasPrefix(path.Base(f.Path), "README")
+	}) {
+		issues = append(issues, Issue{Severity: Warning, Code: MissingReadme, Message: "a README file is recommended in the root of the dataset"})
+	}
+	if !paths[path.Join(root, "participants.tsv")] {
+		issues = append(issues, Issue{Severity: Warning, Code: MissingParticipants, Message: "participants.tsv is recommended in the root of the dataset"})
+	}
+
+	sidecars := sidecarsByFolder(files)
+	for _, file := range files {
+		rel, ok := relativePath(file.Path, root)
+		if !ok {
+			continue
+		}
+		for _, issue := range validateFile(rel, root, sidecars) {
+			issue.Path = file.Path
+			issue.SourcePath = file.SourcePath
+			issues = append(issues, issue)
+		}
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool { return issues[i].Path < issues[j].Path })
+	return issues
+}
+
+// validateFile returns the issues of a file with a path relative to the root of the BIDS dataset.
+func validateFile(rel string, root string, sidecars map[string][]*filename) []Issue {
+	parts := strings.Split(rel, "/")
+	name := parts[len(parts)-1]
+
+	if len(parts) == 1 {
+		if !rootFilePattern.MatchString(name) {
+			return []Issue{{Severity: Warning, Code: UnknownRootFile, Message: fmt.Sprintf("%s is not a BIDS file in the root of the dataset", name)}}
+		}
+		return nil
+	}
+
+	if slices.Contains(rootFolders, parts[0]) {
+		return nil
+	}
+	if !subjectPattern.MatchString(parts[0]) {
+		if strings.HasPrefix(parts[0], "sub") {
+			return []Issue{{Severity: Error, Code: InvalidSubjectName,
+				Message: fmt.Sprintf("subject folder %q must be named sub-<label> with an alphanumeric label", parts[0])}}
+		}
+		return []Issue{{Severity: Warning, Code: UnknownRootFile, Message: fmt.Sprintf("folder %q is not a BIDS folder", parts[0])}}
+	}
+	subject := strings.TrimPrefix(parts[0], "sub-")
+
+	folders := parts[1 : len(parts)-1]
+	session := ""
+	if len(folders) > 0 && strings.HasPrefix(folders[0], "ses") {
+		if !sessionPattern.MatchString(folders[0]) {
+			return []Issue{{Severity: Error, Code: InvalidSessionName,
+				Message: fmt.Sprintf("session folder %q must be named ses-<label> with an alphanumeric label", folders[0])}}
+		}
+		session = strings.TrimPrefix(folders[0], "ses-")
+		folders = folders[1:]
+	}
+
+	f, err := parseFilename(name)
+	if err != nil {
+		return []Issue{{Severity: Error, Code: InvalidFilename, Message: fmt.Sprintf("invalid filename %s: %v", name, err)}}
+	}
+
+	var issues []Issue
+	if f.entities["sub"] != subject || (len(f.keys) > 0 && f.keys[0] != "sub") {
+		issues = append(issues, Issue{Severity: Error, Code: EntityMismatch,
+			Message: fmt.Sprintf("filename must start with sub-%s to match its folder", subject)})
+	}
+	if f.entities["ses"] != session {
+		issues = append(issues, Issue{Severity: Error, Code: EntityMismatch,
+			Message: fmt.Sprintf("ses entity %q does not match the session folder %q", f.entities["ses"], session)})
+	}
+
+	if len(folders) == 0 {
+		if f.suffix != "sessions" && f.suffix != "scans" {
+			issues = append(issues, Issue{Severity: Error, Code: FileOutsideDatatype,
+				Message: "data files must be in a datatype folder such as anat or func"})
+		}
+		return issues
+	}
+	datatype := folders[0]
+	if !slices.Contains(datatypes, datatype) || len(folders) > 1 && datatype != "micr" {
+		issues = append(issues, Issue{Severity: Error, Code: UnknownDatatype,
+			Message: fmt.Sprintf("%q is not a BIDS datatype folder; use one of %s", path.Join(folders...), strings.Join(datatypes, ", "))})
+		return issues
+	}
+
+	if !slices.Contains(dataExtensions, f.extension) {
+		return issues
+	}
+	if slices.Contains(taskRequired, datatype) && f.entities["task"] == "" {
+		issues = append(issues, Issue{Severity: Error, Code: MissingTask, Message: fmt.Sprintf("%s files require a task entity", datatype)})
+	}
+	if !hasSidecar(path.Join(root, dirOf(rel)), root, f, sidecars) {
+		severity, requirement := Warning, "is recommended"
+		if slices.Contains(sidecarRequired, datatype) {
+			severity, requirement = Error, "is required"
+		}
+		issues = append(issues, Issue{Severity: severity, Code: MissingSidecar,
+			Message: fmt.Sprintf("a JSON sidecar with suffix %s %s", f.suffix, requirement)})
+	}
+	return issues
+}
+
+// hasSidecar returns true if there is a JSON sidecar for the file in its folder or in one of the folders above
+// it up to the root. Following the inheritance principle, the sidecar has the suffix of the file and a subset
+// of its entities.
+func hasSidecar(folder string, root string, f *filename, sidecars map[string][]*filename) bool {
+	for dir := folder; ; dir = dirOf(dir) {
+		for _, sidecar := range sidecars[dir] {
+			if sidecar.suffix != f.suffix {
+				continue
+			}
+			subset := true
+			for key, value := range sidecar.entities {
+				subset = subset && f.entities[key] == value
+			}
+			if subset {
+				return true
+			}
+		}
+		if dir == root || dir == "" {
+			return false
+		}
+	}
+}
+
+// sidecarsByFolder returns the parsed names of the JSON files in each folder.
+func sidecarsByFolder(files []File) map[string][]*filename {
+	sidecars := map[string][]*filename{}
+	for _, file := range files {
+		if path.Ext(file.Path) != ".json" {
+			continue
+		}
+		if sidecar, err := parseFilename(path.Base(file.Path)); err == nil {
+			dir := dirOf(file.Path)
+			sidecars[dir] = append(sidecars[dir], sidecar)
+		}
+	}
+	return sidecars
+}
+
+// datasetRoot returns the folder of the dataset_description.json file that is closest to the root.
+func datasetRoot(files []File) (string, bool) {
+	root, found := "", false
+	for _, file := range files {
+		if path.Base(file.Path) != DatasetDescription {
+			continue
+		}
+		dir := dirOf(file.Path)
+		if !found || strings.Count(dir, "/") < strings.Count(root, "/") || dir == "" {
+			root, found = dir, true
+		}
+	}
+	return root, found
+}
+
+// relativePath returns the path relative to the root, if the path is inside the root.
+func relativePath(p string, root string) (string, bool) {
+	if root == "" {
+		return p, true
+	}
+	return strings.CutPrefix(p, root+"/")
+}
+
+// dirOf returns the folder of the path, or an empty string for the root.
+func dirOf(p string) string {
+	if dir := path.Dir(p); dir != "." && dir != "/" {
+		return dir
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package bids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func files(paths ...string) []File {
+	result := make([]File, 0, len(paths))
+	for _, p := range paths {
+		result = append(result, File{Path: p, SourcePath: "/data/" + p})
+	}
+	return result
+}
+
+func codes(issues []Issue) map[string][]string {
+	result := map[string][]string{}
+	for _, issue := range issues {
+		result[issue.Code] = append(result[issue.Code], issue.Path)
+	}
+	return result
+}
+
+func TestValidateValidDataset(t *testing.T) {
+	issues := Validate(files(
+		"dataset_description.json",
+		"README.md",
+		"participants.tsv",
+		"task-rest_bold.json",
+		"sub-01/anat/sub-01_T1w.nii.gz",
+		"sub-01/anat/sub-01_T1w.json",
+		"sub-01/func/sub-01_task-rest_bold.nii.gz",
+		"sub-01/func/sub-01_task-rest_events.tsv",
+		"sub-02/ses-1/eeg/sub-02_ses-1_task-sleep_eeg.edf",
+		"sub-02/ses-1/eeg/sub-02_ses-1_task-sleep_eeg.json",
+		"sub-02/sub-02_sessions.tsv",
+		"derivatives/fmriprep/anything.txt",
+	))
+	assert.Empty(t, issues)
+}
+
+func TestValidateDatasetIssues(t *testing.T) {
+	issues := Validate(files("sub-01/anat/sub-01_T1w.nii.gz"))
+
+	found := codes(issues)
+	assert.Equal(t, []string{""}, found[MissingDatasetDescription])
+	assert.Equal(t, []string{""}, found[MissingReadme])
+	assert.Equal(t, []string{""}, found[MissingParticipants])
+	assert.Equal(t, []string{"sub-01/anat/sub-01_T1w.nii.gz"}, found[MissingSidecar])
+	assert.Equal(t, Warning, issues[len(issues)-1].Severity, "Expect a missing anat sidecar to be a warning.")
+	assert.Equal(t, "/data/sub-01/anat/sub-01_T1w.nii.gz", issues[len(issues)-1].SourcePath)
+}
+
+func TestValidateFileIssues(t *testing.T) {
+	issues := Validate(files(
+		"dataset_description.json",
+		"README",
+		"participants.tsv",
+		"notes.docx",
+		"sub_01/anat/sub_01_T1w.nii.gz",
+		"sub-01/ses_1/anat/sub-01_T1w.nii.gz",
+		"sub-01/mri/sub-01_T1w.nii.gz",
+		"sub-01/sub-01_T1w.nii.gz",
+		"sub-01/anat/sub-02_T1w.nii.gz",
+		"sub-01/anat/sub-01_T1w-final.nii.gz",
+		"sub-01/func/sub-01_bold.nii.gz",
+		"sub-01/func/sub-01_task-rest_bold.json",
+		"sub-01/eeg/sub-01_task-rest_eeg.edf",
+		"sub-01/ses-1/anat/sub-01_T1w.nii.gz",
+	))
+
+	assert.Equal(t, map[string][]string{
+		UnknownRootFile:     {"notes.docx"},
+		InvalidSubjectName:  {"sub_01/anat/sub_01_T1w.nii.gz"},
+		InvalidSessionName:  {"sub-01/ses_1/anat/sub-01_T1w.nii.gz"},
+		UnknownDatatype:     {"sub-01/mri/sub-01_T1w.nii.gz"},
+		FileOutsideDatatype: {"sub-01/sub-01_T1w.nii.gz"},
+		EntityMismatch:      {"sub-01/anat/sub-02_T1w.nii.gz", "sub-01/ses-1/anat/sub-01_T1w.nii.gz"},
+		InvalidFilename:     {"sub-01/anat/sub-01_T1w-final.nii.gz"},
+		MissingTask:         {"sub-01/func/sub-01_bold.nii.gz"},
+		MissingSidecar: {
+			"sub-01/anat/sub-02_T1w.nii.gz",
+			"sub-01/eeg/sub-01_task-rest_eeg.edf",
+			"sub-01/func/sub-01_bold.nii.gz",
+			"sub-01/ses-1/anat/sub-01_T1w.nii.gz",
+		},
+	}, codes(issues))
+
+	for _, issue := range issues {
+		if issue.Path == "sub-01/eeg/sub-01_task-rest_eeg.edf" {
+			assert.Equal(t, Error, issue.Severity, "Expect a missing eeg sidecar to be an error.")
+		}
+	}
+}
+
+func TestValidateNestedRoot(t *testing.T) {
+	issues := Validate(files(
+		"study/dataset_description.json",
+		"study/README",
+		"study/participants.tsv",
+		"study/sub-01/anat/sub-01_T1w.nii.gz",
+		"study/sub-01/anat/sub-01_T1w.json",
+		"other/file.txt",
+	))
+	assert.Empty(t, issues, "Expect files outside the BIDS root to be ignored.")
+}
+
+func TestValidateSidecarFolders(t *testing.T) {
+	issues := Validate(files(
+		"T1w.json",
+		"study/dataset_description.json",
+		"study/README",
+		"study/participants.tsv",
+		"study/sub-01/anat/sub-01_T1w.nii.gz",
+		"study/sub-01/anat/sub-01_T1w.json",
+		"study/sub-02/anat/sub-02_T1w.nii.gz",
+		"study/sub-03/anat/sub-03_T1w.json",
+	))
+	assert.Equal(t, map[string][]string{
+		MissingSidecar: {"study/sub-02/anat/sub-02_T1w.nii.gz"},
+	}, codes(issues), "Expect only sidecars in the folders of a file up to the root to apply.")
+}
+
+func TestParseFilename(t *testing.T) {
+	f, err := parseFilename("sub-01_ses-2_task-rest_run-1_bold.nii.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sub", "ses", "task", "run"}, f.keys)
+	assert.Equal(t, "bold", f.suffix)
+	assert.Equal(t, ".nii.gz", f.extension)
+
+	_, err = parseFilename("sub-01_sub-02_bold.nii")
+	assert.Error(t, err)
+	_, err = parseFilename("sub-01_task-rest.nii")
+	assert.Error(t, err, "Expect a filename without suffix to be invalid.")
+}
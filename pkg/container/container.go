@@ -39,18 +39,31 @@ func (c *agentServerContainer) StartAgent() error {
 	}
 
 	// Register services
-	GRPCServer := grpc.NewServer()
+	GRPCServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(server.AuthUnaryInterceptor),
+		grpc.ChainStreamInterceptor(server.AuthStreamInterceptor),
+	)
 	serverImplementation, _ := server.NewAgentServer(GRPCServer)
 	v1.RegisterAgentServer(GRPCServer, serverImplementation)
 
-	// Reconciler shares the gRPC server's lifetime: cancel on Serve return so
-	// the goroutine exits cleanly instead of leaking.
-	reconcilerCtx, cancelReconciler := context.WithCancel(context.Background())
-	defer cancelReconciler()
-	go serverImplementation.StartReconciler(reconcilerCtx)
+	// Reconciler and timeseries cache evictor share the gRPC server's lifetime:
+	// cancel on Serve return so the goroutines exit cleanly instead of leaking.
+	// A graceful Stop request stops them before the server stops.
+	backgroundCtx, cancelBackground := context.WithCancel(context.Background())
+	defer cancelBackground()
+	serverImplementation.StartBackgroundTasks(backgroundCtx)
 
 	fmt.Printf("GRPC server listening on: %s", lis.Addr())
 
+	if viper.GetBool("agent.gateway_enabled") {
+		gatewayServer, err := startGateway(port)
+		if err != nil {
+			fmt.Println("failed to start HTTP gateway: ", err)
+			return err
+		}
+		defer gatewayServer.Close()
+	}
+
 	if err := GRPCServer.Serve(lis); err != nil {
 		fmt.Println("failed to serve: ", err)
 		return err
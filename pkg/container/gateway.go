@@ -0,0 +1,57 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/gateway"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// gatewayReadHeaderTimeout is the time in which clients must send the headers of a request to the gateway.
+const gatewayReadHeaderTimeout = 10 * time.Second
+
+// startGateway starts the HTTP gateway, which relays requests to the gRPC server on the provided port.
+// The gateway requires an auth token, as all requests to the gateway must carry it.
+func startGateway(grpcPort string) (*http.Server, error) {
+
+	if viper.GetString("agent.auth_token") == "" {
+		return nil, errors.New("the HTTP gateway requires agent.auth_token to be set")
+	}
+
+	conn, err := grpc.NewClient("localhost:"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort(viper.GetString("agent.gateway_host"), viper.GetString("agent.gateway_port"))
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	hosts := append(viper.GetStringSlice("agent.gateway_hosts"), viper.GetString("agent.gateway_host"))
+	gatewayServer := &http.Server{
+		Handler:           gateway.NewGateway(conn, viper.GetStringSlice("agent.gateway_origins"), hosts, server.AuthorizeGateway),
+		ReadHeaderTimeout: gatewayReadHeaderTimeout,
+	}
+
+	go func() {
+		defer conn.Close()
+		if err := gatewayServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("HTTP gateway stopped: ", err)
+		}
+	}()
+
+	fmt.Printf("\nHTTP gateway listening on: %s", lis.Addr())
+
+	return gatewayServer, nil
+}
@@ -0,0 +1,450 @@
+// Package gateway exposes the Agent gRPC service over HTTP for browser-based clients.
+//
+// Unary methods are available as REST/JSON endpoints at /v1/<Method>, with the request message as the JSON
+// body of a POST request. Server-streaming methods are available as Server-Sent Events at /v1/stream/<Method>,
+// with the request in the "request" query parameter, and over WebSocket at /v1/ws/<Method>, where the client
+// sends the request as the first message. Messages use the protobuf JSON mapping.
+//
+// As any web page that is open in a browser can send requests to the gateway, every request must come from an
+// allowed origin, address the gateway with an allowed host name and carry the bearer token. All requests are
+// relayed to the gRPC server, so they are handled exactly like gRPC requests.
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// MetadataKey is set in the metadata of all gRPC requests that are relayed by the gateway.
+const MetadataKey = "x-pennsieve-gateway"
+
+// FromGateway returns true if the incoming gRPC request was relayed by the gateway.
+func FromGateway(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(MetadataKey)) > 0
+}
+
+// AuthorizeFunc checks that a request from the remote address with the authorization header may be handled.
+type AuthorizeFunc func(remoteAddr string, authorization string) error
+
+// Gateway is an http.Handler that relays HTTP requests to the Agent gRPC service.
+type Gateway struct {
+	conn      grpc.ClientConnInterface
+	service   protoreflect.ServiceDescriptor
+	origins   []string
+	hosts     []string
+	authorize AuthorizeFunc
+	mux       *http.ServeMux
+}
+
+var marshaler = protojson.MarshalOptions{EmitUnpopulated: true}
+var unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// NewGateway returns a gateway that relays requests over the connection to the gRPC server. Requests are only
+// allowed from the provided origins, where "*" allows all origins, and for the provided host names, which
+// protects against DNS rebinding.
+func NewGateway(conn grpc.ClientConnInterface, origins []string, hosts []string, authorize AuthorizeFunc) *Gateway {
+	g := &Gateway{
+		conn:      conn,
+		service:   api.File_api_v1_agent_proto.Services().ByName("Agent"),
+		origins:   origins,
+		hosts:     hosts,
+		authorize: authorize,
+		mux:       http.NewServeMux(),
+	}
+
+	g.mux.HandleFunc("/v1/stream/{method}", g.handleEvents)
+	g.mux.Handle("/v1/ws/{method}", websocket.Server{
+		Handshake: g.websocketHandshake,
+		Handler:   g.handleWebsocket,
+	})
+	g.mux.HandleFunc("/v1/{method}", g.handleUnary)
+
+	return g
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if !g.allowedHost(r.Host) {
+		writeError(w, status.Error(codes.PermissionDenied, "host not allowed"))
+		return
+	}
+
+	origin := r.Header.Get("Origin")
+	if !g.allowedOrigin(origin) {
+		writeError(w, status.Error(codes.PermissionDenied, "missing or disallowed origin"))
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	w.Header().Add("Vary", "Origin")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := g.authorize(r.RemoteAddr, authorization(r)); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	g.mux.ServeHTTP(w, r)
+}
+
+// allowedOrigin returns true if requests are allowed from the origin.
+func (g *Gateway) allowedOrigin(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	return slices.Contains(g.origins, "*") || slices.Contains(g.origins, origin)
+}
+
+// allowedHost returns true if the host of the request, with or without port, is an allowed host name.
+func (g *Gateway) allowedHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+
+	return slices.ContainsFunc(g.hosts, func(allowed string) bool {
+		return strings.EqualFold(allowed, host)
+	})
+}
+
+// authorization returns the authorization of the request. Browsers cannot set headers for Server-Sent
+// Events and WebSocket requests, so the bearer token can also be provided as the "access_token" parameter.
+func authorization(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		return header
+	}
+	if token := r.URL.Query().Get("access_token"); token != "" {
+		return "Bearer " + token
+	}
+	return ""
+}
+
+// method returns the descriptor and full gRPC name of the method in the request path.
+func (g *Gateway) method(r *http.Request, streaming bool) (protoreflect.MethodDescriptor, string, error) {
+	md := g.service.Methods().ByName(protoreflect.Name(r.PathValue("method")))
+	if md == nil || md.IsStreamingClient() || md.IsStreamingServer() != streaming {
+		return nil, "", status.Errorf(codes.NotFound, "unknown method %s", r.PathValue("method"))
+	}
+
+	return md, fmt.Sprintf("/%s/%s", g.service.FullName(), md.Name()), nil
+}
+
+// newMessage returns an empty message of the provided type.
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// readRequest returns the request message for the method from the JSON body of a POST request, or from the
+// "request" query parameter of a GET request.
+func readRequest(r *http.Request, md protoreflect.MethodDescriptor) (proto.Message, error) {
+	req, err := newMessage(md.Input())
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	if r.Method == http.MethodPost {
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		body = []byte(r.URL.Query().Get("request"))
+	}
+
+	if len(strings.TrimSpace(string(body))) > 0 {
+		if err := unmarshaler.Unmarshal(body, req); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+	}
+
+	return req, nil
+}
+
+// outgoingContext returns a context that forwards the authorization of the HTTP request to the gRPC server
+// and marks the request as relayed by the gateway.
+func outgoingContext(r *http.Request) context.Context {
+	ctx := metadata.AppendToOutgoingContext(r.Context(), MetadataKey, "1")
+	if auth := authorization(r); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	return ctx
+}
+
+// handleUnary relays a unary method call and writes the response as JSON. Only POST requests with a JSON
+// body are accepted, which browsers cannot send cross-origin without a CORS preflight.
+func (g *Gateway) handleUnary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+
+	md, fullMethod, err := g.method(r, false)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req, err := readRequest(r, md)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := newMessage(md.Output())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := g.conn.Invoke(outgoingContext(r), fullMethod, req, resp); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body, err := marshaler.Marshal(resp)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// openStream calls a server-streaming method and returns a function that receives the next response.
+func (g *Gateway) openStream(ctx context.Context, md protoreflect.MethodDescriptor, fullMethod string,
+	req proto.Message) (func() (proto.Message, error), error) {
+
+	stream, err := g.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(req); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	return func() (proto.Message, error) {
+		resp, err := newMessage(md.Output())
+		if err != nil {
+			return nil, err
+		}
+		return resp, stream.RecvMsg(resp)
+	}, nil
+}
+
+// handleEvents relays a server-streaming method call as Server-Sent Events. Each response is sent as a
+// "message" event. If the stream fails, an "error" event is sent with the error.
+func (g *Gateway) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	md, fullMethod, err := g.method(r, true)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req, err := readRequest(r, md)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	recv, err := g.openStream(outgoingContext(r), md, fullMethod, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return
+		}
+
+		event := "message"
+		var data []byte
+		if err != nil {
+			event = "error"
+			data, _ = marshaler.Marshal(status.Convert(err).Proto())
+		} else if data, err = marshaler.Marshal(resp); err != nil {
+			log.Error("Failed to encode stream message: ", err)
+			return
+		}
+
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if event == "error" {
+			return
+		}
+	}
+}
+
+// websocketHandshake only accepts WebSocket connections from allowed origins, as browsers do not
+// apply the same-origin policy to WebSocket connections.
+func (g *Gateway) websocketHandshake(config *websocket.Config, r *http.Request) error {
+	if origin := r.Header.Get("Origin"); !g.allowedOrigin(origin) {
+		return fmt.Errorf("origin %q not allowed", origin)
+	}
+	return nil
+}
+
+// handleWebsocket relays a server-streaming method call over a WebSocket connection. The client sends
+// the request as the first message, and each response is sent as a text message. If the stream fails, an
+// error message with the status is sent before the connection is closed.
+func (g *Gateway) handleWebsocket(ws *websocket.Conn) {
+	defer ws.Close()
+	r := ws.Request()
+
+	sendError := func(err error) {
+		data, _ := marshaler.Marshal(status.Convert(err).Proto())
+		websocket.Message.Send(ws, fmt.Sprintf(`{"error":%s}`, data))
+	}
+
+	md, fullMethod, err := g.method(r, true)
+	if err != nil {
+		sendError(err)
+		return
+	}
+
+	var body string
+	if err := websocket.Message.Receive(ws, &body); err != nil {
+		return
+	}
+
+	req, err := newMessage(md.Input())
+	if err != nil {
+		sendError(err)
+		return
+	}
+	if err := unmarshaler.Unmarshal([]byte(body), req); err != nil {
+		sendError(status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+		return
+	}
+
+	// Cancel the call when the client closes the connection.
+	ctx, cancel := context.WithCancel(outgoingContext(r))
+	defer cancel()
+	go func() {
+		var ignored string
+		for websocket.Message.Receive(ws, &ignored) == nil {
+		}
+		cancel()
+	}()
+
+	recv, err := g.openStream(ctx, md, fullMethod, req)
+	if err != nil {
+		sendError(err)
+		return
+	}
+
+	for {
+		resp, err := recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			sendError(err)
+			return
+		}
+
+		data, err := marshaler.Marshal(resp)
+		if err != nil {
+			log.Error("Failed to encode stream message: ", err)
+			return
+		}
+		if err := websocket.Message.Send(ws, string(data)); err != nil {
+			return
+		}
+	}
+}
+
+// writeError writes a gRPC error as a JSON status with the corresponding HTTP status code.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := marshaler.Marshal(st.Proto())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(body)
+}
+
+// httpStatus returns the HTTP status code for a gRPC status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// testAgent implements a few methods of the Agent service.
+type testAgent struct {
+	api.UnimplementedAgentServer
+}
+
+func (a *testAgent) Version(ctx context.Context, req *api.VersionRequest) (*api.VersionResponse, error) {
+	return &api.VersionResponse{Version: "1.2.3"}, nil
+}
+
+func (a *testAgent) ListManifestFiles(ctx context.Context, req *api.ListManifestFilesRequest) (*api.ListManifestFilesResponse, error) {
+	return nil, status.Errorf(codes.NotFound, "manifest %d not found", req.ManifestId)
+}
+
+func (a *testAgent) Subscribe(req *api.SubscribeRequest, stream api.Agent_SubscribeServer) error {
+	for i := range 3 {
+		err := stream.Send(&api.SubscribeResponse{
+			Type: api.SubscribeResponse_EVENT,
+			MessageData: &api.SubscribeResponse_EventInfo{
+				EventInfo: &api.SubscribeResponse_EventResponse{Details: fmt.Sprintf("event %d for %d", i, req.Id)},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTestGateway(t *testing.T, authorize AuthorizeFunc) *httptest.Server {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	api.RegisterAgentServer(grpcServer, &testAgent{})
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	if authorize == nil {
+		authorize = func(string, string) error { return nil }
+	}
+
+	server := httptest.NewServer(NewGateway(conn, []string{"http://viewer.local"}, []string{"127.0.0.1"}, authorize))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testOrigin is the origin that is allowed by the test gateway.
+const testOrigin = "http://viewer.local"
+
+// send sends a request to the gateway from the allowed origin. Requests with a body are sent as JSON.
+func send(t *testing.T, method string, url string, body string) *http.Response {
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+	req.Header.Set("Origin", testOrigin)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestUnary(t *testing.T) {
+	server := newTestGateway(t, nil)
+
+	resp := send(t, http.MethodPost, server.URL+"/v1/Version", "{}")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "1.2.3", body["version"])
+
+	// gRPC errors are mapped to HTTP status codes
+	resp = send(t, http.MethodPost, server.URL+"/v1/ListManifestFiles", `{"manifestId": 5}`)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "manifest 5 not found", body["message"])
+
+	resp = send(t, http.MethodPost, server.URL+"/v1/UnknownMethod", "{}")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Streaming methods are not available as unary methods
+	resp = send(t, http.MethodPost, server.URL+"/v1/Subscribe", "{}")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Unary methods only accept POST requests with a JSON body
+	resp = send(t, http.MethodGet, server.URL+"/v1/Version?request="+url.QueryEscape("{}"), "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v1/Version", strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Origin", testOrigin)
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+}
+
+func TestCors(t *testing.T) {
+	server := newTestGateway(t, nil)
+
+	request := func(method string, origin string) *http.Response {
+		req, err := http.NewRequest(method, server.URL+"/v1/Version", strings.NewReader("{}"))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := request(http.MethodOptions, testOrigin)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, testOrigin, resp.Header.Get("Access-Control-Allow-Origin"))
+
+	resp = request(http.MethodPost, testOrigin)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = request(http.MethodPost, "http://evil.example")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+
+	// Requests without an origin are refused
+	resp = request(http.MethodPost, "")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestHost(t *testing.T) {
+	server := newTestGateway(t, nil)
+
+	// A DNS rebinding attack addresses the gateway with the host name of the attacker
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v1/Version", strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Host = "evil.example"
+	req.Header.Set("Origin", testOrigin)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	g := &Gateway{hosts: []string{"localhost", "::1"}}
+	assert.True(t, g.allowedHost("localhost:9001"))
+	assert.True(t, g.allowedHost("LOCALHOST"))
+	assert.True(t, g.allowedHost("[::1]:9001"))
+	assert.False(t, g.allowedHost("127.0.0.1:9001"))
+}
+
+func TestAuthorize(t *testing.T) {
+	server := newTestGateway(t, func(remoteAddr string, authorization string) error {
+		if authorization != "Bearer secret" {
+			return status.Error(codes.Unauthenticated, "missing or invalid auth token")
+		}
+		return nil
+	})
+
+	resp := send(t, http.MethodPost, server.URL+"/v1/Version", "{}")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v1/Version", strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.Header.Set("Origin", testOrigin)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer secret")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = send(t, http.MethodPost, server.URL+"/v1/Version?access_token=secret", "{}")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServerSentEvents(t *testing.T) {
+	server := newTestGateway(t, nil)
+
+	resp := send(t, http.MethodGet, server.URL+"/v1/stream/Subscribe?request="+url.QueryEscape(`{"id": 7}`), "")
+
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+			events = append(events, data)
+		}
+	}
+
+	require.Len(t, events, 3)
+	assert.Contains(t, events[2], "event 2 for 7")
+}
+
+func TestWebsocket(t *testing.T) {
+	server := newTestGateway(t, nil)
+	wsUrl := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/ws/Subscribe"
+
+	ws, err := websocket.Dial(wsUrl, "", testOrigin)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	require.NoError(t, websocket.Message.Send(ws, `{"id": 3}`))
+
+	var messages []string
+	for {
+		var message string
+		if err := websocket.Message.Receive(ws, &message); err != nil {
+			break
+		}
+		messages = append(messages, message)
+	}
+
+	require.Len(t, messages, 3)
+	assert.Contains(t, messages[0], "event 0 for 3")
+
+	// Connections from other origins are refused
+	_, err = websocket.Dial(wsUrl, "", "http://evil.example")
+	var dialErr *websocket.DialError
+	assert.True(t, errors.As(err, &dialErr))
+}
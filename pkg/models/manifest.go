@@ -0,0 +1,101 @@
+package models
+
+import "github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+
+// ManifestState is the lifecycle state of a local manifest.
+type ManifestState string
+
+const (
+	ManifestIndexed         ManifestState = "indexed"          // Files are indexed and waiting to be synced or uploaded
+	ManifestSyncing         ManifestState = "syncing"          // Files are being registered with the server
+	ManifestUploading       ManifestState = "uploading"        // Files are being uploaded
+	ManifestFinalizing      ManifestState = "finalizing"       // Files are uploaded and waiting to be verified by the server
+	ManifestVerified        ManifestState = "verified"         // All files are verified by the server
+	ManifestPartiallyFailed ManifestState = "partially_failed" // Some files failed to sync or upload
+	ManifestCancelled       ManifestState = "cancelled"        // The sync or upload was cancelled by the user
+)
+
+// IsActive returns true if a sync or upload session is running for the manifest.
+func (s ManifestState) IsActive() bool {
+	return s == ManifestSyncing || s == ManifestUploading
+}
+
+// ManifestEvent is an event that moves a manifest to another state.
+type ManifestEvent int
+
+const (
+	ManifestFilesChanged    ManifestEvent = iota // Files were added, removed or changed status outside a session
+	ManifestReset                                // The status of all files was reset
+	ManifestSyncStarted                          // A sync session started
+	ManifestSyncEnded                            // A sync session ended
+	ManifestUploadStarted                        // An upload session started
+	ManifestUploadEnded                          // An upload session ended
+	ManifestCancelRequested                      // The user cancelled the sessions of the manifest
+	ManifestInterrupted                          // The agent stopped while a session of the manifest was running
+)
+
+// NextManifestState returns the state of a manifest after an event, given its current state and the number
+// of files in the manifest for each status.
+//
+// Sessions take precedence over file changes: a syncing or uploading manifest keeps its state until the session
+// ends. A cancelled manifest stays cancelled until it is reset, a new session starts or all files are verified.
+func NextManifestState(current ManifestState, event ManifestEvent, counts map[manifestFile.Status]int64) ManifestState {
+	switch event {
+	case ManifestSyncStarted:
+		if current == ManifestUploading {
+			return current
+		}
+		return ManifestSyncing
+	case ManifestUploadStarted:
+		return ManifestUploading
+	case ManifestCancelRequested:
+		if current.IsActive() {
+			return ManifestCancelled
+		}
+		return current
+	case ManifestSyncEnded:
+		if current != ManifestSyncing {
+			return current
+		}
+		return ManifestStateForCounts(counts)
+	case ManifestReset:
+		return ManifestStateForCounts(counts)
+	case ManifestInterrupted:
+		if !current.IsActive() {
+			return current
+		}
+		return ManifestStateForCounts(counts)
+	}
+
+	if current.IsActive() && event == ManifestFilesChanged {
+		return current
+	}
+	next := ManifestStateForCounts(counts)
+	if current == ManifestCancelled && next != ManifestVerified {
+		return current
+	}
+	return next
+}
+
+// ManifestStateForCounts returns the state of an idle manifest with the number of files for each status.
+func ManifestStateForCounts(counts map[manifestFile.Status]int64) ManifestState {
+	var total int64
+	for st, n := range counts {
+		if st != manifestFile.Removed {
+			total += n
+		}
+	}
+
+	switch {
+	case total == 0:
+		return ManifestIndexed
+	case counts[manifestFile.Verified] == total:
+		return ManifestVerified
+	case counts[manifestFile.Uploaded]+counts[manifestFile.Imported]+counts[manifestFile.Finalized] > 0:
+		return ManifestFinalizing
+	case counts[manifestFile.Failed]+counts[manifestFile.FailedOrphan] > 0:
+		return ManifestPartiallyFailed
+	default:
+		return ManifestIndexed
+	}
+}
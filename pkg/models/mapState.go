@@ -8,11 +8,15 @@ import "time"
 // of files that have been pulled.
 //
 // Using the pull-time, we can check if files were changed after
-// pulled from Pennsieve.
+// pulled from Pennsieve. The size and checksum of the remote file at
+// the time of the pull are used to check if the remote file changed
+// after it was pulled.
 type MapState struct {
-	LastFetch time.Time        `json:"lastFetch"`
-	LastPull  time.Time        `json:"lastPull"`
-	Files     []MapStateRecord `json:"files"`
+	LastFetch  time.Time           `json:"lastFetch"`
+	LastPull   time.Time           `json:"lastPull"`
+	Files      []MapStateRecord    `json:"files"`
+	Hashes     []MapStateHash      `json:"hashes,omitempty"`
+	Tombstones []MapStateTombstone `json:"tombstones,omitempty"`
 }
 
 type MapStateRecord struct {
@@ -21,6 +25,32 @@ type MapStateRecord struct {
 	PullTime time.Time `json:"pullTime"`
 	IsLocal  bool      `json:"isLocal"`
 	Crc32    uint32    `json:"crc32"`
+	Sha256   string    `json:"sha256,omitempty"`
+	ModTime  time.Time `json:"modTime"`
+	Size     int64     `json:"size,omitempty"`
+	CheckSum string    `json:"checksum,omitempty"`
+}
+
+// MapStateHash caches the hashes of a local file in a mapped dataset. The
+// cached hashes are valid as long as the size and modification time of the
+// file are unchanged. Hashes are only set when they have been calculated.
+type MapStateHash struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	Crc32   *uint32   `json:"crc32,omitempty"`
+	Sha256  string    `json:"sha256,omitempty"`
+}
+
+// MapStateTombstone records a file in the mapped dataset whose package was deleted
+// or moved on Pennsieve while the local content was kept by a fetch. The file is no
+// longer in the workspace manifest, but it is not a new file either, so it is not
+// reported as added by diff and not uploaded again by push.
+type MapStateTombstone struct {
+	Path      string    `json:"path"`
+	PackageId string    `json:"packageId"`
+	FileId    string    `json:"fileId"`
+	FetchTime time.Time `json:"fetchTime"`
 }
 
 type StatusFileInfo struct {
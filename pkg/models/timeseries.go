@@ -13,6 +13,9 @@ type TsChannel struct {
 	End           int64
 	Unit          string
 	Rate          float64
+	Group         string            // Channel group, such as a montage or electrode array
+	Type          string            // Channel type, such as "continuous" or "unit"
+	Properties    map[string]string // Channel settings, such as the reference and filters
 }
 
 type TsBlock struct {
@@ -22,6 +25,78 @@ type TsBlock struct {
 	Location      string
 	StartTime     int64
 	EndTime       int64
+	Size          int64  // Size of the cached block file in bytes
+	LastAccess    int64  // Unix time at which the cached block was last used
+	Checksum      uint32 // CRC32 checksum of the cached block file; 0 if unknown
+	Encoding      TsSampleEncoding
+	Scale         float64 // Scale of the samples as recorded with the cached block; 1 for float64 samples
+}
+
+// TsSampleEncoding is the binary encoding of the samples in a timeseries block file.
+//
+// Pennsieve only serves blocks with float64 samples, and the range blocks do not describe their encoding,
+// so all blocks are float64. The encoding is recorded with each cached block so that blocks with another
+// encoding are rejected instead of being misread.
+type TsSampleEncoding string
+
+const (
+	TsFloat64 TsSampleEncoding = "float64" // Big-endian IEEE 754 double precision samples
+)
+
+// SampleWidth returns the number of bytes per sample, or 0 for an unknown encoding. Blocks without an
+// encoding contain float64 samples.
+func (e TsSampleEncoding) SampleWidth() int {
+	switch e {
+	case TsFloat64, "":
+		return 8
+	default:
+		return 0
+	}
+}
+
+// TsCacheStats summarizes the locally cached timeseries blocks for a package.
+type TsCacheStats struct {
+	PackageNodeId string
+	Blocks        int64
+	Size          int64
+	LastAccess    int64
+	Pinned        bool // Blocks of pinned packages are never evicted
+}
+
+// TsCoverage describes how a range request was served from the local cache.
+type TsCoverage struct {
+	Offline bool // Blocks were only read from the local cache
+	Partial bool // Cached blocks do not cover the full requested range
+}
+
+// TsGap is a period without recorded data between two adjacent blocks of a channel.
+type TsGap struct {
+	ChannelNodeId string
+	StartTime     int64 // End time of the block before the gap
+	EndTime       int64 // Start time of the block after the gap
+}
+
+// TsAnnotationLayer is a named collection of annotations on a timeseries package.
+type TsAnnotationLayer struct {
+	LayerId       int64
+	PackageNodeId string
+	Name          string
+	Description   string
+	Color         string
+}
+
+// TsAnnotation is an annotation or event on a time range of one or more channels of a timeseries package.
+// Annotations without channels apply to all channels of the package.
+type TsAnnotation struct {
+	AnnotationId   int64
+	LayerId        int64
+	PackageNodeId  string
+	ChannelNodeIds []string
+	Name           string
+	Label          string
+	Description    string
+	StartTime      int64
+	EndTime        int64
 }
 
 type SimpleTsBlock struct {
@@ -0,0 +1,33 @@
+package models
+
+// WorkflowRunStatus is the state of a local workflow run.
+type WorkflowRunStatus string
+
+const (
+	WorkflowPending   WorkflowRunStatus = "pending"   // The run is being prepared
+	WorkflowRunning   WorkflowRunStatus = "running"   // The workflow is executing
+	WorkflowSucceeded WorkflowRunStatus = "succeeded" // The workflow completed successfully
+	WorkflowFailed    WorkflowRunStatus = "failed"    // The workflow or its preparation failed
+	WorkflowCancelled WorkflowRunStatus = "cancelled" // The run was cancelled by the user or a shutdown
+)
+
+// IsFinished returns true if the run has ended.
+func (s WorkflowRunStatus) IsFinished() bool {
+	return s == WorkflowSucceeded || s == WorkflowFailed || s == WorkflowCancelled
+}
+
+type WorkflowRun struct {
+	Id         string
+	ManifestId int32
+	Workflow   string // Path or name of the workflow
+	Status     WorkflowRunStatus
+	JobDir     string // Folder containing the input, configuration and output of the run
+	LogPath    string // File containing the stdout and stderr of the run
+	ExitCode   int
+	Error      string
+	CreatedAt  int64 // Unix time at which the run was created
+	StartedAt  int64 // Unix time at which the workflow started executing; 0 if it did not start
+	FinishedAt int64 // Unix time at which the run ended; 0 if it has not ended
+
+	DerivativesManifestId int32 // Manifest through which the derivatives of the run are uploaded; 0 if none
+}
@@ -27,6 +27,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
 	"github.com/pennsieve/pennsieve-go/pkg/pennsieve"
@@ -147,6 +148,9 @@ func (r *Reconciler) reconcileManifest(ctx context.Context, client *pennsieve.Cl
 
 	if verified > 0 {
 		log.Infof("reconciler: manifest %s: verified %d file(s)", nodeID, verified)
+		if _, err := r.manifestStore.UpdateState(manifestID, models.ManifestFilesChanged); err != nil {
+			return err
+		}
 	}
 	return nil
 }
\ No newline at end of file
@@ -3,18 +3,18 @@ package server
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"os/exec"
 	"sync"
 
 	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/config"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/service"
-	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
 	"github.com/pennsieve/pennsieve-go/pkg/pennsieve"
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +25,7 @@ type manifestService interface {
 	RemoveFromManifest(manifestId int32, removePath string) (models.RemoveFromManifestResponse, error)
 	RemoveManifest(manifestId int32) error
 	GetFiles(manifestId int32, limit int32, offset int32) ([]store.ManifestFile, error)
+	FindFiles(manifestId int32, filter store.ManifestFileFilter) ([]store.ManifestFile, int64, error)
 	ResetStatusForManifest(manifestId int32) error
 	GetNumberOfRowsForStatus(manifestId int32, statusArr []manifestFile.Status, invert bool) (int64, error)
 	ManifestFilesToChannel(ctx context.Context, manifestId int32, statusArr []manifestFile.Status, walker chan<- store.ManifestFile)
@@ -32,12 +33,17 @@ type manifestService interface {
 	SetManifestNodeId(m *store.Manifest, nodeId string) error
 	BatchSetFileStatus(uploadIds []string, status manifestFile.Status) error
 	AddFiles(records []store.ManifestFileParams) error
+	UpdateFileTargets(manifestId int32, targets []store.ManifestFileTarget) error
+	SetFileMetadata(manifestId int32, metadata map[string]map[string]string) ([]string, error)
+	GetStatusCounts(manifestId int32) (map[manifestFile.Status]int64, error)
+	UpdateState(manifestId int32, event models.ManifestEvent) (models.ManifestState, error)
 }
 
 type DependencyContainer interface {
 	SqliteDB() *sql.DB
 	TimeseriesStore() store.TimeseriesStore
 	TimeseriesService() service.TimeseriesService
+	TimeseriesCache() *service.TimeseriesCache
 	PennsieveClient() (*pennsieve.Client, error)
 	UserSettingsStore() store.UserSettingsStore
 	UserInfoStore() store.UserInfoStore
@@ -52,14 +58,24 @@ type DependencyContainer interface {
 type agentServer struct {
 	pb.UnimplementedAgentServer
 
-	subscribers sync.Map // subscribers is a concurrent map that holds mapping from a client ID to it's subscriber.
-	cancelFncs  sync.Map // cancelFncs is a concurrent map that holds cancel functions for upload routines.
+	subscribers  sync.Map // subscribers is a concurrent map that holds mapping from a client ID to it's subscriber.
+	cancelFncs   sync.Map // cancelFncs is a concurrent map that holds cancel functions for upload routines.
+	workflowRuns sync.Map // workflowRuns is a concurrent map that holds cancel functions for active workflow runs.
 
 	grpcServer *grpc.Server
 	client     *pennsieve.Client
 	sqliteDB   *sql.DB
 
+	shutdownMu     sync.Mutex
+	draining       bool           // draining is set when the agent is stopping and no longer accepts uploads or workflows.
+	uploads        sync.WaitGroup // uploads tracks active upload sessions until their status updates are flushed.
+	workflows      sync.WaitGroup // workflows tracks active workflow runs until their result is stored.
+	background     sync.WaitGroup // background tracks the reconciler and timeseries cache evictor.
+	stopBackground context.CancelFunc
+
+	timeseriesMu      sync.Mutex // timeseriesMu guards the lazy initialization of the timeseries store, cache and service.
 	timeseriesStore   store.TimeseriesStore
+	workflowRunStore  store.WorkflowRunStore
 	userInfoStore     store.UserInfoStore
 	userSettingsStore store.UserSettingsStore
 	manifestStore     store.ManifestStore
@@ -69,9 +85,12 @@ type agentServer struct {
 	user              *service.UserService
 	account           *service.AccountService
 	timeseriesService service.TimeseriesService
+	timeseriesCache   *service.TimeseriesCache
 
 	getManifestParamsOverride func() (store.ManifestParams, error)
 	uploadManifestOverride    func(context.Context, *pb.UploadManifestRequest) (*pb.SimpleStatusResponse, error)
+	syncManifestOverride      func(context.Context, *pb.SyncManifestRequest) (*pb.SyncManifestResponse, error)
+	workflowCommandOverride   func(context.Context, WorkOrder) *exec.Cmd
 }
 
 func NewAgentServer(s *grpc.Server) (*agentServer, error) {
@@ -115,10 +134,16 @@ func (s *agentServer) UserInfoStore() store.UserInfoStore {
 	return s.userInfoStore
 }
 func (s *agentServer) TimeseriesStore() store.TimeseriesStore {
-	if s.timeseriesStore == nil {
-		s.timeseriesStore = store.NewTimeseriesStore(s.SqliteDB())
+	s.timeseriesMu.Lock()
+	defer s.timeseriesMu.Unlock()
+
+	return s.initTimeseriesStore()
+}
+func (s *agentServer) WorkflowRunStore() store.WorkflowRunStore {
+	if s.workflowRunStore == nil {
+		s.workflowRunStore = store.NewWorkflowRunStore(s.SqliteDB())
 	}
-	return s.timeseriesStore
+	return s.workflowRunStore
 }
 func (s *agentServer) PennsieveClient() (*pennsieve.Client, error) {
 	if s.client == nil {
@@ -184,20 +209,57 @@ func (s *agentServer) ManifestService() manifestService {
 	return s.manifest
 }
 func (s *agentServer) TimeseriesService() service.TimeseriesService {
-	if s.timeseriesService == nil {
-		client, err := s.PennsieveClient()
-		if err != nil {
-			log.Error(err)
-		}
+	s.timeseriesMu.Lock()
+	defer s.timeseriesMu.Unlock()
 
-		s.timeseriesService = service.NewTimeseriesService(
-			s.TimeseriesStore(),
-			client,
-			s,
-		)
+	if s.timeseriesService != nil {
+		return s.timeseriesService
+	}
+
+	client, err := s.PennsieveClient()
+	if err != nil {
+		log.Error(err)
 	}
 
-	return s.timeseriesService
+	timeseriesService := service.NewTimeseriesService(
+		s.initTimeseriesStore(),
+		client,
+		s,
+		s.initTimeseriesCache(),
+	)
+
+	// Without a client the service can only serve cached data. It is not kept, so the next call
+	// creates the service again once the client is available.
+	if client != nil {
+		s.timeseriesService = timeseriesService
+	}
+
+	return timeseriesService
+}
+func (s *agentServer) TimeseriesCache() *service.TimeseriesCache {
+	s.timeseriesMu.Lock()
+	defer s.timeseriesMu.Unlock()
+
+	return s.initTimeseriesCache()
+}
+
+// initTimeseriesStore returns the timeseries store and creates it on first use. The caller holds timeseriesMu.
+func (s *agentServer) initTimeseriesStore() store.TimeseriesStore {
+	if s.timeseriesStore == nil {
+		s.timeseriesStore = store.NewTimeseriesStore(s.SqliteDB())
+	}
+	return s.timeseriesStore
+}
+
+// initTimeseriesCache returns the timeseries cache and creates it on first use. The caller holds timeseriesMu.
+func (s *agentServer) initTimeseriesCache() *service.TimeseriesCache {
+	if s.timeseriesCache == nil {
+		s.timeseriesCache = service.NewTimeseriesCache(
+			s.initTimeseriesStore(),
+			viper.GetInt64("agent.timeseries_cache_size")*1024*1024,
+		)
+	}
+	return s.timeseriesCache
 }
 
 // messageSubscribers sends a string message to all grpc-update subscribers and the log
@@ -206,42 +268,9 @@ func (s *agentServer) messageSubscribers(message string) {
 	// Send message to log
 	log.Info("SubscriberMessage: ", message)
 
-	// A list of clients to unsubscribe in case of error
-	var unsubscribe []int32
-
-	// Iterate over all subscribers and send data to each client
-	s.subscribers.Range(func(k, v interface{}) bool {
-		id, ok := k.(int32)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber key: %T", k))
-			return false
-		}
-		sub, ok := v.(shared.Sub)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber value: %T", v))
-			return false
-		}
-		// Send data over the gRPC Stream to the client
-		if err := sub.Stream.Send(&pb.SubscribeResponse{
-			Type: pb.SubscribeResponse_EVENT,
-			MessageData: &pb.SubscribeResponse_EventInfo{
-				EventInfo: &pb.SubscribeResponse_EventResponse{Details: message}},
-		}); err != nil {
-			log.Warn(fmt.Sprintf("Failed to send data to client: %v", err))
-			select {
-			case sub.Finished <- true:
-				log.Info(fmt.Sprintf("Unsubscribed client: %d", id))
-			default:
-				// Default case is to avoid blocking in case client has already unsubscribed
-			}
-			// In case of error the client would re-subscribe so close the subscriber Stream
-			unsubscribe = append(unsubscribe, id)
-		}
-		return true
+	s.sendSubscribers(&pb.SubscribeResponse{
+		Type: pb.SubscribeResponse_EVENT,
+		MessageData: &pb.SubscribeResponse_EventInfo{
+			EventInfo: &pb.SubscribeResponse_EventResponse{Details: message}},
 	})
-
-	// Unsubscribe erroneous client streams
-	for _, id := range unsubscribe {
-		s.subscribers.Delete(id)
-	}
 }
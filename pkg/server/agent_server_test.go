@@ -3,12 +3,16 @@ package server
 import (
 	"context"
 	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/service"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
 	"net"
+	"sync"
 	"testing"
 )
 
@@ -75,3 +79,23 @@ func (s *GRPCTestSuite) TestVersion() {
 func TestGRPCSuite(t *testing.T) {
 	suite.Run(t, new(GRPCTestSuite))
 }
+
+func TestTimeseriesCacheConcurrentInit(t *testing.T) {
+	s := &agentServer{timeseriesStore: store.NewTimeseriesStore(nil)}
+
+	// The evictor and request handlers get the cache concurrently and must share one instance.
+	caches := make([]*service.TimeseriesCache, 8)
+	var wg sync.WaitGroup
+	for i := range caches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			caches[i] = s.TimeseriesCache()
+		}()
+	}
+	wg.Wait()
+
+	for _, c := range caches {
+		assert.Same(t, caches[0], c)
+	}
+}
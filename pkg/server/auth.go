@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Authorize checks the bearer token in the authorization value of a request from the remote address.
+// Requests from the local machine are always allowed. Requests from other machines must carry the
+// token configured as "agent.auth_token", if any.
+func Authorize(remoteAddr string, authorization string) error {
+	token := viper.GetString("agent.auth_token")
+	if token == "" || isLoopback(remoteAddr) {
+		return nil
+	}
+
+	return checkToken(authorization, token)
+}
+
+// AuthorizeGateway checks the bearer token in the authorization value of a request to the HTTP gateway.
+// Unlike gRPC requests, requests from the local machine must carry the token too, as any web page that
+// is open in a local browser can send them. Without a configured "agent.auth_token" all requests are denied.
+func AuthorizeGateway(remoteAddr string, authorization string) error {
+	token := viper.GetString("agent.auth_token")
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "the HTTP gateway requires agent.auth_token to be set")
+	}
+
+	return checkToken(authorization, token)
+}
+
+// checkToken returns an error unless the authorization value is the bearer token.
+func checkToken(authorization string, token string) error {
+	bearer, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+		return status.Error(codes.Unauthenticated, "missing or invalid auth token")
+	}
+
+	return nil
+}
+
+// AuthUnaryInterceptor authorizes unary gRPC requests.
+func AuthUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if err := authorizeContext(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// AuthStreamInterceptor authorizes streaming gRPC requests.
+func AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if err := authorizeContext(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authorizeContext authorizes a gRPC request using the peer address and authorization metadata.
+func authorizeContext(ctx context.Context) error {
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+
+	return Authorize(remoteAddr, authorization)
+}
+
+// isLoopback returns true if the address is on the local machine.
+func isLoopback(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,36 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	defer viper.Set("agent.auth_token", "")
+
+	// Without an auth token all requests are allowed
+	viper.Set("agent.auth_token", "")
+	assert.NoError(t, Authorize("192.168.1.10:5000", ""))
+
+	viper.Set("agent.auth_token", "secret")
+	assert.NoError(t, Authorize("127.0.0.1:5000", ""), "Expect requests from the local machine to be allowed.")
+	assert.NoError(t, Authorize("[::1]:5000", ""))
+	assert.Error(t, Authorize("192.168.1.10:5000", ""))
+	assert.Error(t, Authorize("192.168.1.10:5000", "Bearer wrong"))
+	assert.NoError(t, Authorize("192.168.1.10:5000", "Bearer secret"))
+}
+
+func TestAuthorizeGateway(t *testing.T) {
+	defer viper.Set("agent.auth_token", "")
+
+	// Without an auth token the gateway denies all requests
+	viper.Set("agent.auth_token", "")
+	assert.Error(t, AuthorizeGateway("127.0.0.1:5000", ""))
+
+	viper.Set("agent.auth_token", "secret")
+	assert.Error(t, AuthorizeGateway("127.0.0.1:5000", ""), "Expect requests from the local machine to require the token.")
+	assert.Error(t, AuthorizeGateway("127.0.0.1:5000", "Bearer wrong"))
+	assert.NoError(t, AuthorizeGateway("127.0.0.1:5000", "Bearer secret"))
+}
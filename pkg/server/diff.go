@@ -35,7 +35,14 @@ func (s *agentServer) GetMapDiff(_ context.Context, req *api.MapDiffRequest) (*a
 		return nil, err
 	}
 
-	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files)
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := newFingerprinter(req.Strength, mapState.Hashes)
+	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files, hasher)
 
 	log.Warn(result)
 
@@ -43,6 +50,13 @@ func (s *agentServer) GetMapDiff(_ context.Context, req *api.MapDiffRequest) (*a
 		return nil, err
 	}
 
+	// Cache the calculated hashes so repeated diffs only hash new or modified files.
+	if hashes := hasher.calculatedHashes(); len(hashes) > 0 {
+		if err := cacheMapStateHashes(datasetRoot, hashes); err != nil {
+			log.Warnf("Unable to cache file hashes: %v", err)
+		}
+	}
+
 	// Map result into response
 	var response api.MapDiffResponse
 	for _, r := range result {
@@ -70,6 +84,8 @@ func (s *agentServer) GetMapDiff(_ context.Context, req *api.MapDiffRequest) (*a
 				Message:   "",
 			}
 		case api.PackageStatus_CHANGED:
+			fallthrough
+		case api.PackageStatus_CONFLICT:
 			content = api.FileInfo{
 				PackageId: r.Changed.from.PackageNodeId,
 				Path:      r.Changed.from.Path,
@@ -91,15 +107,42 @@ func (s *agentServer) GetMapDiff(_ context.Context, req *api.MapDiffRequest) (*a
 	return &response, nil
 }
 
-// CrcSize is the length of the buffer that is used to calculate the CRC32 for the files.
-// Files are considered the same if files match for the length of the buffer.
+// cacheMapStateHashes adds hashes to the hash cache in the state file of a mapped dataset, and removes
+// the hashes of files that no longer exist. The state file is read again under the state file lock, as
+// pull and other commands update it while the diff runs.
+func cacheMapStateHashes(datasetRoot string, hashes []models2.MapStateHash) error {
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	defer shared.LockStateFile(stateFileLocation)()
+
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return err
+	}
+
+	for _, h := range hashes {
+		setMapStateHash(mapState, h)
+	}
+
+	cached := mapState.Hashes[:0]
+	for _, h := range mapState.Hashes {
+		if found, _ := exists(filepath.Join(datasetRoot, filepath.FromSlash(h.Path))); found {
+			cached = append(cached, h)
+		}
+	}
+	mapState.Hashes = cached
+
+	return shared.WriteStateFile(stateFileLocation, mapState)
+}
+
+// CrcSize is the minimum length of the buffer that is used to calculate the CRC32 for the files.
+// Files that are larger than the buffer are hashed entirely.
 // This is only tested for new files and compared to other files in manifest that are considered deleted.
 const CrcSize = 1024 * 1024
 
-type crcOrFileId struct {
-	hasFileId bool
-	FileId    string
-	Crc32     uint32
+type fingerprintOrFileId struct {
+	hasFileId   bool
+	FileId      string
+	Fingerprint string
 }
 
 type folderFile struct {
@@ -109,12 +152,12 @@ type folderFile struct {
 }
 
 type addedFile struct {
-	FileName  string
-	Path      string
-	Size      int64
-	hasFileId bool
-	Crc32     uint32
-	FileId    string
+	FileName    string
+	Path        string
+	Size        int64
+	hasFileId   bool
+	Fingerprint string
+	FileId      string
 }
 
 type deletedFile struct {
@@ -127,9 +170,9 @@ type deletedFile struct {
 }
 
 type changedFile struct {
-	Size  int64
-	Crc32 uint32
-	from  models.ManifestDTO
+	Size        int64
+	Fingerprint string
+	from        models.ManifestDTO
 }
 
 type renamedMovedFile struct {
@@ -146,31 +189,31 @@ type diffResult struct {
 	Changed  changedFile
 }
 
-func getFileIdOrCrc32(path string, maxBytes int) (*crcOrFileId, error) {
+func getFileIdOrFingerprint(datasetRoot string, relPath string, hasher *fingerprinter) (*fingerprintOrFileId, error) {
 
 	// Try to read file id from file
-	fileId, err := shared.ReadFileIDFromFile(path)
+	fileId, err := shared.ReadFileIDFromFile(path.Join(datasetRoot, relPath))
 	if err != nil {
-		// Return CRC
-		crc32, err := shared.GetFileCrc32(path, maxBytes)
-		log.Debug("GOT CRC32: ", crc32)
+		// Return fingerprint
+		fingerprint, err := hasher.fingerprint(datasetRoot, relPath)
+		log.Debug("GOT FINGERPRINT: ", fingerprint)
 		if err != nil {
 			return nil, err
 		}
 
-		return &crcOrFileId{
-			hasFileId: false,
-			FileId:    "",
-			Crc32:     crc32,
+		return &fingerprintOrFileId{
+			hasFileId:   false,
+			FileId:      "",
+			Fingerprint: fingerprint,
 		}, nil
 
 	}
 	log.Debug("GOT FILEID: ", fileId)
 
-	return &crcOrFileId{
-		hasFileId: true,
-		FileId:    fileId,
-		Crc32:     0,
+	return &fingerprintOrFileId{
+		hasFileId:   true,
+		FileId:      fileId,
+		Fingerprint: "",
 	}, nil
 
 }
@@ -237,13 +280,15 @@ func createFolderManifest(datasetRoot string) ([]folderFile, error) {
 
 }
 
-// compareManifestToFolder returns a list of files that are ADDED, CHANGED, MOVED, RENAMED or DELETED
-// since fetching the dataset from the Pennsieve server (compare to the manifest.json file)
-func compareManifestToFolder(datasetRoot string, manifest []models.ManifestDTO, files []folderFile) ([]diffResult, error) {
+// compareManifestToFolder returns a list of files that are ADDED, CHANGED, MOVED, RENAMED, DELETED or in CONFLICT
+// since fetching the dataset from the Pennsieve server (compare to the manifest.json file).
+// The fingerprinter determines how file contents are compared to match moved/renamed and changed files.
+func compareManifestToFolder(datasetRoot string, manifest []models.ManifestDTO, files []folderFile, hasher *fingerprinter) ([]diffResult, error) {
 
 	var addedFiles []addedFile
 	var deletedFiles []deletedFile
 	var changedFiles []changedFile
+	var conflictFiles []changedFile
 
 	// Just in case the input is not operating system correct.
 	datasetRoot = filepath.FromSlash(datasetRoot)
@@ -287,20 +332,39 @@ FindAdded:
 						if s.Path == fPath && s.IsLocal {
 
 							// File is different size at the same location and file is local
-							crc32, err := shared.GetFileCrc32(fPathFull, CrcSize)
+							fingerprint, err := hasher.fingerprint(datasetRoot, fPath)
 							if err != nil {
 								log.Error(err)
-								log.Error("Cannot get crc32 for changed file: ", fPath)
+								log.Error("Cannot get fingerprint for changed file: ", fPath)
 								continue
 							}
 
+							changed := changedFile{
+								Size:        fi.Size(),
+								Fingerprint: fingerprint,
+								from:        m,
+							}
+
+							// If the remote file changed since the file was pulled, we need to check
+							// whether the local file changed as well. If both changed, the file is in
+							// conflict. If only the remote file changed, the local copy is outdated
+							// but does not contain local changes.
+							if remoteChangedSincePull(s, m) {
+								same, err := hasher.matchesPulled(datasetRoot, fPath, s)
+								if err != nil {
+									log.Error("Cannot get fingerprint for changed file: ", fPath)
+									continue
+								}
+								if !same {
+									log.Warn("FIND CONFLICTED FILE")
+									conflictFiles = append(conflictFiles, changed)
+								}
+								continue FindAdded
+							}
+
 							log.Warn("FIND CHANGED FILE")
 
-							changedFiles = append(changedFiles, changedFile{
-								Size:  fi.Size(),
-								Crc32: crc32,
-								from:  m,
-							})
+							changedFiles = append(changedFiles, changed)
 							continue FindAdded
 						}
 					}
@@ -312,21 +376,21 @@ FindAdded:
 					// FileID, we know that this is an added file that has replaced the expected file with
 					// that name.
 
-					crcOrFileInfo, err := getFileIdOrCrc32(fPathFull, CrcSize)
+					fileInfo, err := getFileIdOrFingerprint(datasetRoot, fPath, hasher)
 					if err != nil {
 						log.Info("Cannot find file ", err)
 						return nil, err
 					}
 
-					if crcOrFileInfo.FileId != m.FileNodeId.String {
+					if fileInfo.FileId != m.FileNodeId.String {
 
 						addedF := addedFile{
-							FileName:  f.FileName,
-							Path:      f.Path,
-							Size:      f.Size,
-							hasFileId: crcOrFileInfo.hasFileId,
-							Crc32:     crcOrFileInfo.Crc32,
-							FileId:    crcOrFileInfo.FileId,
+							FileName:    f.FileName,
+							Path:        f.Path,
+							Size:        f.Size,
+							hasFileId:   fileInfo.hasFileId,
+							Fingerprint: fileInfo.Fingerprint,
+							FileId:      fileInfo.FileId,
 						}
 
 						addedFiles = append(addedFiles, addedF)
@@ -339,21 +403,27 @@ FindAdded:
 
 		}
 
-		// Getting CRC32 for each added file. This will be used to figure out
+		// Fetch keeps the local content of packages that were deleted or moved on Pennsieve.
+		// These files are not in the manifest, but they are not new files either.
+		if isTombstone(fPath, *datasetState) {
+			continue
+		}
+
+		// Getting fingerprint for each added file. This will be used to figure out
 		// if the file was moved, renamed or truly was added to the dataset.
-		// The CRC32 could be of the "empty" file, so we need to check later,
-		crcOrFileInfo, err := getFileIdOrCrc32(fPathFull, CrcSize)
+		// The fingerprint could be of the "empty" file, so we need to check later,
+		fileInfo, err := getFileIdOrFingerprint(datasetRoot, fPath, hasher)
 		if err != nil {
 			return nil, err
 		}
 
 		addedF := addedFile{
-			FileName:  f.FileName,
-			Path:      f.Path,
-			Size:      f.Size,
-			hasFileId: crcOrFileInfo.hasFileId,
-			Crc32:     crcOrFileInfo.Crc32,
-			FileId:    crcOrFileInfo.FileId,
+			FileName:    f.FileName,
+			Path:        f.Path,
+			Size:        f.Size,
+			hasFileId:   fileInfo.hasFileId,
+			Fingerprint: fileInfo.Fingerprint,
+			FileId:      fileInfo.FileId,
 		}
 
 		addedFiles = append(addedFiles, addedF)
@@ -509,17 +579,19 @@ FindMovedRenamed:
 
 					if err != nil {
 						// Likely the file is actually downloaded and moved, which is why
-						// it is not found in the state file. Need to check the CRC against the
+						// it is not found in the state file. Need to check the fingerprint against the
 						// pulled files.
 
-						crc, err := shared.GetFileCrc32(path.Join(datasetRoot, fAdded.Path, fAdded.FileName), CrcSize)
-						if err != nil {
-							log.Error("Unable to read ID from file", fAdded.Path)
-							continue FindMovedRenamed
-						}
-
 						for _, m := range datasetState.Files {
-							if m.Crc32 == crc && fDeleted.Path == m.Path {
+							if fDeleted.Path != m.Path {
+								continue
+							}
+							same, err := hasher.matchesPulled(datasetRoot, path.Join(fAdded.Path, fAdded.FileName), m)
+							if err != nil {
+								log.Error("Unable to read ID from file", fAdded.Path)
+								continue FindMovedRenamed
+							}
+							if same {
 								deletedFiles[deletedIndex].hasMatched = true
 								movedFiles = append(movedFiles, renamedMovedFile{
 									Type: api.PackageStatus_MOVED,
@@ -557,7 +629,7 @@ FindMovedRenamed:
 				log.Warn("POTENTIAL RENAME/MOVE combo: ", fAdded.Path, "/", fAdded.FileName)
 
 				// If the added file has a fileId (not local), then compare fileId to deleted entries
-				// If the added file has a crc32 (local), then compare to state to get packageID and
+				// If the added file has a fingerprint (local), then compare to state to get packageID and
 				// then deleted based on package ID.
 				if fAdded.hasFileId {
 					if fAdded.FileId == fDeleted.FileId {
@@ -572,7 +644,15 @@ FindMovedRenamed:
 					}
 				} else {
 					for _, m := range datasetState.Files {
-						if m.Crc32 == fAdded.Crc32 && fDeleted.FileId == m.FileId {
+						if fAdded.Fingerprint == "" || fDeleted.FileId != m.FileId {
+							continue
+						}
+						same, err := hasher.matchesPulled(datasetRoot, path.Join(fAdded.Path, fAdded.FileName), m)
+						if err != nil {
+							log.Error("Cannot get fingerprint for added file: ", fAdded.Path)
+							continue
+						}
+						if same {
 							deletedFiles[deletedIndex].hasMatched = true
 							movedFiles = append(movedFiles, renamedMovedFile{
 								Type: api.PackageStatus_MOVED_RENAMED,
@@ -663,9 +743,32 @@ MergeStep1:
 		difResults = append(difResults, r)
 	}
 
+	// FIND CONFLICT
+	for _, cFile := range conflictFiles {
+
+		r := diffResult{
+			FilePath: path.Join(cFile.from.Path, cFile.from.FileName.String),
+			Type:     api.PackageStatus_CONFLICT,
+			Changed:  cFile,
+		}
+
+		difResults = append(difResults, r)
+	}
+
 	return difResults, nil
 }
 
+// remoteChangedSincePull returns true if the remote file in the workspace manifest is different from the
+// remote file at the time the file was pulled. The checksum is used when available, and the size otherwise.
+func remoteChangedSincePull(record models2.MapStateRecord, m models.ManifestDTO) bool {
+	if record.CheckSum != "" && m.CheckSum.Valid && m.CheckSum.String != "" {
+		return record.CheckSum != m.CheckSum.String
+	}
+
+	// Files that were pulled before the remote size was recorded cannot be compared.
+	return record.Size != 0 && m.Size.Valid && record.Size != m.Size.Int64
+}
+
 func fileIsLocalAndNotMoved(filePath string, state models2.MapState) bool {
 
 	log.Debug("FILEISLOCALANDNOTMOVED: ", filePath)
@@ -679,3 +782,15 @@ func fileIsLocalAndNotMoved(filePath string, state models2.MapState) bool {
 
 	return false
 }
+
+// isTombstone returns true if the file is the kept local content of a package that was deleted or
+// moved on Pennsieve.
+func isTombstone(filePath string, state models2.MapState) bool {
+	for _, t := range state.Tombstones {
+		if t.Path == filePath {
+			return true
+		}
+	}
+
+	return false
+}
@@ -3,9 +3,12 @@ package server
 import (
 	"database/sql"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
 	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
 	"path"
 	"path/filepath"
 	"testing"
@@ -22,7 +25,7 @@ func TestCompareManifest(t *testing.T) {
 	manifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
 	assert.NoError(t, err)
 
-	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files)
+	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files, newFingerprinter(api.MapDiffRequest_FAST, nil))
 	assert.NoError(t, err)
 
 	assert.Len(t, result, 9, "Expect 9 results to have changes compared to original manifest.")
@@ -84,3 +87,63 @@ func TestCompareManifest(t *testing.T) {
 	assert.Equal(t, 9, count, "Expect each case statement in switch to be called once.")
 
 }
+
+// writeConflictDataset creates a mapped dataset with a single pulled file whose remote checksum
+// is different from the checksum at the time of the pull.
+func writeConflictDataset(t *testing.T, localContent string) (string, models2.MapStateRecord) {
+	datasetRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, ".pennsieve"), 0755))
+
+	pulledContent := "pulled content"
+	location := filepath.Join(datasetRoot, "file_1.txt")
+	require.NoError(t, os.WriteFile(location, []byte(pulledContent), 0644))
+	pulledCrc, err := shared.GetFileCrc32(location, CrcSize)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(location, []byte(localContent), 0644))
+
+	remote := manifestEntry("N:package:1", "11111111-1111-1111-1111-111111111111", "", "file_1.txt")
+	remote.Size = models.NullInt{NullInt64: sql.NullInt64{Int64: 40, Valid: true}}
+	remote.CheckSum = models.NullString{NullString: sql.NullString{String: "remote-checksum-2", Valid: true}}
+	require.NoError(t, shared.WriteWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"),
+		&models.WorkspaceManifest{DatasetNodeId: "N:dataset:1", Files: []models.ManifestDTO{remote}}))
+
+	record := models2.MapStateRecord{
+		FileId:   "11111111-1111-1111-1111-111111111111",
+		Path:     "file_1.txt",
+		IsLocal:  true,
+		Crc32:    pulledCrc,
+		Size:     int64(len(pulledContent)),
+		CheckSum: "remote-checksum-1",
+	}
+	require.NoError(t, shared.WriteStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"),
+		&models2.MapState{Files: []models2.MapStateRecord{record}}))
+
+	return datasetRoot, record
+}
+
+func TestCompareManifestConflict(t *testing.T) {
+
+	// Local and remote file changed since pull
+	datasetRoot, _ := writeConflictDataset(t, "locally changed content")
+	files, err := createFolderManifest(datasetRoot)
+	require.NoError(t, err)
+	manifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	require.NoError(t, err)
+
+	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files, newFingerprinter(api.MapDiffRequest_FAST, nil))
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, api.PackageStatus_CONFLICT, result[0].Type)
+	assert.Equal(t, "file_1.txt", result[0].FilePath)
+
+	// Only the remote file changed since pull; the local file is outdated but unchanged
+	datasetRoot, _ = writeConflictDataset(t, "pulled content")
+	files, err = createFolderManifest(datasetRoot)
+	require.NoError(t, err)
+	manifest, err = shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	require.NoError(t, err)
+
+	result, err = compareManifestToFolder(datasetRoot, manifest.Files, files, newFingerprinter(api.MapDiffRequest_FAST, nil))
+	require.NoError(t, err)
+	assert.Len(t, result, 0, "Expect no local changes when only the remote file changed.")
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models2 "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	log "github.com/sirupsen/logrus"
+)
+
+// Evict replaces pulled files in a mapped dataset with placeholders to free up local disk space.
+//
+// Only files whose content is unchanged since they were pulled are evicted. Files that were
+// modified locally, or that are no longer part of the dataset on Pennsieve, are left in place.
+func (s *agentServer) Evict(ctx context.Context, req *api.EvictRequest) (*api.EvictResponse, error) {
+
+	// Check if the provided path is part of a mapped dataset
+	datasetRoot, found, err := findMappedDatasetRoot(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &api.EvictResponse{Status: "The provided path is not part of a Pennsieve mapped dataset."}, nil
+	}
+
+	workspaceManifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	defer shared.LockStateFile(stateFileLocation)()
+
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	relTarget, err := filepath.Rel(datasetRoot, filepath.FromSlash(req.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	files, freed := evictFiles(datasetRoot, filepath.ToSlash(relTarget), workspaceManifest.Files, mapState)
+
+	err = shared.WriteStateFile(stateFileLocation, mapState)
+	if err != nil {
+		return nil, err
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Evicted %d file(s) from %s: freed %d bytes", countEvicted(files), datasetRoot, freed))
+
+	return &api.EvictResponse{
+		Status:     "Success",
+		FreedBytes: freed,
+		Files:      files,
+	}, nil
+}
+
+// evictFiles replaces the pulled files at, or within, the relative target path with placeholders and
+// updates the map state accordingly. It returns the result for each pulled file and the number of bytes freed.
+func evictFiles(datasetRoot string, relTarget string, manifest []models2.ManifestDTO, mapState *models.MapState) ([]*api.EvictResponse_EvictedFile, int64) {
+
+	remoteFiles := make(map[string]models2.ManifestDTO)
+	for _, f := range manifest {
+		if f.FileName.Valid {
+			remoteFiles[path.Join(f.Path, f.PackageName)] = f
+		}
+	}
+
+	// Prefer the full-content hash if it was recorded during the pull; records without it fall back to CRC32.
+	hasher := newFingerprinter(api.MapDiffRequest_FULL, mapState.Hashes)
+
+	var results []*api.EvictResponse_EvictedFile
+	var freed int64
+	for i, record := range mapState.Files {
+		if !record.IsLocal || !isWithinPath(record.Path, relTarget) {
+			continue
+		}
+
+		location := filepath.Join(datasetRoot, filepath.FromSlash(record.Path))
+		result := &api.EvictResponse_EvictedFile{Path: record.Path}
+		results = append(results, result)
+
+		info, err := os.Stat(location)
+		if err != nil {
+			result.Message = "File not found; not evicted."
+			continue
+		}
+		result.Size = info.Size()
+
+		remote, ok := remoteFiles[record.Path]
+		if !ok {
+			result.Message = "File is not part of the dataset on Pennsieve; not evicted."
+			continue
+		}
+
+		same, err := hasher.matchesPulled(datasetRoot, record.Path, record)
+		if err != nil {
+			log.Errorf("Cannot get fingerprint for %s: %v", record.Path, err)
+			result.Message = fmt.Sprintf("Unable to read file: %v", err)
+			continue
+		}
+		if (record.Size > 0 && info.Size() != record.Size) || !same {
+			result.Message = "Locally modified; not evicted."
+			continue
+		}
+
+		fileId := remote.FileNodeId.String
+		if err := os.WriteFile(location, []byte(fileId), 0644); err != nil {
+			log.Errorf("Failed to evict %s: %v", record.Path, err)
+			result.Message = fmt.Sprintf("Failed to create placeholder: %v", err)
+			continue
+		}
+
+		mapState.Files[i].IsLocal = false
+		removeMapStateHash(mapState, record.Path)
+
+		result.Evicted = true
+		result.Message = "Evicted."
+		freed += info.Size() - int64(len(fileId))
+	}
+
+	return results, freed
+}
+
+// isWithinPath returns true if the relative path equals, or is located in, the relative target path.
+func isWithinPath(relPath string, relTarget string) bool {
+	if relTarget == "." || relTarget == "" {
+		return true
+	}
+
+	return relPath == relTarget || strings.HasPrefix(relPath, relTarget+"/")
+}
+
+func countEvicted(files []*api.EvictResponse_EvictedFile) int {
+	count := 0
+	for _, f := range files {
+		if f.Evicted {
+			count++
+		}
+	}
+
+	return count
+}
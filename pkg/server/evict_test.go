@@ -0,0 +1,89 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictFiles(t *testing.T) {
+	datasetRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, "sub-01"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, "sub-02"), 0755))
+
+	unchangedId := "11111111-1111-1111-1111-111111111111"
+	modifiedId := "22222222-2222-2222-2222-222222222222"
+	otherId := "33333333-3333-3333-3333-333333333333"
+
+	pulledContent := "content of a file that was pulled from Pennsieve"
+	var records []models2.MapStateRecord
+	for _, f := range []struct {
+		id      string
+		relPath string
+	}{
+		{unchangedId, "sub-01/unchanged.txt"},
+		{modifiedId, "sub-01/modified.txt"},
+		{otherId, "sub-02/other.txt"},
+	} {
+		location := filepath.Join(datasetRoot, filepath.FromSlash(f.relPath))
+		require.NoError(t, os.WriteFile(location, []byte(pulledContent), 0644))
+		crc, err := shared.GetFileCrc32(location, CrcSize)
+		require.NoError(t, err)
+		sha, err := shared.GetFileSha256(location)
+		require.NoError(t, err)
+
+		records = append(records, models2.MapStateRecord{
+			FileId:  f.id,
+			Path:    f.relPath,
+			IsLocal: true,
+			Crc32:   crc,
+			Sha256:  sha,
+			Size:    int64(len(pulledContent)),
+		})
+	}
+
+	// Same size, so only the content hash reveals the local modification.
+	modifiedContent := "CONTENT of a file that was pulled from Pennsieve"
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "sub-01", "modified.txt"), []byte(modifiedContent), 0644))
+
+	manifest := []models.ManifestDTO{
+		manifestEntry("N:package:1", unchangedId, "sub-01", "unchanged.txt"),
+		manifestEntry("N:package:2", modifiedId, "sub-01", "modified.txt"),
+		manifestEntry("N:package:3", otherId, "sub-02", "other.txt"),
+	}
+	state := &models2.MapState{Files: records}
+
+	result, freed := evictFiles(datasetRoot, "sub-01", manifest, state)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(len(pulledContent)-len(unchangedId)), freed)
+
+	byPath := make(map[string]*api.EvictResponse_EvictedFile)
+	for _, r := range result {
+		byPath[r.Path] = r
+	}
+
+	// Unchanged file is replaced by a placeholder
+	assert.True(t, byPath["sub-01/unchanged.txt"].Evicted)
+	content, err := os.ReadFile(filepath.Join(datasetRoot, "sub-01", "unchanged.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, unchangedId, string(content))
+	assert.False(t, state.Files[0].IsLocal)
+
+	// Modified file is never evicted
+	assert.False(t, byPath["sub-01/modified.txt"].Evicted)
+	assert.Equal(t, "Locally modified; not evicted.", byPath["sub-01/modified.txt"].Message)
+	content, err = os.ReadFile(filepath.Join(datasetRoot, "sub-01", "modified.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, modifiedContent, string(content))
+	assert.True(t, state.Files[1].IsLocal)
+
+	// Files outside the target path are untouched
+	assert.True(t, state.Files[2].IsLocal)
+}
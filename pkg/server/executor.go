@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/gateway"
+	"github.com/pennsieve/pennsieve-agent/v2/workflow"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the workflow executors.
+const (
+	localExecutor     = "local"
+	nextflowExecutor  = "nextflow"
+	podmanExecutor    = "podman"
+	apptainerExecutor = "apptainer"
+)
+
+// Container engines that Nextflow can use to run the processes of a workflow.
+var nextflowContainerEngines = []string{"docker", "podman", "apptainer"}
+
+// WorkflowMount maps a folder on this machine to a folder inside the containers of a workflow.
+type WorkflowMount struct {
+	Source string `json:"Source"`
+	Target string `json:"Target"`
+}
+
+// workflowExecutor runs a workflow for a work order.
+//
+// Prepare is called once the input of the run is written and the manifest roots are known. It sets the
+// mounts of the work order and writes executor specific configuration to the job folder. Command returns
+// the command that runs the workflow; the command runs in the job folder.
+type workflowExecutor interface {
+	Prepare(workOrder *WorkOrder) error
+	Command(ctx context.Context, workOrder WorkOrder) *exec.Cmd
+}
+
+// newWorkflowExecutor returns the executor with the given name.
+func newWorkflowExecutor(name string, containerEngine string, image string) (workflowExecutor, error) {
+	switch name {
+	case localExecutor:
+		return localWorkflowExecutor{}, nil
+	case nextflowExecutor:
+		if containerEngine == "" {
+			containerEngine = "docker"
+		}
+		if !slices.Contains(nextflowContainerEngines, containerEngine) {
+			return nil, fmt.Errorf("unsupported container engine %q for nextflow; use one of %s",
+				containerEngine, strings.Join(nextflowContainerEngines, ", "))
+		}
+		return nextflowWorkflowExecutor{engine: containerEngine}, nil
+	case podmanExecutor, apptainerExecutor:
+		if image == "" {
+			return nil, fmt.Errorf("the %s executor requires a container image", name)
+		}
+		return containerWorkflowExecutor{runtime: name, image: image}, nil
+	default:
+		return nil, fmt.Errorf("unknown workflow executor %q; use one of %s, %s, %s or %s",
+			name, localExecutor, nextflowExecutor, podmanExecutor, apptainerExecutor)
+	}
+}
+
+// checkLocalWorkflow returns an error unless the workflow may run with the local executor, which runs it
+// without a container. The local executor must be enabled with agent.workflow_allow_local and is never
+// available to requests that are relayed by the HTTP gateway. It only runs registered workflows, and
+// workflow files that are listed in agent.workflow_local_paths or are in a listed folder.
+func checkLocalWorkflow(ctx context.Context, workflowPath string, registered bool) error {
+	if !viper.GetBool("agent.workflow_allow_local") {
+		return status.Errorf(codes.PermissionDenied,
+			"the %s executor is disabled; set agent.workflow_allow_local to enable it", localExecutor)
+	}
+	if gateway.FromGateway(ctx) {
+		return status.Errorf(codes.PermissionDenied, "the %s executor is not available through the HTTP gateway", localExecutor)
+	}
+	if registered {
+		return nil
+	}
+
+	resolved, err := filepath.EvalSymlinks(workflowPath)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid workflow path %q: %v", workflowPath, err)
+	}
+	for _, allowed := range viper.GetStringSlice("agent.workflow_local_paths") {
+		if !filepath.IsAbs(allowed) {
+			continue
+		}
+		allowed, err := filepath.EvalSymlinks(allowed)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(allowed, resolved)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied,
+		"workflow %s is not registered or in a folder of agent.workflow_local_paths", workflowPath)
+}
+
+// localWorkflowExecutor runs the workflow as a command on this machine. The command receives the
+// input file as its argument and finds the folders of the run in its environment.
+type localWorkflowExecutor struct{}
+
+func (e localWorkflowExecutor) Prepare(workOrder *WorkOrder) error {
+	workOrder.Mounts = identityMounts(workOrder.ManifestRoots)
+	return nil
+}
+
+func (e localWorkflowExecutor) Command(ctx context.Context, workOrder WorkOrder) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, workOrder.Input, workOrder.Files)
+	cmd.Env = append(os.Environ(), workflowEnvironment(workOrder, workOrder.FilePath)...)
+	return cmd
+}
+
+// nextflowWorkflowExecutor runs the workflow with Nextflow, wrapped in the pre- and postprocessing steps
+// of the agent. The processes of the workflow run in containers of the container engine. The manifest roots
+// are mounted at /data, or at /data/0, /data/1, ... if the manifest has multiple roots, and the job folder
+// is mounted at /job.
+type nextflowWorkflowExecutor struct {
+	engine string
+}
+
+func (e nextflowWorkflowExecutor) Prepare(workOrder *WorkOrder) error {
+	workOrder.Mounts = dataMounts(workOrder.ManifestRoots)
+
+	if err := writeWorkflowFiles(filepath.Join(workOrder.FilePath, "workflow")); err != nil {
+		return fmt.Errorf("unable to write workflow files: %w", err)
+	}
+
+	workOrder.NextflowConfigFile = filepath.Join(workOrder.FilePath, "nextflow.config")
+	if err := os.WriteFile(workOrder.NextflowConfigFile, []byte(e.config(*workOrder)), 0644); err != nil {
+		return fmt.Errorf("unable to write nextflow config: %w", err)
+	}
+	return nil
+}
+
+func (e nextflowWorkflowExecutor) Command(ctx context.Context, workOrder WorkOrder) *exec.Cmd {
+	args := []string{filepath.Join(workOrder.FilePath, "workflow", workflow.Main),
+		"--workflowJobId", workOrder.ProcessID.String(), "--userJob", workOrder.Input, "-c", workOrder.NextflowConfigFile}
+	if workOrder.ParamsFile != "" {
+		args = append(args, "-params-file", workOrder.ParamsFile)
+	}
+	return exec.CommandContext(ctx, "nextflow", args...)
+}
+
+// config returns the Nextflow configuration that enables the container engine and mounts the folders of the run.
+func (e nextflowWorkflowExecutor) config(workOrder WorkOrder) string {
+	mounts := append(slices.Clone(workOrder.Mounts), WorkflowMount{Source: workOrder.FilePath, Target: "/job"})
+
+	var options []string
+	switch e.engine {
+	case "apptainer":
+		for _, m := range mounts {
+			options = append(options, "--bind "+strconv.Quote(m.Source+":"+m.Target))
+		}
+	default:
+		options = append(options, "--platform linux/amd64 --rm")
+		for _, m := range mounts {
+			options = append(options, "-v "+strconv.Quote(m.Source+":"+m.Target))
+		}
+	}
+
+	config := "process.failFast = true\n" +
+		"process.containerOptions = " + groovyString(strings.Join(options, " ")) + "\n" +
+		e.engine + " {\n" +
+		"    enabled = true\n"
+	if e.engine == "apptainer" {
+		config += "    autoMounts = true\n"
+	}
+	return config + "}\n"
+}
+
+// containerWorkflowExecutor runs the workflow inside a container of the image with Podman or Apptainer.
+// The manifest roots are mounted at the same path inside the container, so the source paths in the
+// input file can be used as is, and the job folder is mounted at /job.
+type containerWorkflowExecutor struct {
+	runtime string
+	image   string
+}
+
+func (e containerWorkflowExecutor) Prepare(workOrder *WorkOrder) error {
+	workOrder.Mounts = identityMounts(workOrder.ManifestRoots)
+	return nil
+}
+
+func (e containerWorkflowExecutor) Command(ctx context.Context, workOrder WorkOrder) *exec.Cmd {
+	script := "/workflow/" + filepath.Base(workOrder.Input)
+	mounts := append(slices.Clone(workOrder.Mounts),
+		WorkflowMount{Source: workOrder.FilePath, Target: "/job"},
+		WorkflowMount{Source: workOrder.Input, Target: script},
+	)
+
+	var args []string
+	switch e.runtime {
+	case apptainerExecutor:
+		args = []string{"exec", "--containall", "--pwd", "/job"}
+		for _, m := range mounts {
+			args = append(args, "--bind", m.Source+":"+m.Target)
+		}
+		for _, env := range workflowEnvironment(workOrder, "/job") {
+			args = append(args, "--env", env)
+		}
+	default:
+		args = []string{"run", "--rm", "-w", "/job"}
+		for _, m := range mounts {
+			args = append(args, "-v", m.Source+":"+m.Target)
+		}
+		for _, env := range workflowEnvironment(workOrder, "/job") {
+			args = append(args, "-e", env)
+		}
+	}
+	args = append(args, e.image, script, "/job/workflow/input.csv")
+
+	return exec.CommandContext(ctx, e.runtime, args...)
+}
+
+// workflowEnvironment returns the environment variables that describe a run to the workflow. The job
+// folder is the location of the job folder as seen by the workflow.
+func workflowEnvironment(workOrder WorkOrder, jobFolder string) []string {
+	env := []string{
+		"PENNSIEVE_WORKFLOW_RUN_ID=" + workOrder.ProcessID.String(),
+		"PENNSIEVE_WORKFLOW_MANIFEST_ID=" + strconv.Itoa(int(workOrder.ManifestID)),
+		"PENNSIEVE_WORKFLOW_JOB_DIR=" + jobFolder,
+		"PENNSIEVE_WORKFLOW_INPUT=" + filepath.Join(jobFolder, "workflow", "input.csv"),
+		"PENNSIEVE_WORKFLOW_DERIVATIVES=" + filepath.Join(jobFolder, ".derivatives"),
+	}
+	if workOrder.ParamsFile != "" {
+		env = append(env, "PENNSIEVE_WORKFLOW_PARAMS="+filepath.Join(jobFolder, "workflow", "params.json"))
+	}
+	return env
+}
+
+// identityMounts mounts each root at the same path.
+func identityMounts(roots []string) []WorkflowMount {
+	mounts := make([]WorkflowMount, 0, len(roots))
+	for _, root := range sortedCopy(roots) {
+		mounts = append(mounts, WorkflowMount{Source: root, Target: root})
+	}
+	return mounts
+}
+
+// dataMounts mounts a single root at /data, and multiple roots at /data/0, /data/1, ... in sorted order.
+func dataMounts(roots []string) []WorkflowMount {
+	sorted := sortedCopy(roots)
+	if len(sorted) == 1 {
+		return []WorkflowMount{{Source: sorted[0], Target: "/data"}}
+	}
+
+	mounts := make([]WorkflowMount, 0, len(sorted))
+	for i, root := range sorted {
+		mounts = append(mounts, WorkflowMount{Source: root, Target: "/data/" + strconv.Itoa(i)})
+	}
+	return mounts
+}
+
+func sortedCopy(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+// groovyString returns the value as a single quoted Groovy string.
+func groovyString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	guuid "github.com/google/uuid"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/gateway"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewWorkflowExecutor(t *testing.T) {
+	executor, err := newWorkflowExecutor(nextflowExecutor, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, nextflowWorkflowExecutor{engine: "docker"}, executor)
+
+	executor, err = newWorkflowExecutor(apptainerExecutor, "", "docker://alpine")
+	require.NoError(t, err)
+	assert.Equal(t, containerWorkflowExecutor{runtime: apptainerExecutor, image: "docker://alpine"}, executor)
+
+	_, err = newWorkflowExecutor(podmanExecutor, "", "")
+	assert.Error(t, err, "Expect container executors to require an image.")
+
+	_, err = newWorkflowExecutor(nextflowExecutor, "kubernetes", "")
+	assert.Error(t, err)
+
+	_, err = newWorkflowExecutor("slurm", "", "")
+	assert.Error(t, err)
+}
+
+func TestNextflowConfigMountsAllRoots(t *testing.T) {
+	workOrder := WorkOrder{
+		FilePath:      "/home/user/.pennsieve/.jobs/1",
+		ManifestRoots: []string{"/Volumes/mounted/data", "/Users/user/My Data"},
+	}
+	workOrder.Mounts = dataMounts(workOrder.ManifestRoots)
+
+	assert.Equal(t, []WorkflowMount{
+		{Source: "/Users/user/My Data", Target: "/data/0"},
+		{Source: "/Volumes/mounted/data", Target: "/data/1"},
+	}, workOrder.Mounts)
+
+	config := nextflowWorkflowExecutor{engine: "podman"}.config(workOrder)
+	assert.Contains(t, config, `-v "/Users/user/My Data:/data/0"`)
+	assert.Contains(t, config, `-v "/Volumes/mounted/data:/data/1"`)
+	assert.Contains(t, config, `-v "/home/user/.pennsieve/.jobs/1:/job"`)
+	assert.Contains(t, config, "podman {\n    enabled = true\n}")
+
+	config = nextflowWorkflowExecutor{engine: "apptainer"}.config(workOrder)
+	assert.Contains(t, config, `--bind "/Users/user/My Data:/data/0"`)
+	assert.NotContains(t, config, "--platform")
+
+	// A single root keeps its mount at /data
+	assert.Equal(t, []WorkflowMount{{Source: "/data", Target: "/data"}}, dataMounts([]string{"/data"}))
+}
+
+func TestContainerWorkflowCommand(t *testing.T) {
+	workOrder := WorkOrder{
+		ProcessID: guuid.New(),
+		FilePath:  "/jobs/1",
+		Input:     "/workflows/pipeline.sh",
+		Mounts:    identityMounts([]string{"/data/b", "/data/a"}),
+	}
+
+	cmd := containerWorkflowExecutor{runtime: podmanExecutor, image: "alpine"}.Command(context.Background(), workOrder)
+	args := strings.Join(cmd.Args, " ")
+	assert.Equal(t, "podman", cmd.Args[0])
+	assert.Contains(t, args, "-v /data/a:/data/a -v /data/b:/data/b -v /jobs/1:/job -v /workflows/pipeline.sh:/workflow/pipeline.sh")
+	assert.Contains(t, args, "-e PENNSIEVE_WORKFLOW_INPUT=/job/workflow/input.csv")
+	assert.True(t, strings.HasSuffix(args, "alpine /workflow/pipeline.sh /job/workflow/input.csv"))
+
+	cmd = containerWorkflowExecutor{runtime: apptainerExecutor, image: "alpine.sif"}.Command(context.Background(), workOrder)
+	args = strings.Join(cmd.Args, " ")
+	assert.Equal(t, "apptainer", cmd.Args[0])
+	assert.Contains(t, args, "--bind /data/a:/data/a --bind /data/b:/data/b")
+}
+
+func TestLocalWorkflowExecutor(t *testing.T) {
+	s := newWorkflowTestServer(t, 3, "")
+	s.workflowCommandOverride = nil
+
+	workflowFolder := t.TempDir()
+	workflowPath := filepath.Join(workflowFolder, "pipeline.sh")
+	script := "#!/bin/sh\nwc -l < \"$1\" > \"$PENNSIEVE_WORKFLOW_DERIVATIVES/count.txt\"\necho \"$PENNSIEVE_WORKFLOW_RUN_ID\"\n"
+	require.NoError(t, os.WriteFile(workflowPath, []byte(script), 0755))
+
+	viper.Set("agent.workflow_allow_local", true)
+	viper.Set("agent.workflow_local_paths", []string{workflowFolder})
+	defer viper.Set("agent.workflow_allow_local", false)
+	defer viper.Set("agent.workflow_local_paths", []string{})
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{
+		ManifestId:   1,
+		WorkflowFlag: workflowPath,
+		Executor:     localExecutor,
+	})
+	require.NoError(t, err)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	require.Equal(t, api.WorkflowRun_SUCCEEDED, run.Status, run.Error)
+
+	count, err := os.ReadFile(filepath.Join(run.JobDir, ".derivatives", "count.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "4", strings.TrimSpace(string(count)))
+	assert.NoFileExists(t, filepath.Join(run.JobDir, "nextflow.config"))
+
+	status, err := s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: run.Id, LogLines: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{run.Id}, status.Log)
+
+	_, err = s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: workflowPath, Executor: "slurm"})
+	assert.Error(t, err)
+}
+
+func TestCheckLocalWorkflow(t *testing.T) {
+	workflowFolder := t.TempDir()
+	workflowPath := filepath.Join(workflowFolder, "pipeline.sh")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("#!/bin/sh\n"), 0755))
+	otherPath := filepath.Join(t.TempDir(), "other.sh")
+	require.NoError(t, os.WriteFile(otherPath, []byte("#!/bin/sh\n"), 0755))
+
+	defer viper.Set("agent.workflow_allow_local", false)
+	defer viper.Set("agent.workflow_local_paths", []string{})
+	ctx := context.Background()
+
+	// The local executor is disabled by default
+	err := checkLocalWorkflow(ctx, workflowPath, true)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	viper.Set("agent.workflow_allow_local", true)
+	viper.Set("agent.workflow_local_paths", []string{workflowFolder, "relative"})
+	assert.NoError(t, checkLocalWorkflow(ctx, workflowPath, false))
+	assert.NoError(t, checkLocalWorkflow(ctx, otherPath, true), "Expect registered workflows to be allowed.")
+	assert.Error(t, checkLocalWorkflow(ctx, otherPath, false))
+
+	// Symbolic links cannot be used to leave the allowed folders
+	link := filepath.Join(workflowFolder, "link.sh")
+	require.NoError(t, os.Symlink(otherPath, link))
+	assert.Error(t, checkLocalWorkflow(ctx, link, false))
+
+	// Requests through the HTTP gateway cannot use the local executor
+	gatewayCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(gateway.MetadataKey, "1"))
+	err = checkLocalWorkflow(gatewayCtx, workflowPath, true)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRootDirectoriesWithSimilarNames(t *testing.T) {
+	roots := rootDirectories([]string{"/data/sub-10", "/data/sub-1", "/data/sub-1/anat", "/data-b/x", "/data-b/x", "/data-b"})
+	assert.Equal(t, []string{"/data-b", "/data/sub-1", "/data/sub-10"}, roots)
+}
@@ -0,0 +1,394 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	log "github.com/sirupsen/logrus"
+)
+
+// remoteChange represents a difference between the workspace manifest that was
+// used to map the dataset and the latest workspace manifest on Pennsieve.
+type remoteChange struct {
+	Type api.PackageStatus_StatusType
+	Old  models.ManifestDTO
+	New  models.ManifestDTO
+}
+
+// Fetch refreshes a mapped dataset with the latest state of the dataset on Pennsieve.
+//
+// Placeholders are created for packages that were added on the server, and placeholders for
+// packages that were moved or deleted on the server are moved or removed. Files that have been
+// pulled are never modified; remote changes to those files are returned so the user can act on them.
+// Pulled files of packages that were deleted or moved are recorded as tombstones in the state, so
+// they are not reported as added by diff and not pushed again.
+func (s *agentServer) Fetch(ctx context.Context, req *api.FetchRequest) (*api.FetchResponse, error) {
+
+	// Check if the provided path is part of a mapped dataset
+	datasetRoot, found, err := findMappedDatasetRoot(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &api.FetchResponse{Status: "The provided path is not part of a Pennsieve mapped dataset."}, nil
+	}
+
+	localManifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.PennsieveClient()
+	if err != nil {
+		return nil, err
+	}
+
+	manifestResponse, err := client.Dataset.GetManifest(ctx, localManifest.DatasetNodeId)
+	if err != nil {
+		log.Errorf("Fetch failed: %v", err)
+		return nil, err
+	}
+
+	// Download the remote manifest next to the current manifest so the mapped dataset
+	// remains untouched if the download or parsing fails.
+	remoteManifestLocation := filepath.Join(datasetRoot, ".pennsieve", "manifest_remote.json")
+	defer os.Remove(remoteManifestLocation)
+
+	downloadImpl := shared.NewDownloader(s, client)
+	_, err = downloadImpl.DownloadFileFromPresignedUrl(ctx, manifestResponse.URL, remoteManifestLocation, uuid.New().String())
+	if err != nil {
+		log.Errorf("Download failed: %v", err)
+		return nil, err
+	}
+
+	remoteManifest, err := shared.ReadWorkspaceManifest(remoteManifestLocation)
+	if err != nil {
+		log.Errorf("Failed to read manifest: %v", err)
+		return nil, err
+	}
+
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	defer shared.LockStateFile(stateFileLocation)()
+
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := applyRemoteManifest(datasetRoot, localManifest, remoteManifest, mapState)
+	if err != nil {
+		return nil, err
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Fetched dataset %s: %d remote change(s)", remoteManifest.DatasetNodeId, len(files)))
+
+	return &api.FetchResponse{
+		Status: "Success",
+		Files:  files,
+	}, nil
+}
+
+// applyRemoteManifest updates the mapped dataset to the remote workspace manifest and replaces the
+// workspace manifest and state of the mapped dataset. Files whose local content is kept after their
+// package was deleted or moved on Pennsieve are recorded as tombstones in the state.
+func applyRemoteManifest(datasetRoot string, localManifest *models.WorkspaceManifest,
+	remoteManifest *models.WorkspaceManifest, mapState *models2.MapState) ([]*api.PackageStatus, error) {
+
+	changes := compareWorkspaceManifests(localManifest.Files, remoteManifest.Files)
+	files := applyRemoteChanges(datasetRoot, changes, mapState)
+
+	// Tombstones are only kept as long as the local content exists.
+	tombstones := mapState.Tombstones[:0]
+	for _, t := range mapState.Tombstones {
+		if found, _ := exists(filepath.Join(datasetRoot, filepath.FromSlash(t.Path))); found {
+			tombstones = append(tombstones, t)
+		}
+	}
+	mapState.Tombstones = tombstones
+
+	err := shared.WriteWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"), remoteManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	mapState.LastFetch = time.Now()
+	err = shared.WriteStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"), mapState)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// compareWorkspaceManifests returns the packages that were ADDED, CHANGED, DELETED, MOVED, RENAMED or MOVED_RENAMED
+// on Pennsieve compared to the local workspace manifest. Packages are matched on their package node id.
+func compareWorkspaceManifests(local []models.ManifestDTO, remote []models.ManifestDTO) []remoteChange {
+
+	localPackages := make(map[string]models.ManifestDTO)
+	pushedFiles := make(map[string]struct{})
+	for _, m := range local {
+		if !m.FileName.Valid {
+			continue
+		}
+
+		// Files that were pushed from the mapped dataset are added to the local manifest
+		// without a package node id, so we can only match these on their location.
+		if m.PackageNodeId == "" {
+			pushedFiles[path.Join(m.Path, m.PackageName)] = struct{}{}
+			continue
+		}
+		localPackages[m.PackageNodeId] = m
+	}
+
+	remotePackages := make(map[string]struct{})
+	var changes []remoteChange
+	for _, r := range remote {
+		if !r.FileName.Valid {
+			continue
+		}
+		remotePackages[r.PackageNodeId] = struct{}{}
+
+		l, ok := localPackages[r.PackageNodeId]
+		if !ok {
+			if _, pushed := pushedFiles[path.Join(r.Path, r.PackageName)]; pushed {
+				continue
+			}
+
+			changes = append(changes, remoteChange{
+				Type: api.PackageStatus_ADDED,
+				New:  r,
+			})
+			continue
+		}
+
+		samePath := l.Path == r.Path
+		sameName := l.PackageName == r.PackageName
+		switch {
+		case samePath && sameName:
+			if remoteContentChanged(l, r) {
+				changes = append(changes, remoteChange{Type: api.PackageStatus_CHANGED, Old: l, New: r})
+			}
+		case samePath:
+			changes = append(changes, remoteChange{Type: api.PackageStatus_RENAMED, Old: l, New: r})
+		case sameName:
+			changes = append(changes, remoteChange{Type: api.PackageStatus_MOVED, Old: l, New: r})
+		default:
+			changes = append(changes, remoteChange{Type: api.PackageStatus_MOVED_RENAMED, Old: l, New: r})
+		}
+	}
+
+	for _, l := range local {
+		if !l.FileName.Valid || l.PackageNodeId == "" {
+			continue
+		}
+		if _, ok := remotePackages[l.PackageNodeId]; !ok {
+			changes = append(changes, remoteChange{
+				Type: api.PackageStatus_DELETED,
+				Old:  l,
+			})
+		}
+	}
+
+	return changes
+}
+
+// remoteContentChanged returns true if the checksum, or the size when no checksums are available,
+// of a package differs between two workspace manifests.
+func remoteContentChanged(old models.ManifestDTO, new models.ManifestDTO) bool {
+	if old.CheckSum.String != "" && new.CheckSum.String != "" {
+		return old.CheckSum.String != new.CheckSum.String
+	}
+
+	return old.Size.Valid && new.Size.Valid && old.Size.Int64 != new.Size.Int64
+}
+
+// applyRemoteChanges updates the placeholders in the mapped dataset to reflect the remote changes.
+// Files that contain pulled, or otherwise local, content are left in place and flagged in the
+// message of the returned package status. Kept files of deleted or moved packages are added to
+// the tombstones in the state.
+func applyRemoteChanges(datasetRoot string, changes []remoteChange, state *models2.MapState) []*api.PackageStatus {
+
+	var result []*api.PackageStatus
+	for _, c := range changes {
+
+		content := api.FileInfo{}
+		switch c.Type {
+		case api.PackageStatus_ADDED:
+			newLocation := filepath.Join(datasetRoot, c.New.Path, c.New.PackageName)
+			content = api.FileInfo{
+				PackageId: c.New.PackageNodeId,
+				Path:      c.New.Path,
+				Name:      c.New.PackageName,
+			}
+
+			if found, _ := exists(newLocation); found {
+				content.Message = "Local file exists at location; placeholder not created."
+				break
+			}
+
+			err := createPlaceholder(newLocation, c.New.FileNodeId.String)
+			if err != nil {
+				log.Errorf("Failed to create placeholder: %v", err)
+				content.Message = fmt.Sprintf("Failed to create placeholder: %v", err)
+				break
+			}
+			content.Message = "Placeholder created."
+
+		case api.PackageStatus_CHANGED:
+			location := filepath.Join(datasetRoot, c.New.Path, c.New.PackageName)
+			content = api.FileInfo{
+				PackageId: c.New.PackageNodeId,
+				Path:      c.New.Path,
+				Name:      c.New.PackageName,
+			}
+
+			if !isPlaceholder(location, c.Old.FileNodeId.String, datasetRoot, *state) {
+				content.Message = "Changed on Pennsieve; local content kept."
+				break
+			}
+
+			if c.Old.FileNodeId.String != c.New.FileNodeId.String {
+				if err := os.WriteFile(location, []byte(c.New.FileNodeId.String), 0644); err != nil {
+					log.Errorf("Failed to update placeholder: %v", err)
+					content.Message = fmt.Sprintf("Failed to update placeholder: %v", err)
+					break
+				}
+			}
+			content.Message = "Placeholder updated."
+
+		case api.PackageStatus_DELETED:
+			oldLocation := filepath.Join(datasetRoot, c.Old.Path, c.Old.PackageName)
+			content = api.FileInfo{
+				PackageId: c.Old.PackageNodeId,
+				Path:      c.Old.Path,
+				Name:      c.Old.PackageName,
+			}
+
+			if found, _ := exists(oldLocation); !found {
+				break
+			}
+
+			if !isPlaceholder(oldLocation, c.Old.FileNodeId.String, datasetRoot, *state) {
+				addTombstone(state, c.Old)
+				content.Message = "Deleted on Pennsieve; local content kept."
+				break
+			}
+
+			if err := os.Remove(oldLocation); err != nil {
+				log.Errorf("Failed to remove placeholder: %v", err)
+				content.Message = fmt.Sprintf("Failed to remove placeholder: %v", err)
+				break
+			}
+			content.Message = "Placeholder removed."
+
+		case api.PackageStatus_MOVED, api.PackageStatus_RENAMED, api.PackageStatus_MOVED_RENAMED:
+			oldLocation := filepath.Join(datasetRoot, c.Old.Path, c.Old.PackageName)
+			newLocation := filepath.Join(datasetRoot, c.New.Path, c.New.PackageName)
+			content = api.FileInfo{
+				PackageId: c.New.PackageNodeId,
+				Path:      c.New.Path,
+				Name:      c.New.PackageName,
+			}
+
+			if found, _ := exists(newLocation); found {
+				content.Message = "Local file exists at new location; placeholder not moved."
+				break
+			}
+
+			if found, _ := exists(oldLocation); !found {
+				// Placeholder was removed locally; create a new one at the remote location.
+				if err := createPlaceholder(newLocation, c.New.FileNodeId.String); err != nil {
+					log.Errorf("Failed to create placeholder: %v", err)
+					content.Message = fmt.Sprintf("Failed to create placeholder: %v", err)
+					break
+				}
+				content.Message = "Placeholder created."
+				break
+			}
+
+			if !isPlaceholder(oldLocation, c.Old.FileNodeId.String, datasetRoot, *state) {
+				addTombstone(state, c.Old)
+				content.Message = fmt.Sprintf("Moved on Pennsieve; local content kept at %s.",
+					filepath.ToSlash(filepath.Join(c.Old.Path, c.Old.PackageName)))
+				break
+			}
+
+			if err := os.MkdirAll(filepath.Dir(newLocation), os.ModePerm); err != nil {
+				log.Errorf("Failed to create target path: %v", err)
+				content.Message = fmt.Sprintf("Failed to create target path: %v", err)
+				break
+			}
+			if err := os.Rename(oldLocation, newLocation); err != nil {
+				log.Errorf("Failed to move placeholder: %v", err)
+				content.Message = fmt.Sprintf("Failed to move placeholder: %v", err)
+				break
+			}
+			content.Message = "Placeholder moved."
+		}
+
+		result = append(result, &api.PackageStatus{
+			Content:    &content,
+			ChangeType: c.Type,
+		})
+	}
+
+	return result
+}
+
+// addTombstone records the local content of a package that is no longer at its location on Pennsieve.
+func addTombstone(state *models2.MapState, m models.ManifestDTO) {
+	tombstone := models2.MapStateTombstone{
+		Path:      path.Join(m.Path, m.PackageName),
+		PackageId: m.PackageNodeId,
+		FileId:    m.FileNodeId.String,
+		FetchTime: time.Now(),
+	}
+
+	for i, t := range state.Tombstones {
+		if t.Path == tombstone.Path {
+			state.Tombstones[i] = tombstone
+			return
+		}
+	}
+	state.Tombstones = append(state.Tombstones, tombstone)
+}
+
+// createPlaceholder creates the folder structure for, and the placeholder file representing a file on Pennsieve.
+func createPlaceholder(location string, fileId string) error {
+	err := os.MkdirAll(filepath.Dir(location), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return touchFile(location, fileId)
+}
+
+// isPlaceholder returns true if the file at the location is a placeholder for the provided file id
+// and has not been pulled.
+func isPlaceholder(location string, fileId string, datasetRoot string, state models2.MapState) bool {
+
+	relLocation, err := filepath.Rel(datasetRoot, location)
+	if err != nil {
+		return false
+	}
+	if fileIsLocalAndNotMoved(filepath.ToSlash(relLocation), state) {
+		return false
+	}
+
+	id, err := shared.ReadFileIDFromFile(location)
+	if err != nil {
+		return false
+	}
+
+	return id == fileId
+}
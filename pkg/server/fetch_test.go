@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func manifestEntry(packageId string, fileId string, path string, name string) models.ManifestDTO {
+	return models.ManifestDTO{
+		PackageNodeId: packageId,
+		PackageName:   name,
+		FileNodeId:    models.NullString{NullString: sql.NullString{String: fileId, Valid: true}},
+		FileName:      models.NullString{NullString: sql.NullString{String: name, Valid: true}},
+		Path:          path,
+	}
+}
+
+func TestCompareWorkspaceManifests(t *testing.T) {
+	local := []models.ManifestDTO{
+		manifestEntry("N:package:1", "11111111-1111-1111-1111-111111111111", "", "file_1.txt"),
+		manifestEntry("N:package:2", "22222222-2222-2222-2222-222222222222", "folder_1", "file_2.txt"),
+		manifestEntry("N:package:3", "33333333-3333-3333-3333-333333333333", "folder_1", "file_3.txt"),
+		manifestEntry("N:package:4", "44444444-4444-4444-4444-444444444444", "folder_1", "file_4.txt"),
+		manifestEntry("N:package:5", "55555555-5555-5555-5555-555555555555", "folder_1", "file_5.txt"),
+	}
+	remote := []models.ManifestDTO{
+		manifestEntry("N:package:1", "11111111-1111-1111-1111-111111111111", "", "file_1.txt"),
+		manifestEntry("N:package:2", "22222222-2222-2222-2222-222222222222", "folder_1", "file_2_renamed.txt"),
+		manifestEntry("N:package:3", "33333333-3333-3333-3333-333333333333", "folder_2", "file_3.txt"),
+		manifestEntry("N:package:4", "44444444-4444-4444-4444-444444444444", "folder_2", "file_4_renamed.txt"),
+		manifestEntry("N:package:6", "66666666-6666-6666-6666-666666666666", "folder_2", "file_6.txt"),
+	}
+
+	changes := compareWorkspaceManifests(local, remote)
+	require.Len(t, changes, 5)
+
+	byPackage := make(map[string]remoteChange)
+	for _, c := range changes {
+		if c.Type == api.PackageStatus_DELETED {
+			byPackage[c.Old.PackageNodeId] = c
+		} else {
+			byPackage[c.New.PackageNodeId] = c
+		}
+	}
+
+	assert.Equal(t, api.PackageStatus_RENAMED, byPackage["N:package:2"].Type)
+	assert.Equal(t, api.PackageStatus_MOVED, byPackage["N:package:3"].Type)
+	assert.Equal(t, api.PackageStatus_MOVED_RENAMED, byPackage["N:package:4"].Type)
+	assert.Equal(t, api.PackageStatus_DELETED, byPackage["N:package:5"].Type)
+	assert.Equal(t, api.PackageStatus_ADDED, byPackage["N:package:6"].Type)
+}
+
+func TestApplyRemoteChanges(t *testing.T) {
+	datasetRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, "folder_1"), 0755))
+
+	// Placeholders for files that were never pulled
+	movedId := "33333333-3333-3333-3333-333333333333"
+	deletedId := "55555555-5555-5555-5555-555555555555"
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_1", "file_3.txt"), []byte(movedId), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_1", "file_5.txt"), []byte(deletedId), 0644))
+
+	// Pulled file that was deleted on the server
+	pulledContent := []byte("content of a file that was pulled from Pennsieve")
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_1", "file_7.txt"), pulledContent, 0644))
+
+	state := models2.MapState{
+		Files: []models2.MapStateRecord{
+			{FileId: "77777777-7777-7777-7777-777777777777", Path: "folder_1/file_7.txt", IsLocal: true},
+		},
+	}
+
+	changes := []remoteChange{
+		{
+			Type: api.PackageStatus_ADDED,
+			New:  manifestEntry("N:package:6", "66666666-6666-6666-6666-666666666666", "folder_2", "file_6.txt"),
+		},
+		{
+			Type: api.PackageStatus_MOVED,
+			Old:  manifestEntry("N:package:3", movedId, "folder_1", "file_3.txt"),
+			New:  manifestEntry("N:package:3", movedId, "folder_2", "file_3.txt"),
+		},
+		{
+			Type: api.PackageStatus_DELETED,
+			Old:  manifestEntry("N:package:5", deletedId, "folder_1", "file_5.txt"),
+		},
+		{
+			Type: api.PackageStatus_DELETED,
+			Old:  manifestEntry("N:package:7", "77777777-7777-7777-7777-777777777777", "folder_1", "file_7.txt"),
+		},
+	}
+
+	result := applyRemoteChanges(datasetRoot, changes, &state)
+	require.Len(t, result, 4)
+
+	// Added package has a placeholder with the file id
+	content, err := os.ReadFile(filepath.Join(datasetRoot, "folder_2", "file_6.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "66666666-6666-6666-6666-666666666666", string(content))
+
+	// Moved placeholder is relocated
+	assert.NoFileExists(t, filepath.Join(datasetRoot, "folder_1", "file_3.txt"))
+	content, err = os.ReadFile(filepath.Join(datasetRoot, "folder_2", "file_3.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, movedId, string(content))
+
+	// Deleted placeholder is removed
+	assert.NoFileExists(t, filepath.Join(datasetRoot, "folder_1", "file_5.txt"))
+
+	// Pulled content is never touched
+	content, err = os.ReadFile(filepath.Join(datasetRoot, "folder_1", "file_7.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, pulledContent, content)
+	assert.Equal(t, "Deleted on Pennsieve; local content kept.", result[3].Content.Message)
+	require.Len(t, state.Tombstones, 1)
+	assert.Equal(t, "folder_1/file_7.txt", state.Tombstones[0].Path)
+	assert.Equal(t, "N:package:7", state.Tombstones[0].PackageId)
+}
+
+func TestFetchKeepsDeletedPulledFilesOutOfDiff(t *testing.T) {
+	datasetRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, ".pennsieve"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, "folder_1"), 0755))
+
+	// Pulled files that are deleted and moved on the server
+	deletedContent := []byte("content of a pulled file that is deleted on Pennsieve")
+	movedContent := []byte("content of a pulled file that is moved on Pennsieve")
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_1", "file_7.txt"), deletedContent, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_1", "file_8.txt"), movedContent, 0644))
+
+	deleted := manifestEntry("N:package:7", "77777777-7777-7777-7777-777777777777", "folder_1", "file_7.txt")
+	deleted.Size = models.NullInt{NullInt64: sql.NullInt64{Int64: int64(len(deletedContent)), Valid: true}}
+	moved := manifestEntry("N:package:8", "88888888-8888-8888-8888-888888888888", "folder_1", "file_8.txt")
+	moved.Size = models.NullInt{NullInt64: sql.NullInt64{Int64: int64(len(movedContent)), Valid: true}}
+	movedRemote := moved
+	movedRemote.Path = "folder_2"
+
+	localManifest := &models.WorkspaceManifest{DatasetNodeId: "N:dataset:1", Files: []models.ManifestDTO{deleted, moved}}
+	require.NoError(t, shared.WriteWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"), localManifest))
+
+	state := &models2.MapState{
+		Files: []models2.MapStateRecord{
+			{FileId: deleted.FileNodeId.String, Path: "folder_1/file_7.txt", IsLocal: true},
+			{FileId: moved.FileNodeId.String, Path: "folder_1/file_8.txt", IsLocal: true},
+		},
+	}
+	require.NoError(t, shared.WriteStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"), state))
+
+	remoteManifest := &models.WorkspaceManifest{DatasetNodeId: "N:dataset:1", Files: []models.ManifestDTO{movedRemote}}
+	result, err := applyRemoteManifest(datasetRoot, localManifest, remoteManifest, state)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.FileExists(t, filepath.Join(datasetRoot, "folder_1", "file_7.txt"))
+	assert.FileExists(t, filepath.Join(datasetRoot, "folder_1", "file_8.txt"))
+
+	// The kept files are not reported as added, so push does not upload them again
+	diff, err := (&agentServer{}).GetMapDiff(context.Background(), &api.MapDiffRequest{Path: datasetRoot})
+	require.NoError(t, err)
+	for _, f := range diff.Files {
+		assert.NotEqual(t, api.PackageStatus_ADDED, f.ChangeType, "%s/%s", f.Content.Path, f.Content.Name)
+	}
+
+	// Tombstones are removed with the local content
+	require.NoError(t, os.Remove(filepath.Join(datasetRoot, "folder_1", "file_7.txt")))
+	_, err = applyRemoteManifest(datasetRoot, remoteManifest, remoteManifest, state)
+	require.NoError(t, err)
+	state, err = shared.ReadStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"))
+	require.NoError(t, err)
+	require.Len(t, state.Tombstones, 1)
+	assert.Equal(t, "folder_1/file_8.txt", state.Tombstones[0].Path)
+}
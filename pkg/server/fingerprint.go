@@ -0,0 +1,187 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// fingerprinter computes fingerprints of files in a mapped dataset that are used to
+// match files that were moved or renamed, and to check if files changed since they
+// were pulled. The diff strength determines how a fingerprint is calculated:
+//
+//	FAST:       CRC32 over the file, as recorded when files are pulled
+//	FULL:       SHA-256 over the entire file
+//	SIZE_MTIME: size and modification time of the file
+//
+// Hashes are cached by path, size and modification time. The cache in the map state is
+// seeded when files are pulled, and hashes that are calculated during a diff are added to
+// it, so repeated diffs only hash files that were added or modified since the previous diff.
+type fingerprinter struct {
+	strength   api.MapDiffRequest_Strength
+	cache      map[string]models.MapStateHash
+	calculated map[string]struct{}
+}
+
+func newFingerprinter(strength api.MapDiffRequest_Strength, hashes []models.MapStateHash) *fingerprinter {
+	cache := make(map[string]models.MapStateHash, len(hashes))
+	for _, h := range hashes {
+		cache[h.Path] = h
+	}
+
+	return &fingerprinter{
+		strength:   strength,
+		cache:      cache,
+		calculated: make(map[string]struct{}),
+	}
+}
+
+// diffStrengthFromConfig returns the diff strength that is set in the agent configuration.
+func diffStrengthFromConfig() api.MapDiffRequest_Strength {
+	strength, err := shared.ParseDiffStrength(viper.GetString("agent.diff_strength"))
+	if err != nil {
+		log.Warnf("Using fast diff strength: %v", err)
+	}
+
+	return strength
+}
+
+// fingerprint returns the fingerprint of a file in the mapped dataset.
+// The relPath is relative to the dataset root and uses slash as path divider.
+func (f *fingerprinter) fingerprint(datasetRoot string, relPath string) (string, error) {
+	return f.fingerprintWith(f.strength, datasetRoot, relPath)
+}
+
+// fingerprintWith returns the fingerprint of a file in the mapped dataset for a diff strength.
+func (f *fingerprinter) fingerprintWith(strength api.MapDiffRequest_Strength, datasetRoot string, relPath string) (string, error) {
+
+	location := filepath.Join(filepath.FromSlash(datasetRoot), filepath.FromSlash(relPath))
+	info, err := os.Stat(location)
+	if err != nil {
+		return "", err
+	}
+
+	if strength == api.MapDiffRequest_SIZE_MTIME {
+		return sizeModTimeFingerprint(info.Size(), info.ModTime()), nil
+	}
+
+	entry, ok := f.cache[relPath]
+	if !ok || entry.Size != info.Size() || !entry.ModTime.Equal(info.ModTime()) {
+		entry = models.MapStateHash{
+			Path:    relPath,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		}
+	}
+
+	var result string
+	switch strength {
+	case api.MapDiffRequest_FULL:
+		if entry.Sha256 == "" {
+			entry.Sha256, err = shared.GetFileSha256(location)
+			if err != nil {
+				return "", err
+			}
+			f.calculated[relPath] = struct{}{}
+		}
+		result = entry.Sha256
+	default:
+		if entry.Crc32 == nil {
+			crc, err := shared.GetFileCrc32(location, CrcSize)
+			if err != nil {
+				return "", err
+			}
+			entry.Crc32 = &crc
+			f.calculated[relPath] = struct{}{}
+		}
+		result = crc32Fingerprint(*entry.Crc32)
+	}
+
+	f.cache[relPath] = entry
+	return result, nil
+}
+
+// calculatedHashes returns the cached hashes of the files that were hashed by the fingerprinter.
+func (f *fingerprinter) calculatedHashes() []models.MapStateHash {
+	var result []models.MapStateHash
+	for p := range f.calculated {
+		result = append(result, f.cache[p])
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// pulledFingerprint returns the fingerprint of a file at the time it was pulled, or an
+// empty string if the state does not contain the information for the diff strength.
+func (f *fingerprinter) pulledFingerprint(record models.MapStateRecord) string {
+	switch f.strength {
+	case api.MapDiffRequest_FULL:
+		return record.Sha256
+	case api.MapDiffRequest_SIZE_MTIME:
+		if record.ModTime.IsZero() {
+			return ""
+		}
+		return sizeModTimeFingerprint(record.Size, record.ModTime)
+	default:
+		return crc32Fingerprint(record.Crc32)
+	}
+}
+
+// matchesPulled returns true if a file in the mapped dataset has the same fingerprint as the pulled file
+// in the record. Records that do not contain the hash for the diff strength, such as records of files
+// that were pulled before SHA-256 hashes were recorded, are compared by their CRC32 hash.
+func (f *fingerprinter) matchesPulled(datasetRoot string, relPath string, record models.MapStateRecord) (bool, error) {
+	strength, pulled := f.strength, f.pulledFingerprint(record)
+	if pulled == "" {
+		strength, pulled = api.MapDiffRequest_FAST, crc32Fingerprint(record.Crc32)
+	}
+
+	fingerprint, err := f.fingerprintWith(strength, datasetRoot, relPath)
+	if err != nil {
+		return false, err
+	}
+
+	return fingerprint == pulled, nil
+}
+
+// setMapStateHash adds a hash to the hash cache in the map state, replacing an existing entry for the same path.
+func setMapStateHash(mapState *models.MapState, hash models.MapStateHash) {
+	for i, h := range mapState.Hashes {
+		if h.Path == hash.Path {
+			mapState.Hashes[i] = hash
+			return
+		}
+	}
+
+	mapState.Hashes = append(mapState.Hashes, hash)
+}
+
+// removeMapStateHash removes the cached hash for a path from the map state.
+func removeMapStateHash(mapState *models.MapState, relPath string) {
+	for i, h := range mapState.Hashes {
+		if h.Path == relPath {
+			mapState.Hashes = append(mapState.Hashes[:i], mapState.Hashes[i+1:]...)
+			return
+		}
+	}
+}
+
+func crc32Fingerprint(crc uint32) string {
+	return fmt.Sprintf("crc32:%d", crc)
+}
+
+func sizeModTimeFingerprint(size int64, modTime time.Time) string {
+	return fmt.Sprintf("size:%d:mtime:%d", size, modTime.UnixNano())
+}
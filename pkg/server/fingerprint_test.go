@@ -0,0 +1,163 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeLargeFileDataset creates a mapped dataset with a pulled file that is larger than CrcSize.
+// The pulled file is removed and a different file, with identical first CrcSize bytes, is added
+// at another location.
+func writeLargeFileDataset(t *testing.T) string {
+	datasetRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, ".pennsieve"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(datasetRoot, "folder_2"), 0755))
+
+	prefix := bytes.Repeat([]byte("a"), CrcSize)
+	pulledContent := append(append([]byte{}, prefix...), []byte("pulled tail")...)
+	addedContent := append(append([]byte{}, prefix...), []byte("another tail")...)
+
+	pulledLocation := filepath.Join(datasetRoot, "pulled.bin")
+	require.NoError(t, os.WriteFile(pulledLocation, pulledContent, 0644))
+	pulledCrc, err := shared.GetFileCrc32(pulledLocation, CrcSize)
+	require.NoError(t, err)
+	pulledSha, err := shared.GetFileSha256(pulledLocation)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(pulledLocation))
+
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "folder_2", "added.bin"), addedContent, 0644))
+
+	fileId := "11111111-1111-1111-1111-111111111111"
+	require.NoError(t, shared.WriteWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"),
+		&models.WorkspaceManifest{
+			DatasetNodeId: "N:dataset:1",
+			Files:         []models.ManifestDTO{manifestEntry("N:package:1", fileId, "", "pulled.bin")},
+		}))
+
+	require.NoError(t, shared.WriteStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"),
+		&models2.MapState{Files: []models2.MapStateRecord{{
+			FileId:  fileId,
+			Path:    "pulled.bin",
+			IsLocal: true,
+			Crc32:   pulledCrc,
+			Sha256:  pulledSha,
+			Size:    int64(len(pulledContent)),
+		}}}))
+
+	return datasetRoot
+}
+
+func TestCompareManifestStrength(t *testing.T) {
+	datasetRoot := writeLargeFileDataset(t)
+	files, err := createFolderManifest(datasetRoot)
+	require.NoError(t, err)
+	manifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	require.NoError(t, err)
+
+	// Both FAST and FULL hash the entire file, so files with an identical header are reported as added and deleted.
+	for _, strength := range []api.MapDiffRequest_Strength{api.MapDiffRequest_FAST, api.MapDiffRequest_FULL} {
+		result, err := compareManifestToFolder(datasetRoot, manifest.Files, files, newFingerprinter(strength, nil))
+		require.NoError(t, err)
+		require.Len(t, result, 2, strength.String())
+		types := []api.PackageStatus_StatusType{result[0].Type, result[1].Type}
+		assert.ElementsMatch(t, []api.PackageStatus_StatusType{api.PackageStatus_ADDED, api.PackageStatus_DELETED}, types)
+	}
+}
+
+func TestFingerprintCache(t *testing.T) {
+	datasetRoot := writeLargeFileDataset(t)
+	info, err := os.Stat(filepath.Join(datasetRoot, "folder_2", "added.bin"))
+	require.NoError(t, err)
+
+	// Cached hashes are re-used as long as the size and modification time of the file are unchanged.
+	hasher := newFingerprinter(api.MapDiffRequest_FULL, []models2.MapStateHash{
+		{Path: "folder_2/added.bin", Size: info.Size(), ModTime: info.ModTime(), Sha256: "cached"},
+	})
+	fingerprint, err := hasher.fingerprint(datasetRoot, "folder_2/added.bin")
+	require.NoError(t, err)
+	assert.Equal(t, "cached", fingerprint)
+
+	stale := newFingerprinter(api.MapDiffRequest_FULL, []models2.MapStateHash{
+		{Path: "folder_2/added.bin", Size: info.Size() + 1, ModTime: info.ModTime(), Sha256: "cached"},
+	})
+	fingerprint, err = stale.fingerprint(datasetRoot, "folder_2/added.bin")
+	require.NoError(t, err)
+	assert.NotEqual(t, "cached", fingerprint, "Expect the hash to be calculated when the file changed.")
+}
+
+func TestMapDiffCachesHashes(t *testing.T) {
+	datasetRoot := writeLargeFileDataset(t)
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+
+	// The diff hashes the added file and caches the hash in the state file.
+	_, err := (&agentServer{}).GetMapDiff(context.Background(), &api.MapDiffRequest{Path: datasetRoot, Strength: api.MapDiffRequest_FULL})
+	require.NoError(t, err)
+
+	state, err := shared.ReadStateFile(stateFileLocation)
+	require.NoError(t, err)
+	require.Len(t, state.Hashes, 1)
+	assert.Equal(t, "folder_2/added.bin", state.Hashes[0].Path)
+	assert.NotEmpty(t, state.Hashes[0].Sha256)
+	assert.Len(t, state.Files, 1, "Expect the records in the state file to be kept.")
+
+	// Cached hashes of removed files are dropped, and records that were written to the state file
+	// since it was read by the diff are kept.
+	require.NoError(t, os.Remove(filepath.Join(datasetRoot, "folder_2", "added.bin")))
+	require.NoError(t, os.WriteFile(filepath.Join(datasetRoot, "new.txt"), []byte("new"), 0644))
+	state.Files = append(state.Files, models2.MapStateRecord{Path: "other.bin", IsLocal: true})
+	require.NoError(t, shared.WriteStateFile(stateFileLocation, state))
+
+	require.NoError(t, cacheMapStateHashes(datasetRoot, []models2.MapStateHash{{Path: "new.txt", Sha256: "hash"}}))
+	state, err = shared.ReadStateFile(stateFileLocation)
+	require.NoError(t, err)
+	require.Len(t, state.Hashes, 1)
+	assert.Equal(t, "new.txt", state.Hashes[0].Path)
+	assert.Len(t, state.Files, 2)
+}
+
+func TestMatchesPulledFallback(t *testing.T) {
+	datasetRoot := t.TempDir()
+	location := filepath.Join(datasetRoot, "pulled.bin")
+	require.NoError(t, os.WriteFile(location, []byte("content"), 0644))
+	crc, err := shared.GetFileCrc32(location, CrcSize)
+	require.NoError(t, err)
+
+	// Records of files that were pulled before SHA-256 hashes were recorded are compared by CRC32.
+	record := models2.MapStateRecord{Path: "pulled.bin", Crc32: crc}
+	for _, strength := range []api.MapDiffRequest_Strength{api.MapDiffRequest_FULL, api.MapDiffRequest_SIZE_MTIME} {
+		same, err := newFingerprinter(strength, nil).matchesPulled(datasetRoot, "pulled.bin", record)
+		require.NoError(t, err)
+		assert.True(t, same, strength.String())
+	}
+
+	require.NoError(t, os.WriteFile(location, []byte("changed"), 0644))
+	same, err := newFingerprinter(api.MapDiffRequest_FULL, nil).matchesPulled(datasetRoot, "pulled.bin", record)
+	require.NoError(t, err)
+	assert.False(t, same)
+}
+
+func TestParseDiffStrength(t *testing.T) {
+	for value, expected := range map[string]api.MapDiffRequest_Strength{
+		"":           api.MapDiffRequest_FAST,
+		"fast":       api.MapDiffRequest_FAST,
+		"FULL":       api.MapDiffRequest_FULL,
+		"size+mtime": api.MapDiffRequest_SIZE_MTIME,
+	} {
+		strength, err := shared.ParseDiffStrength(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, strength, value)
+	}
+
+	_, err := shared.ParseDiffStrength("sha1")
+	assert.Error(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 
 	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest"
@@ -29,7 +30,8 @@ type fileWalk chan string
 // API ENDPOINT IMPLEMENTATIONS
 // --------------------------------------------
 
-// ListManifests returns a list of manifests that are currently defined in the local database.
+// ListManifests returns a list of manifests that are currently defined in the local database, with the lifecycle
+// state of each manifest and the number of files in the manifest for each status.
 func (s *agentServer) ListManifests(ctx context.Context, request *pb.ListManifestsRequest) (*pb.ListManifestsResponse, error) {
 
 	manifests, err := s.ManifestService().GetAll()
@@ -42,6 +44,15 @@ func (s *agentServer) ListManifests(ctx context.Context, request *pb.ListManifes
 			nodeId = m.NodeId.String
 		}
 
+		counts, err := s.ManifestService().GetStatusCounts(m.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to count files in manifest %d: %v", m.Id, err)
+		}
+		statusCounts := make(map[string]int64, len(counts))
+		for st, n := range counts {
+			statusCounts[strings.ToUpper(st.String())] = n
+		}
+
 		r = append(r, &pb.ListManifestsResponse_Manifest{
 			Id:               m.Id,
 			NodeId:           nodeId,
@@ -51,7 +62,8 @@ func (s *agentServer) ListManifests(ctx context.Context, request *pb.ListManifes
 			OrganizationId:   m.OrganizationId,
 			DatasetName:      m.DatasetName,
 			DatasetId:        m.DatasetId,
-			Status:           m.Status.String(),
+			Status:           string(m.State),
+			StatusCounts:     statusCounts,
 		})
 	}
 	response := pb.ListManifestsResponse{Manifests: r}
@@ -138,13 +150,33 @@ func (s *agentServer) CreateManifest(ctx context.Context, request *pb.CreateMani
 }
 
 // AddToManifest adds files to existing upload manifest.
+// If a metadata file is provided, the key/value metadata in the file is stored with the files in the local manifest.
 func (s *agentServer) AddToManifest(ctx context.Context, request *pb.AddToManifestRequest) (*pb.SimpleStatusResponse, error) {
 
+	var metadata map[string]map[string]string
+	if request.MetadataPath != "" {
+		var err error
+		if metadata, err = readFileMetadata(request.MetadataPath); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to read metadata from %s: %v", request.MetadataPath, err)
+		}
+		metadata = resolveMetadataPaths(metadata, metadataFolder(request.BasePath, request.MetadataPath))
+	}
+
 	nrRecords, nrOSSkipped, nrSecretsSkipped, _ := s.addToManifest(request.BasePath, request.TargetBasePath, request.Files, request.ManifestId)
 
 	log.Infof("Finished Adding %d files (skipped %d OS metadata, %d suspected credential).", nrRecords, nrOSSkipped, nrSecretsSkipped)
 
-	response := pb.SimpleStatusResponse{Status: "Successfully indexed " + manifestAddSummary(nrRecords, nrOSSkipped, nrSecretsSkipped)}
+	summary := manifestAddSummary(nrRecords, nrOSSkipped, nrSecretsSkipped)
+	if metadata != nil {
+		missing, err := s.ManifestService().SetFileMetadata(request.ManifestId, metadata)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to set metadata of files in manifest %d: %v", request.ManifestId, err)
+		}
+		summary += metadataSummary(len(metadata)-len(missing), missing)
+	}
+	s.updateManifestState(request.ManifestId, models.ManifestFilesChanged)
+
+	response := pb.SimpleStatusResponse{Status: "Successfully indexed " + summary}
 	return &response, nil
 }
 
@@ -170,6 +202,7 @@ func (s *agentServer) RemoveFromManifest(ctx context.Context, request *pb.Remove
 	if err != nil {
 		return nil, err
 	}
+	s.updateManifestState(request.ManifestId, models.ManifestFilesChanged)
 
 	// using uppercase status strings because that's how the user sees them via the CLI manifest list.
 	removeStatus := fmt.Sprintf("Successfully removed %d %s files and %d %s files.",
@@ -205,10 +238,37 @@ func (s *agentServer) DeleteManifest(ctx context.Context, request *pb.DeleteMani
 
 }
 
-// ListManifestFiles lists files from an existing upload manifest.
+// manifestFileOrders maps the sort fields of a list request to the order of files in the store.
+var manifestFileOrders = map[pb.ListManifestFilesRequest_SortField]store.ManifestFileOrder{
+	pb.ListManifestFilesRequest_ID:          store.OrderById,
+	pb.ListManifestFilesRequest_SOURCE_PATH: store.OrderBySourcePath,
+	pb.ListManifestFilesRequest_TARGET_PATH: store.OrderByTargetPath,
+	pb.ListManifestFilesRequest_STATUS:      store.OrderByStatus,
+	pb.ListManifestFilesRequest_UPDATED_AT:  store.OrderByUpdatedAt,
+}
+
+// ListManifestFiles lists files from an existing upload manifest, optionally filtered by status and path and
+// sorted by a field. The response includes the total number of files that match the filters.
 func (s *agentServer) ListManifestFiles(ctx context.Context, request *pb.ListManifestFilesRequest) (*pb.ListManifestFilesResponse, error) {
 
-	result, err := s.ManifestService().GetFiles(request.ManifestId, request.Limit, request.Offset)
+	orderBy, ok := manifestFileOrders[request.SortBy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort field %v", request.SortBy)
+	}
+	filter := store.ManifestFileFilter{
+		TargetPath: request.Path,
+		SourcePath: request.SourcePath,
+		OrderBy:    orderBy,
+		Descending: request.Descending,
+		Limit:      request.Limit,
+		Offset:     request.Offset,
+	}
+	for _, st := range request.Status {
+		// The status types have the same values as the file statuses.
+		filter.Statuses = append(filter.Statuses, manifestFile.Status(st))
+	}
+
+	result, total, err := s.ManifestService().FindFiles(request.ManifestId, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -230,7 +290,7 @@ func (s *agentServer) ListManifestFiles(ctx context.Context, request *pb.ListMan
 
 	}
 
-	response := pb.ListManifestFilesResponse{File: r}
+	response := pb.ListManifestFilesResponse{File: r, Total: total}
 
 	return &response, nil
 
@@ -269,7 +329,11 @@ func (s *agentServer) SyncManifest(ctx context.Context, request *pb.SyncManifest
 	}
 	s.cancelFncs.Store(request.GetManifestId(), session)
 
-	go s.syncProcessor(ctx, manifest)
+	s.updateManifestState(request.GetManifestId(), models.ManifestSyncStarted)
+	go func() {
+		s.syncProcessor(ctx, manifest)
+		s.updateManifestState(request.GetManifestId(), models.ManifestSyncEnded)
+	}()
 
 	r := pb.SyncManifestResponse{
 		ManifestNodeId: manifest.NodeId.String,
@@ -279,12 +343,6 @@ func (s *agentServer) SyncManifest(ctx context.Context, request *pb.SyncManifest
 
 }
 
-// RelocateManifestFiles allows users to update the target path for a given path.
-func (s *agentServer) RelocateManifestFiles(ctx context.Context, request *pb.RelocateManifestFilesRequest) (*pb.SimpleStatusResponse, error) {
-
-	return nil, nil
-}
-
 // ResetManifest allows users to reset the status for all files in a manifest
 func (s *agentServer) ResetManifest(ctx context.Context, request *pb.ResetManifestRequest) (*pb.SimpleStatusResponse, error) {
 
@@ -293,11 +351,20 @@ func (s *agentServer) ResetManifest(ctx context.Context, request *pb.ResetManife
 		log.Error("Cannot reset manifest: ", err)
 		return nil, err
 	}
+	s.updateManifestState(request.ManifestId, models.ManifestReset)
 
 	response := pb.SimpleStatusResponse{Status: "Success"}
 	return &response, nil
 }
 
+// updateManifestState moves a manifest to its next lifecycle state after an event. Errors are logged and not
+// returned, so a failure to update the state does not fail the operation that caused the event.
+func (s *agentServer) updateManifestState(manifestId int32, event models.ManifestEvent) {
+	if _, err := s.ManifestService().UpdateState(manifestId, event); err != nil {
+		log.Warnf("Unable to update state of manifest %d: %v", manifestId, err)
+	}
+}
+
 // ----------------------------------------------
 // SYNC FUNCTIONS
 // ----------------------------------------------
@@ -536,49 +603,16 @@ func (s *agentServer) syncItems(requestFiles []manifestFile.FileDTO, manifestNod
 
 // updateSubscribers sends upload-progress updates to all grpc-update subscribers.
 func (s *agentServer) syncUpdateSubscribers(total int64, nrSynced int64, workerId int32, status pb.SubscribeResponse_SyncResponse_SyncStatus) {
-	// A list of clients to unsubscribe in case of error
-	var unsubscribe []int32
-
-	// Iterate over all subscribers and send data to each client
-	s.subscribers.Range(func(k, v any) bool {
-		id, ok := k.(int32)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber key: %T", k))
-			return false
-		}
-		sub, ok := v.(shared.Sub)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber value: %T", v))
-			return false
-		}
-		// Send data over the gRPC Stream to the client
-		if err := sub.Stream.Send(&pb.SubscribeResponse{
-			Type: pb.SubscribeResponse_SYNC_STATUS,
-			MessageData: &pb.SubscribeResponse_SyncStatus{
-				SyncStatus: &pb.SubscribeResponse_SyncResponse{
-					Total:    total,
-					Status:   status,
-					NrSynced: nrSynced,
-					WorkerId: workerId,
-				}},
-		}); err != nil {
-			log.Error(fmt.Sprintf("Failed to send data to client: %v", err))
-			select {
-			case sub.Finished <- true:
-				log.Info(fmt.Sprintf("Unsubscribed client: %d", id))
-			default:
-				// Default case is to avoid blocking in case client has already unsubscribed
-			}
-			// In case of error the client would re-subscribe so close the subscriber Stream
-			unsubscribe = append(unsubscribe, id)
-		}
-		return true
+	s.sendSubscribers(&pb.SubscribeResponse{
+		Type: pb.SubscribeResponse_SYNC_STATUS,
+		MessageData: &pb.SubscribeResponse_SyncStatus{
+			SyncStatus: &pb.SubscribeResponse_SyncResponse{
+				Total:    total,
+				Status:   status,
+				NrSynced: nrSynced,
+				WorkerId: workerId,
+			}},
 	})
-
-	// Unsubscribe erroneous client streams
-	for _, id := range unsubscribe {
-		s.subscribers.Delete(id)
-	}
 }
 
 // addToManifest walks over provided path and adds records to DB. Returns
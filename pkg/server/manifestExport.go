@@ -0,0 +1,354 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Formats of exported manifests.
+const (
+	csvManifestFormat  = "csv"
+	jsonManifestFormat = "json"
+)
+
+// manifestColumns are the columns of an exported manifest, in order.
+var manifestColumns = []string{"source_path", "target_path", "target_name", "status", "upload_id", "size"}
+
+// importBatchSize is the number of files that are added to the database at a time when importing a manifest.
+const importBatchSize = 50
+
+// maxMissingExamples is the number of missing files that are listed when an import fails.
+const maxMissingExamples = 5
+
+// ExportedManifest is the JSON representation of an exported manifest.
+type ExportedManifest struct {
+	ManifestId  int32                  `json:"manifest_id"`
+	DatasetId   string                 `json:"dataset_id"`
+	DatasetName string                 `json:"dataset_name"`
+	Files       []ExportedManifestFile `json:"files"`
+}
+
+// ExportedManifestFile is a file in an exported manifest. The size is nil if the file does not exist on
+// this machine anymore.
+type ExportedManifestFile struct {
+	SourcePath string `json:"source_path"`
+	TargetPath string `json:"target_path"`
+	TargetName string `json:"target_name"`
+	Status     string `json:"status,omitempty"`
+	UploadId   string `json:"upload_id,omitempty"`
+	Size       *int64 `json:"size"`
+}
+
+// ExportManifest writes the files of a manifest to a CSV or JSON file.
+func (s *agentServer) ExportManifest(ctx context.Context, request *pb.ExportManifestRequest) (*pb.ExportManifestResponse, error) {
+
+	format, err := manifestFormat(request.GetFormat(), request.GetPath())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	m, err := s.ManifestService().GetManifest(request.GetManifestId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "manifest %d not found: %v", request.GetManifestId(), err)
+	}
+
+	files, err := s.allManifestFiles(request.GetManifestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list files in manifest %d: %v", request.GetManifestId(), err)
+	}
+
+	exported := ExportedManifest{
+		ManifestId:  m.Id,
+		DatasetId:   m.DatasetId,
+		DatasetName: m.DatasetName,
+		Files:       make([]ExportedManifestFile, 0, len(files)),
+	}
+	for _, file := range files {
+		exported.Files = append(exported.Files, exportManifestFile(file))
+	}
+
+	if err := writeExportedManifest(request.GetPath(), format, exported); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to export manifest %d: %v", request.GetManifestId(), err)
+	}
+
+	return &pb.ExportManifestResponse{Path: request.GetPath(), NrFiles: int64(len(exported.Files))}, nil
+}
+
+// ImportManifest creates a manifest for a dataset from a CSV or JSON file with the columns of an exported
+// manifest. Every source path in the file must exist on this machine; relative source paths are relative to
+// the folder of the file. The status and upload id of the files are not imported, so all files are uploaded
+// again as new files.
+func (s *agentServer) ImportManifest(ctx context.Context, request *pb.ImportManifestRequest) (*pb.ImportManifestResponse, error) {
+
+	if request.GetDatasetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "a dataset is required to import a manifest")
+	}
+
+	format, err := manifestFormat(request.GetFormat(), request.GetPath())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	files, err := readExportedManifest(request.GetPath(), format)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to read %s: %v", request.GetPath(), err)
+	}
+
+	records, err := importRecords(files, filepath.Dir(request.GetPath()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := s.getManifestParams(request.GetDatasetId())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "unable to get dataset %s: %v", request.GetDatasetId(), err)
+	}
+
+	created, err := s.ManifestService().Add(params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create manifest: %v", err)
+	}
+
+	for start := 0; start < len(records); start += importBatchSize {
+		batch := records[start:min(start+importBatchSize, len(records))]
+		for i := range batch {
+			batch[i].ManifestId = created.Id
+		}
+		if err := s.ManifestService().AddFiles(batch); err != nil {
+			log.Errorf("Unable to add files to imported manifest %d: %v", created.Id, err)
+			// Files are removed with the manifest, so a failed import does not leave a partial manifest
+			if err := s.ManifestService().RemoveManifest(created.Id); err != nil {
+				log.Errorf("Unable to remove partially imported manifest %d: %v", created.Id, err)
+			}
+			return nil, status.Errorf(codes.Internal, "unable to add files to manifest %d: %v", created.Id, err)
+		}
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Imported %d files from %s into manifest %d.", len(records), request.GetPath(), created.Id))
+	return &pb.ImportManifestResponse{ManifestId: created.Id, NrFiles: int64(len(records))}, nil
+}
+
+// manifestFormat returns the requested format, or the format that matches the extension of the file.
+func manifestFormat(format string, file string) (string, error) {
+	if file == "" {
+		return "", errors.New("a file is required")
+	}
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file)), ".")
+	}
+	switch strings.ToLower(format) {
+	case csvManifestFormat:
+		return csvManifestFormat, nil
+	case jsonManifestFormat:
+		return jsonManifestFormat, nil
+	default:
+		return "", fmt.Errorf("unsupported manifest format %q; use %s or %s", format, csvManifestFormat, jsonManifestFormat)
+	}
+}
+
+// exportManifestFile returns the exported representation of a file, with its current size on disk.
+func exportManifestFile(file store.ManifestFile) ExportedManifestFile {
+	exported := ExportedManifestFile{
+		SourcePath: file.SourcePath,
+		TargetPath: file.TargetPath,
+		TargetName: file.TargetName,
+		Status:     file.Status.String(),
+		UploadId:   file.UploadId.String(),
+	}
+	if info, err := os.Stat(file.SourcePath); err == nil && info.Mode().IsRegular() {
+		size := info.Size()
+		exported.Size = &size
+	}
+	return exported
+}
+
+// writeExportedManifest writes the manifest to a temporary file next to the file, and renames it into place
+// once it is complete.
+func writeExportedManifest(file string, format string, exported ExportedManifest) error {
+	tmp, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	switch format {
+	case csvManifestFormat:
+		err = writeManifestCSV(tmp, exported.Files)
+	default:
+		encoder := json.NewEncoder(tmp)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(exported)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
+
+func writeManifestCSV(w io.Writer, files []ExportedManifestFile) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(manifestColumns); err != nil {
+		return err
+	}
+	for _, f := range files {
+		size := ""
+		if f.Size != nil {
+			size = strconv.FormatInt(*f.Size, 10)
+		}
+		if err := writer.Write([]string{f.SourcePath, f.TargetPath, f.TargetName, f.Status, f.UploadId, size}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// readExportedManifest reads the files of a manifest from a CSV or JSON file. A JSON file contains either an
+// exported manifest or a list of files.
+func readExportedManifest(file string, format string) ([]ExportedManifestFile, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == csvManifestFormat {
+		return readManifestCSV(bytes.NewReader(content))
+	}
+
+	var files []ExportedManifestFile
+	if err := json.Unmarshal(content, &files); err == nil {
+		return files, nil
+	}
+	var exported ExportedManifest
+	if err := json.Unmarshal(content, &exported); err != nil {
+		return nil, err
+	}
+	return exported.Files, nil
+}
+
+// readManifestCSV reads the files from a CSV file with a header. Columns are matched by name, and only the
+// source_path column is required.
+func readManifestCSV(r io.Reader) ([]ExportedManifestFile, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["source_path"]; !ok {
+		return nil, errors.New("missing source_path column")
+	}
+
+	value := func(row []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var files []ExportedManifestFile
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		} else if err != nil {
+			return nil, err
+		}
+		files = append(files, ExportedManifestFile{
+			SourcePath: value(row, "source_path"),
+			TargetPath: value(row, "target_path"),
+			TargetName: value(row, "target_name"),
+		})
+	}
+}
+
+// importRecords returns the records of the imported files. It returns an error that lists the files that do
+// not exist, and an error if a file is listed twice or if two files have the same target.
+func importRecords(files []ExportedManifestFile, folder string) ([]store.ManifestFileParams, error) {
+	var records []store.ManifestFileParams
+	var missing []string
+	sources := map[string]bool{}
+	targets := map[string]string{}
+
+	for i, file := range files {
+		if file.SourcePath == "" {
+			return nil, fmt.Errorf("file %d does not have a source path", i+1)
+		}
+		sourcePath := file.SourcePath
+		if !filepath.IsAbs(sourcePath) {
+			sourcePath = filepath.Join(folder, sourcePath)
+		}
+		sourcePath = filepath.Clean(sourcePath)
+
+		if sources[sourcePath] {
+			return nil, fmt.Errorf("%s is listed more than once", sourcePath)
+		}
+		sources[sourcePath] = true
+
+		if info, err := os.Stat(sourcePath); err != nil || !info.Mode().IsRegular() {
+			missing = append(missing, sourcePath)
+			continue
+		}
+
+		targetName := file.TargetName
+		if targetName == "" {
+			targetName = filepath.Base(sourcePath)
+		}
+		if strings.ContainsAny(targetName, `/\`) || targetName == "." || targetName == ".." {
+			return nil, fmt.Errorf("invalid target name %q for %s", targetName, sourcePath)
+		}
+		targetPath := normalizeTargetPath(file.TargetPath)
+
+		target := path.Join(targetPath, targetName)
+		if other, ok := targets[target]; ok {
+			return nil, fmt.Errorf("%s and %s would both be uploaded to %s", other, sourcePath, target)
+		}
+		targets[target] = sourcePath
+
+		records = append(records, store.ManifestFileParams{
+			SourcePath: sourcePath,
+			TargetPath: targetPath,
+			TargetName: targetName,
+		})
+	}
+
+	if len(missing) > 0 {
+		examples := missing[:min(len(missing), maxMissingExamples)]
+		return nil, fmt.Errorf("%d of %d files do not exist: %s", len(missing), len(files), strings.Join(examples, ", "))
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the file does not list any files")
+	}
+	return records, nil
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeExportTestFiles writes files to a folder and returns the folder and a manifest with the files.
+func writeExportTestFiles(t *testing.T) (string, []store.ManifestFile) {
+	folder := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(folder, "sub-1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "sub-1", "a.edf"), []byte("12345"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "b, with comma.txt"), []byte("1"), 0644))
+
+	files := []store.ManifestFile{
+		{Id: 1, SourcePath: filepath.Join(folder, "sub-1", "a.edf"), TargetPath: "sub-1", TargetName: "a.edf",
+			Status: manifestFile.Finalized, UploadId: uuid.MustParse("6f1c7d0e-4e8a-4a43-a0a1-0b1e2c3d4e5f")},
+		{Id: 2, SourcePath: filepath.Join(folder, "b, with comma.txt"), TargetPath: "", TargetName: "b, with comma.txt",
+			Status: manifestFile.Local},
+		{Id: 3, SourcePath: filepath.Join(folder, "deleted.txt"), TargetPath: "", TargetName: "deleted.txt",
+			Status: manifestFile.Local},
+	}
+	return folder, files
+}
+
+func TestExportManifestCSV(t *testing.T) {
+	folder, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	s.manifest.(*stubManifestService).withFiles(files)
+	output := filepath.Join(t.TempDir(), "manifest-1.csv")
+
+	response, err := s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), response.NrFiles)
+
+	exported, err := readExportedManifest(output, csvManifestFormat)
+	require.NoError(t, err)
+	require.Len(t, exported, 3)
+	assert.Equal(t, filepath.Join(folder, "b, with comma.txt"), exported[1].SourcePath)
+	assert.Equal(t, "b, with comma.txt", exported[1].TargetName)
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "source_path,target_path,target_name,status,upload_id,size\n")
+	assert.Contains(t, string(content), ",sub-1,a.edf,Finalized,6f1c7d0e-4e8a-4a43-a0a1-0b1e2c3d4e5f,5\n")
+	assert.Contains(t, string(content), "deleted.txt,Local,", "Expect an empty size for files that do not exist.")
+}
+
+func TestExportManifestJSON(t *testing.T) {
+	_, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	s.manifest.(*stubManifestService).withFiles(files)
+	output := filepath.Join(t.TempDir(), "manifest.out")
+
+	_, err := s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expect an error if the format cannot be inferred.")
+
+	_, err = s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output, Format: "json"})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(output)
+	require.NoError(t, err)
+	var exported ExportedManifest
+	require.NoError(t, json.Unmarshal(content, &exported))
+	assert.Equal(t, "N:dataset:1", exported.DatasetId)
+	require.Len(t, exported.Files, 3)
+	assert.Equal(t, int64(5), *exported.Files[0].Size)
+	assert.Nil(t, exported.Files[2].Size)
+
+	_, err = s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 2, Path: output, Format: "json"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestImportManifest(t *testing.T) {
+	folder, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	m := s.manifest.(*stubManifestService).withFiles(files[:2])
+
+	output := filepath.Join(folder, "manifest.json")
+	_, err := s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output})
+	require.NoError(t, err)
+
+	response, err := s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: output, DatasetId: "N:dataset:2"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), response.NrFiles)
+
+	require.Len(t, m.created, 1)
+	assert.Equal(t, "N:dataset:2", m.created[0].DatasetId)
+	assert.Equal(t, []store.ManifestFileParams{
+		{SourcePath: filepath.Join(folder, "sub-1", "a.edf"), TargetPath: "sub-1", TargetName: "a.edf", ManifestId: response.ManifestId},
+		{SourcePath: filepath.Join(folder, "b, with comma.txt"), TargetPath: "", TargetName: "b, with comma.txt", ManifestId: response.ManifestId},
+	}, m.addedFiles)
+}
+
+func TestImportManifestCSVWithRelativePaths(t *testing.T) {
+	folder, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	m := s.manifest.(*stubManifestService).withFiles(files)
+
+	input := filepath.Join(folder, "import.csv")
+	require.NoError(t, os.WriteFile(input, []byte("target_path,source_path\n/raw/,sub-1/a.edf\n"), 0644))
+
+	_, err := s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: input, DatasetId: "N:dataset:2"})
+	require.NoError(t, err)
+	assert.Equal(t, []store.ManifestFileParams{
+		{SourcePath: filepath.Join(folder, "sub-1", "a.edf"), TargetPath: "raw", TargetName: "a.edf", ManifestId: 2},
+	}, m.addedFiles)
+}
+
+func TestImportManifestValidatesPaths(t *testing.T) {
+	folder, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	m := s.manifest.(*stubManifestService).withFiles(files)
+
+	output := filepath.Join(folder, "manifest.csv")
+	_, err := s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output})
+	require.NoError(t, err)
+
+	_, err = s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: output, DatasetId: "N:dataset:2"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "1 of 3 files do not exist: "+filepath.Join(folder, "deleted.txt"))
+	assert.Empty(t, m.created, "Expect no manifest if the import is invalid.")
+
+	duplicate := filepath.Join(folder, "duplicate.csv")
+	require.NoError(t, os.WriteFile(duplicate, []byte("source_path\nsub-1/a.edf\nsub-1/../sub-1/a.edf\n"), 0644))
+	_, err = s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: duplicate, DatasetId: "N:dataset:2"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: output})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expect a dataset to be required.")
+}
+
+func TestImportManifestRemovesManifestOnError(t *testing.T) {
+	folder, files := writeExportTestFiles(t)
+	s := newTestPushServer()
+	m := s.manifest.(*stubManifestService).withFiles(files[:2])
+	m.addFilesErr = errors.New("disk full")
+
+	output := filepath.Join(folder, "manifest.json")
+	_, err := s.ExportManifest(context.Background(), &pb.ExportManifestRequest{ManifestId: 1, Path: output})
+	require.NoError(t, err)
+
+	_, err = s.ImportManifest(context.Background(), &pb.ImportManifestRequest{Path: output, DatasetId: "N:dataset:2"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	require.Len(t, m.created, 1)
+	_, err = m.GetManifest(2)
+	assert.Error(t, err, "Expect the manifest to be removed if its files cannot be added.")
+}
@@ -1,7 +1,13 @@
 package server
 
 import (
+	"context"
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"os"
 	"path/filepath"
 	"testing"
@@ -61,3 +67,55 @@ func TestRecordCreation(t *testing.T) {
 	assert.Equal(t, "newTargetPath/Folder 1", records[3].TargetPath,
 		"TargetBasePath should be root of target path.")
 }
+
+func TestListManifests(t *testing.T) {
+	m := newStubManifestService()
+	m.manifests[1] = &store.Manifest{Id: 1, DatasetId: "N:dataset:1", State: models.ManifestPartiallyFailed}
+	m.manifests[2] = &store.Manifest{Id: 2, DatasetId: "N:dataset:2", State: models.ManifestIndexed}
+	m.counts[1] = map[manifestFile.Status]int64{manifestFile.Verified: 8, manifestFile.Failed: 2, manifestFile.FailedOrphan: 1}
+	s := &agentServer{manifest: m}
+
+	response, err := s.ListManifests(context.Background(), &pb.ListManifestsRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Manifests, 2)
+
+	assert.Equal(t, "partially_failed", response.Manifests[0].Status, "Expect the lifecycle state as status.")
+	assert.Equal(t, map[string]int64{"VERIFIED": 8, "FAILED": 2, "FAILEDORPHAN": 1}, response.Manifests[0].StatusCounts)
+	assert.Equal(t, "indexed", response.Manifests[1].Status)
+	assert.Empty(t, response.Manifests[1].StatusCounts)
+}
+
+func TestListManifestFilesFilters(t *testing.T) {
+	m := newStubManifestService().withFiles([]store.ManifestFile{
+		{Id: 6, ManifestId: 1, SourcePath: "/data/sub-02/eeg.edf", TargetPath: "sub-02", Status: manifestFile.Verified},
+		{Id: 7, ManifestId: 1, SourcePath: "/data/sub-03/eeg.edf", TargetPath: "sub-03", Status: manifestFile.FailedOrphan},
+		{Id: 8, ManifestId: 1, SourcePath: "/data/sub-03/eeg.json", TargetPath: "sub-03", Status: manifestFile.Failed},
+	})
+	s := &agentServer{manifest: m}
+
+	response, err := s.ListManifestFiles(context.Background(), &pb.ListManifestFilesRequest{
+		ManifestId: 1,
+		Limit:      1,
+		Status:     []pb.ListManifestFilesResponse_StatusType{pb.ListManifestFilesResponse_FAILED, pb.ListManifestFilesResponse_FAILEDORPHAN},
+		Path:       "sub-03/*",
+		SourcePath: "/data/",
+		SortBy:     pb.ListManifestFilesRequest_TARGET_PATH,
+		Descending: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, store.ManifestFileFilter{
+		Statuses:   []manifestFile.Status{manifestFile.Failed, manifestFile.FailedOrphan},
+		TargetPath: "sub-03/*",
+		SourcePath: "/data/",
+		OrderBy:    store.OrderByTargetPath,
+		Descending: true,
+		Limit:      1,
+	}, m.filter)
+
+	assert.Equal(t, int64(2), response.Total)
+	require.Len(t, response.File, 1)
+	assert.Equal(t, pb.ListManifestFilesResponse_FAILEDORPHAN, response.File[0].Status)
+
+	_, err = s.ListManifestFiles(context.Background(), &pb.ListManifestFilesRequest{ManifestId: 1, SortBy: 42})
+	assert.Error(t, err)
+}
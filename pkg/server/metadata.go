@@ -0,0 +1,170 @@
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// maxMetadataKeyLength is the maximum length of a metadata key.
+const maxMetadataKeyLength = 255
+
+// metadataPathColumns are the names of the column with the path of the file in a CSV metadata file.
+var metadataPathColumns = []string{"path", "source_path"}
+
+// readFileMetadata reads the metadata of files from a CSV or JSON file, keyed by the path of the file.
+// The metadata is stored with the files in the local manifest; it is not sent to Pennsieve, which has
+// no API to set package properties on upload.
+//
+// A CSV file has a header with a path column, and a column for each key. Empty cells are skipped. A JSON
+// file is an object that maps the path of each file to an object with its keys and values.
+func readFileMetadata(file string) (map[string]map[string]string, error) {
+	format, err := manifestFormat("", file)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata map[string]map[string]string
+	if format == csvManifestFormat {
+		metadata, err = readMetadataCSV(bytes.NewReader(content))
+	} else {
+		metadata, err = readMetadataJSON(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for path, values := range metadata {
+		for key := range values {
+			if key == "" || len(key) > maxMetadataKeyLength {
+				return nil, fmt.Errorf("invalid metadata key %q for %s", key, path)
+			}
+		}
+	}
+	return metadata, nil
+}
+
+func readMetadataCSV(r io.Reader) (map[string]map[string]string, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the file is empty")
+	} else if err != nil {
+		return nil, err
+	}
+
+	pathColumn := -1
+	for i, name := range header {
+		header[i] = strings.TrimSpace(name)
+		for _, column := range metadataPathColumns {
+			if pathColumn < 0 && strings.EqualFold(header[i], column) {
+				pathColumn = i
+			}
+		}
+	}
+	if pathColumn < 0 {
+		return nil, fmt.Errorf("missing %s column", strings.Join(metadataPathColumns, " or "))
+	}
+
+	metadata := map[string]map[string]string{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return metadata, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		path := strings.TrimSpace(row[pathColumn])
+		if path == "" {
+			continue
+		}
+		if _, ok := metadata[path]; ok {
+			return nil, fmt.Errorf("%s is listed more than once", path)
+		}
+		values := map[string]string{}
+		for i, value := range row {
+			if value = strings.TrimSpace(value); i != pathColumn && value != "" {
+				values[header[i]] = value
+			}
+		}
+		metadata[path] = values
+	}
+}
+
+func readMetadataJSON(content []byte) (map[string]map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var raw map[string]map[string]any
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("expected an object that maps paths to objects with metadata: %w", err)
+	}
+
+	metadata := map[string]map[string]string{}
+	for path, values := range raw {
+		metadata[path] = map[string]string{}
+		for key, value := range values {
+			switch v := value.(type) {
+			case string:
+				metadata[path][key] = v
+			case json.Number:
+				metadata[path][key] = v.String()
+			case bool:
+				metadata[path][key] = strconv.FormatBool(v)
+			case nil:
+			default:
+				return nil, fmt.Errorf("metadata %q of %s must be a string, number or boolean", key, path)
+			}
+		}
+	}
+	return metadata, nil
+}
+
+// resolveMetadataPaths makes the relative paths in the metadata relative to the folder, so they match the
+// source paths of the files that are added from the folder.
+func resolveMetadataPaths(metadata map[string]map[string]string, folder string) map[string]map[string]string {
+	resolved := make(map[string]map[string]string, len(metadata))
+	for path, values := range metadata {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(folder, path)
+		}
+		resolved[filepath.Clean(path)] = values
+	}
+	return resolved
+}
+
+// metadataFolder returns the folder that relative paths in the metadata file are relative to: the base path
+// that is added if it is a folder, the folder of the base path if it is a file, or the folder of the metadata
+// file if no base path is added.
+func metadataFolder(basePath string, metadataPath string) string {
+	if basePath == "" {
+		return filepath.Dir(metadataPath)
+	}
+	if info, err := os.Stat(basePath); err == nil && !info.IsDir() {
+		return filepath.Dir(basePath)
+	}
+	return basePath
+}
+
+// metadataSummary formats the result of setting file metadata for the response of AddToManifest.
+func metadataSummary(nrFiles int, missing []string) string {
+	out := fmt.Sprintf(" Set metadata of %d files.", nrFiles)
+	if len(missing) > 0 {
+		examples := missing[:min(len(missing), maxMissingExamples)]
+		out += fmt.Sprintf(" %d paths in the metadata file are not in the manifest: %s.", len(missing), strings.Join(examples, ", "))
+	}
+	return out
+}
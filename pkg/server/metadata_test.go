@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadFileMetadataCSV(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "metadata.csv")
+	content := "subject_id,Path,session,modality\n" +
+		"sub-01,sub-01/anat/T1w.nii.gz,1,MRI\n" +
+		"sub-02,/data/sub-02/eeg.edf,,EEG\n"
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+
+	metadata, err := readFileMetadata(file)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"sub-01/anat/T1w.nii.gz": {"subject_id": "sub-01", "session": "1", "modality": "MRI"},
+		"/data/sub-02/eeg.edf":   {"subject_id": "sub-02", "modality": "EEG"},
+	}, metadata)
+
+	require.NoError(t, os.WriteFile(file, []byte("subject_id,session\nsub-01,1\n"), 0644))
+	_, err = readFileMetadata(file)
+	assert.ErrorContains(t, err, "missing path or source_path column")
+}
+
+func TestReadFileMetadataJSON(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "metadata.json")
+	content := `{"eeg.edf": {"subject_id": "sub-01", "session": 2, "sedated": false, "notes": null}}`
+	require.NoError(t, os.WriteFile(file, []byte(content), 0644))
+
+	metadata, err := readFileMetadata(file)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"eeg.edf": {"subject_id": "sub-01", "session": "2", "sedated": "false"},
+	}, metadata)
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"eeg.edf": {"channels": ["C3", "C4"]}}`), 0644))
+	_, err = readFileMetadata(file)
+	assert.Error(t, err, "Expect nested values to be rejected.")
+
+	require.NoError(t, os.WriteFile(file, []byte(`{"eeg.edf": {"": "value"}}`), 0644))
+	_, err = readFileMetadata(file)
+	assert.Error(t, err, "Expect empty keys to be rejected.")
+}
+
+func TestAddToManifestWithMetadata(t *testing.T) {
+	folder := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "eeg.edf"), []byte("1"), 0644))
+	metadataFile := filepath.Join(t.TempDir(), "metadata.csv")
+	require.NoError(t, os.WriteFile(metadataFile, []byte("path,subject_id\neeg.edf,sub-01\nmissing.edf,sub-02\n"), 0644))
+
+	m := newStubManifestService().withFiles(nil)
+	s := &agentServer{manifest: m}
+
+	response, err := s.AddToManifest(context.Background(), &pb.AddToManifestRequest{
+		ManifestId:   1,
+		BasePath:     folder,
+		MetadataPath: metadataFile,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, response.Status, "Set metadata of 1 files.")
+	assert.Contains(t, response.Status, "1 paths in the metadata file are not in the manifest: "+filepath.Join(folder, "missing.edf"))
+
+	require.Len(t, m.addedFiles, 1)
+	assert.Equal(t, map[string]string{"subject_id": "sub-01"}, m.metadata[m.addedFiles[0].SourcePath],
+		"Expect relative paths in the metadata file to match the added files.")
+
+	_, err = s.AddToManifest(context.Background(), &pb.AddToManifestRequest{
+		ManifestId:   1,
+		BasePath:     folder,
+		MetadataPath: filepath.Join(folder, "metadata.txt"),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, m.addedFiles, 1, "Expect no files to be added if the metadata file is invalid.")
+}
@@ -2,11 +2,12 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models2 "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	"github.com/pennsieve/pennsieve-go/pkg/pennsieve"
 	log "github.com/sirupsen/logrus"
 	"os"
 	"path/filepath"
@@ -17,6 +18,9 @@ import (
 type packageRecord struct {
 	PackageId string
 	Location  string
+	FileId    string
+	Size      int64
+	CheckSum  string
 }
 
 func (s *agentServer) Pull(ctx context.Context, req *api.PullRequest) (*api.SimpleStatusResponse, error) {
@@ -46,10 +50,7 @@ func (s *agentServer) Pull(ctx context.Context, req *api.PullRequest) (*api.Simp
 			curFile := filepath.Join(datasetRoot, f.Path, f.FileName.String)
 			curFolder := filepath.Join(datasetRoot, f.Path)
 			if curFile == req.Path || curFolder == req.Path {
-				packages = append(packages, packageRecord{
-					PackageId: f.PackageNodeId,
-					Location:  curFile,
-				})
+				packages = append(packages, newPackageRecord(datasetRoot, f))
 			}
 		}
 	}
@@ -57,62 +58,27 @@ func (s *agentServer) Pull(ctx context.Context, req *api.PullRequest) (*api.Simp
 	// Iterate over packages and download files.
 	// Run this in a goroutine to prevent blocking of the agent.
 	go func() {
-		// Open the state file so we can update as needed
-		mapState, _ := shared.ReadStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"))
+		client, err := s.PennsieveClient()
+		if err != nil {
+			log.Error("Cannot get Pennsieve client")
+			return
+		}
 
+		// Pulled files are recorded in a separate state that is merged into the state file when
+		// the downloads are done, so changes made to the state file in the meantime are kept.
+		pulled := &models.MapState{}
 		for _, pkg := range packages {
-			client, err := s.PennsieveClient()
-			if err != nil {
-				log.Error("Cannot get Pennsieve client")
-
-			}
-			res, err := client.Package.GetPresignedUrl(context.Background(), pkg.PackageId, false)
-			if err != nil {
+			if err := s.pullPackage(client, datasetRoot, pkg, pulled); err != nil {
 				// TODO: do correct error handling from go routine
-				log.Error("Cannot get presigned url")
-			}
-
-			downloaderImpl := shared.NewDownloader(s, client)
-			// Iterate over the files in a package and download serially
-		FILEWALK:
-			for _, f := range res.Files {
-				_, err := downloaderImpl.DownloadFileFromPresignedUrl(ctx, f.URL, pkg.Location, pkg.PackageId)
-				if err != nil {
-					log.Errorf("Download failed: %v", err)
-				}
-
-				// Get CRC for 1st MB of file, or the entire file if less.
-				crc32, err := shared.GetFileCrc32(pkg.Location, 1024*1024)
-				if err != nil {
-					log.Errorf("CRC2 failed: %v", err)
-				}
-
-				// Find if entry already exist in state and update if so
-				for i, mf := range mapState.Files {
-					if mf.Path == pkg.Location {
-						mapState.Files[i].PullTime = time.Now()
-						mapState.Files[i].Crc32 = crc32
-						continue FILEWALK
-					}
-				}
-
-				relLocation := strings.TrimPrefix(pkg.Location, datasetRoot+string(os.PathSeparator))
-
-				// First time we pull the file --> create new record in mapState.
-				mapState.Files = append(mapState.Files, models.MapStateRecord{
-					Path:     filepath.ToSlash(relLocation),
-					PullTime: time.Now(),
-					IsLocal:  true,
-					Crc32:    crc32,
-				})
+				log.Errorf("Pull failed for package %s: %v", pkg.PackageId, err)
 			}
 		}
 
 		// Update MapState file
-		stateJson, _ := json.MarshalIndent(mapState, "", "  ")
-		stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
-		err = os.WriteFile(stateFileLocation, stateJson, 0644)
-
+		err = mergePulledState(datasetRoot, pulled)
+		if err != nil {
+			log.Error(err)
+		}
 	}()
 
 	resp := &api.SimpleStatusResponse{Status: "Success"}
@@ -120,6 +86,119 @@ func (s *agentServer) Pull(ctx context.Context, req *api.PullRequest) (*api.Simp
 	return resp, nil
 }
 
+// mergePulledState adds the records and cached hashes of pulled files to the state file of a mapped dataset.
+func mergePulledState(datasetRoot string, pulled *models.MapState) error {
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	defer shared.LockStateFile(stateFileLocation)()
+
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return err
+	}
+
+RECORDS:
+	for _, record := range pulled.Files {
+		for i, mf := range mapState.Files {
+			if mf.Path == record.Path {
+				mapState.Files[i] = record
+				continue RECORDS
+			}
+		}
+		mapState.Files = append(mapState.Files, record)
+	}
+
+	for _, h := range pulled.Hashes {
+		setMapStateHash(mapState, h)
+	}
+
+	return shared.WriteStateFile(stateFileLocation, mapState)
+}
+
+// newPackageRecord creates a packageRecord for a file in the workspace manifest of a mapped dataset.
+func newPackageRecord(datasetRoot string, f models2.ManifestDTO) packageRecord {
+	return packageRecord{
+		PackageId: f.PackageNodeId,
+		Location:  filepath.Join(datasetRoot, f.Path, f.FileName.String),
+		FileId:    f.FileNodeId.String,
+		Size:      f.Size.Int64,
+		CheckSum:  f.CheckSum.String,
+	}
+}
+
+// pullPackage downloads the files of a package into the mapped dataset and records the pull in the map state.
+// The size and checksum of the remote file are recorded so remote changes after the pull can be detected.
+func (s *agentServer) pullPackage(client *pennsieve.Client, datasetRoot string, pkg packageRecord, mapState *models.MapState) error {
+
+	res, err := client.Package.GetPresignedUrl(context.Background(), pkg.PackageId, false)
+	if err != nil {
+		return fmt.Errorf("cannot get presigned url: %w", err)
+	}
+
+	relLocation := filepath.ToSlash(strings.TrimPrefix(pkg.Location, datasetRoot+string(os.PathSeparator)))
+
+	downloaderImpl := shared.NewDownloader(s, client)
+	// Iterate over the files in a package and download serially
+FILEWALK:
+	for _, f := range res.Files {
+		_, err := downloaderImpl.DownloadFileFromPresignedUrl(context.Background(), f.URL, pkg.Location, pkg.PackageId)
+		if err != nil {
+			return fmt.Errorf("download failed: %w", err)
+		}
+
+		crc32, err := shared.GetFileCrc32(pkg.Location, CrcSize)
+		if err != nil {
+			log.Errorf("CRC2 failed: %v", err)
+		}
+
+		// Get SHA-256 for entire file to support full-content diffs.
+		sha256, err := shared.GetFileSha256(pkg.Location)
+		if err != nil {
+			log.Errorf("SHA-256 failed: %v", err)
+		}
+
+		var modTime time.Time
+		var size int64
+		if info, err := os.Stat(pkg.Location); err == nil {
+			modTime = info.ModTime()
+			size = info.Size()
+		}
+
+		record := models.MapStateRecord{
+			FileId:   pkg.FileId,
+			Path:     relLocation,
+			PullTime: time.Now(),
+			IsLocal:  true,
+			Crc32:    crc32,
+			Sha256:   sha256,
+			ModTime:  modTime,
+			Size:     pkg.Size,
+			CheckSum: pkg.CheckSum,
+		}
+
+		// Seed the hash cache so the next diff does not need to hash the pulled file.
+		setMapStateHash(mapState, models.MapStateHash{
+			Path:    relLocation,
+			Size:    size,
+			ModTime: modTime,
+			Crc32:   &crc32,
+			Sha256:  sha256,
+		})
+
+		// Find if entry already exist in state and update if so
+		for i, mf := range mapState.Files {
+			if mf.Path == relLocation {
+				mapState.Files[i] = record
+				continue FILEWALK
+			}
+		}
+
+		// First time we pull the file --> create new record in mapState.
+		mapState.Files = append(mapState.Files, record)
+	}
+
+	return nil
+}
+
 // findMappedDatasetRoot checks if the provided path is part of a Pennsieve Mapped Dataset.
 func findMappedDatasetRoot(startPath string) (string, bool, error) {
 
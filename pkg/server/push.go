@@ -28,7 +28,7 @@ func (s *agentServer) Push(ctx context.Context, req *api.PushRequest) (*api.Simp
     manifestPath := filepath.Join(datasetRoot, ".pennsieve", "manifest.json")
 
     // Use existing diff functionality to find local additions
-    diffResp, err := s.GetMapDiff(ctx, &api.MapDiffRequest{Path: datasetRoot})
+    diffResp, err := s.GetMapDiff(ctx, &api.MapDiffRequest{Path: datasetRoot, Strength: diffStrengthFromConfig()})
     if err != nil {
         log.Errorf("Unable to calculate diff for push: %v", err)
         return nil, err
@@ -66,7 +66,7 @@ func (s *agentServer) Push(ctx context.Context, req *api.PushRequest) (*api.Simp
     // This runs in a goroutine to prevent blocking
     work := func() {
 
-        manifestParams, err := s.getManifestParams()
+        manifestParams, err := s.getManifestParams("")
         if err != nil {
             log.Errorf("Error retrieving manifest parameters: %v", err)
             s.messageSubscribers(fmt.Sprintf("Error preparing manifest: %v", err))
@@ -151,25 +151,33 @@ func (s *agentServer) Push(ctx context.Context, req *api.PushRequest) (*api.Simp
 }
 
 // getManifestParams is a helper to get manifest parameters for creating a new manifest
-func (s *agentServer) getManifestParams() (store.ManifestParams, error) {
+// for the dataset, or for the active dataset of the user if datasetId is empty
+func (s *agentServer) getManifestParams(datasetId string) (store.ManifestParams, error) {
     if s.getManifestParamsOverride != nil {
-        return s.getManifestParamsOverride()
+        params, err := s.getManifestParamsOverride()
+        if datasetId != "" {
+            params.DatasetId = datasetId
+        }
+        return params, err
     }
 
     activeUser, err := s.UserService().GetActiveUser()
     if err != nil {
         return store.ManifestParams{}, err
     }
-    curClientSession, err := s.UserService().GetUserSettings()
-    if err != nil {
-        return store.ManifestParams{}, err
+    if datasetId == "" {
+        curClientSession, err := s.UserService().GetUserSettings()
+        if err != nil {
+            return store.ManifestParams{}, err
+        }
+        datasetId = curClientSession.UseDatasetId
     }
 
     client, err := s.PennsieveClient()
     if err != nil {
         return store.ManifestParams{}, err
     }
-    ds, err := client.Dataset.Get(context.Background(), curClientSession.UseDatasetId)
+    ds, err := client.Dataset.Get(context.Background(), datasetId)
     if err != nil {
         return store.ManifestParams{}, err
     }
@@ -179,7 +187,7 @@ func (s *agentServer) getManifestParams() (store.ManifestParams, error) {
         UserName:         activeUser.Name,
         OrganizationId:   activeUser.OrganizationId,
         OrganizationName: activeUser.OrganizationName,
-        DatasetId:        curClientSession.UseDatasetId,
+        DatasetId:        datasetId,
         DatasetName:      ds.Content.Name,
     }, nil
 }
@@ -5,7 +5,6 @@ import (
     "encoding/json"
     "os"
     "path/filepath"
-    "sync"
     "testing"
     "time"
 
@@ -13,7 +12,6 @@ import (
     "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
     "github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
     "github.com/pennsieve/pennsieve-agent/v2/pkg/store"
-    "github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/require"
 )
@@ -430,103 +428,3 @@ func newTestPushServer() *agentServer {
 
     return server
 }
-
-type stubManifestService struct {
-    mu         sync.Mutex
-    nextID     int32
-    manifests  map[int32]*store.Manifest
-    addedFiles []store.ManifestFileParams
-}
-
-func newStubManifestService() *stubManifestService {
-    return &stubManifestService{
-        manifests: make(map[int32]*store.Manifest),
-    }
-}
-
-func (s *stubManifestService) Add(params store.ManifestParams) (*store.Manifest, error) {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    s.nextID++
-    manifest := &store.Manifest{
-        Id:          s.nextID,
-        DatasetId:   params.DatasetId,
-        DatasetName: params.DatasetName,
-    }
-    s.manifests[manifest.Id] = manifest
-    return manifest, nil
-}
-
-func (s *stubManifestService) GetManifest(manifestId int32) (*store.Manifest, error) {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    if manifest, ok := s.manifests[manifestId]; ok {
-        return manifest, nil
-    }
-    manifest := &store.Manifest{Id: manifestId}
-    s.manifests[manifestId] = manifest
-    return manifest, nil
-}
-
-func (s *stubManifestService) GetAll() ([]store.Manifest, error) {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    var manifests []store.Manifest
-    for _, m := range s.manifests {
-        manifests = append(manifests, *m)
-    }
-    return manifests, nil
-}
-
-func (s *stubManifestService) RemoveFromManifest(manifestId int32, removePath string) (models.RemoveFromManifestResponse, error) {
-    return models.RemoveFromManifestResponse{}, nil
-}
-
-func (s *stubManifestService) RemoveManifest(manifestId int32) error {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    delete(s.manifests, manifestId)
-    return nil
-}
-
-func (s *stubManifestService) GetFiles(manifestId int32, limit int32, offset int32) ([]store.ManifestFile, error) {
-    return nil, nil
-}
-
-func (s *stubManifestService) ResetStatusForManifest(manifestId int32) error {
-    return nil
-}
-
-func (s *stubManifestService) GetNumberOfRowsForStatus(manifestId int32, statusArr []manifestFile.Status, invert bool) (int64, error) {
-    return 0, nil
-}
-
-func (s *stubManifestService) ManifestFilesToChannel(ctx context.Context, manifestId int32, statusArr []manifestFile.Status, walker chan<- store.ManifestFile) {
-}
-
-func (s *stubManifestService) SyncResponseStatusUpdate(manifestId int32, statusList []manifestFile.FileStatusDTO) error {
-    return nil
-}
-
-func (s *stubManifestService) SetManifestNodeId(m *store.Manifest, nodeId string) error {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    stored, ok := s.manifests[m.Id]
-    if !ok {
-        stored = m
-        s.manifests[m.Id] = stored
-    }
-    stored.NodeId = m.NodeId
-    return nil
-}
-
-func (s *stubManifestService) BatchSetFileStatus(uploadIds []string, status manifestFile.Status) error {
-    return nil
-}
-
-func (s *stubManifestService) AddFiles(records []store.ManifestFileParams) error {
-    s.mu.Lock()
-    defer s.mu.Unlock()
-    s.addedFiles = append(s.addedFiles, records...)
-    return nil
-}
@@ -0,0 +1,286 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// relocatePageSize is the number of manifest files that are read at a time when relocating files.
+const relocatePageSize = 1000
+
+// templateField matches the fields of a path template such as {subject} or {1}.
+var templateField = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*|[0-9]+)\}`)
+
+// relocation is the updated target of a file in a manifest.
+type relocation struct {
+	file       store.ManifestFile
+	targetPath string
+	targetName string
+}
+
+// RelocateManifestFiles changes the target path or name of files in a manifest before they are uploaded.
+//
+// Files are moved from one target path prefix to another, a single file is renamed, or a path template is
+// applied to the files whose source path matches a regular expression. Only files that are not uploaded yet
+// can be relocated. Files that are registered with Pennsieve are marked as changed and the manifest is
+// synchronized again, so the server uses their new target.
+func (s *agentServer) RelocateManifestFiles(ctx context.Context, request *pb.RelocateManifestFilesRequest) (*pb.RelocateManifestFilesResponse, error) {
+
+	if _, err := s.ManifestService().GetManifest(request.GetManifestId()); err != nil {
+		return nil, status.Errorf(codes.NotFound, "manifest %d not found: %v", request.GetManifestId(), err)
+	}
+
+	relocate, err := relocateFunc(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	files, err := s.allManifestFiles(request.GetManifestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list files in manifest %d: %v", request.GetManifestId(), err)
+	}
+
+	var relocations []relocation
+	for _, file := range files {
+		if file.Status == manifestFile.Removed {
+			continue
+		}
+		targetPath, targetName, ok := relocate(file)
+		if !ok || (targetPath == file.TargetPath && targetName == file.TargetName) {
+			continue
+		}
+		relocations = append(relocations, relocation{file: file, targetPath: targetPath, targetName: targetName})
+	}
+
+	if request.GetTargetName() != "" && len(relocations) == 0 {
+		matched := false
+		for _, file := range files {
+			_, _, ok := relocate(file)
+			matched = matched || ok
+		}
+		if !matched {
+			return nil, status.Errorf(codes.NotFound, "no file at %q in manifest %d", request.GetPath(), request.GetManifestId())
+		}
+	}
+
+	if err := checkRelocations(files, relocations); err != nil {
+		return nil, err
+	}
+
+	response := &pb.RelocateManifestFilesResponse{NrFilesUpdated: int32(len(relocations))}
+	var targets []store.ManifestFileTarget
+	for _, r := range relocations {
+		target := store.ManifestFileTarget{
+			Id:             r.file.Id,
+			TargetPath:     r.targetPath,
+			TargetName:     r.targetName,
+			Status:         r.file.Status,
+			PreviousStatus: r.file.Status,
+		}
+		if r.file.Status == manifestFile.Registered {
+			target.Status = manifestFile.Changed
+			response.NrFilesResynced++
+		}
+		targets = append(targets, target)
+
+		if request.GetDryRun() {
+			response.Relocations = append(response.Relocations, &pb.RelocateManifestFilesResponse_Relocation{
+				Id:                r.file.Id,
+				SourcePath:        r.file.SourcePath,
+				TargetPath:        r.file.TargetPath,
+				TargetName:        r.file.TargetName,
+				UpdatedTargetPath: r.targetPath,
+				UpdatedTargetName: r.targetName,
+			})
+		}
+	}
+
+	if request.GetDryRun() || len(targets) == 0 {
+		return response, nil
+	}
+
+	err = s.ManifestService().UpdateFileTargets(request.GetManifestId(), targets)
+	if errors.Is(err, store.ErrManifestFileChanged) {
+		return nil, status.Errorf(codes.Aborted, "files in manifest %d changed while relocating, try again: %v", request.GetManifestId(), err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to update files in manifest %d: %v", request.GetManifestId(), err)
+	}
+	s.messageSubscribers(fmt.Sprintf("Relocated %d files in manifest %d.", len(targets), request.GetManifestId()))
+
+	if response.NrFilesResynced > 0 {
+		if _, err := s.callSyncManifest(ctx, &pb.SyncManifestRequest{ManifestId: request.GetManifestId()}); err != nil {
+			log.Errorf("Unable to synchronize manifest %d after relocating files: %v", request.GetManifestId(), err)
+			s.messageSubscribers(fmt.Sprintf("Unable to synchronize manifest %d; run a sync to update the relocated files.", request.GetManifestId()))
+		}
+	}
+
+	return response, nil
+}
+
+// relocateFunc returns the function that computes the updated target of a file for the request, and
+// whether the file is affected by the request.
+func relocateFunc(request *pb.RelocateManifestFilesRequest) (func(store.ManifestFile) (string, string, bool), error) {
+
+	switch {
+	case request.GetSourcePattern() != "" || request.GetPathTemplate() != "":
+		if request.GetSourcePattern() == "" || request.GetPathTemplate() == "" {
+			return nil, fmt.Errorf("a path template requires a source pattern and vice versa")
+		}
+		pattern, err := regexp.Compile(request.GetSourcePattern())
+		if err != nil {
+			return nil, fmt.Errorf("invalid source pattern: %w", err)
+		}
+		if err := checkPathTemplate(request.GetPathTemplate(), pattern); err != nil {
+			return nil, err
+		}
+
+		return func(file store.ManifestFile) (string, string, bool) {
+			match := pattern.FindStringSubmatch(file.SourcePath)
+			if match == nil {
+				return "", "", false
+			}
+			return applyPathTemplate(request.GetPathTemplate(), pattern, match), file.TargetName, true
+		}, nil
+
+	case request.GetTargetName() != "":
+		name := request.GetTargetName()
+		if strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+			return nil, fmt.Errorf("invalid target name %q", name)
+		}
+		location := normalizeTargetPath(request.GetPath())
+
+		return func(file store.ManifestFile) (string, string, bool) {
+			if file.SourcePath != request.GetPath() && path.Join(file.TargetPath, file.TargetName) != location {
+				return "", "", false
+			}
+			return file.TargetPath, name, true
+		}, nil
+
+	case request.GetPath() != "" || request.GetUpdatedPath() != "":
+		prefix := normalizeTargetPath(request.GetPath())
+		updated := normalizeTargetPath(request.GetUpdatedPath())
+
+		return func(file store.ManifestFile) (string, string, bool) {
+			targetPath := normalizeTargetPath(file.TargetPath)
+			rest, ok := cutPathPrefix(targetPath, prefix)
+			if !ok {
+				return "", "", false
+			}
+			return normalizeTargetPath(path.Join(updated, rest)), file.TargetName, true
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("provide a path to move, a target name, or a source pattern and path template")
+	}
+}
+
+// checkRelocations returns an error if a relocated file cannot be changed anymore, or if files would end up
+// with the same target.
+func checkRelocations(files []store.ManifestFile, relocations []relocation) error {
+
+	relocated := map[int32]relocation{}
+	for _, r := range relocations {
+		switch r.file.Status {
+		case manifestFile.Local, manifestFile.Registered, manifestFile.Changed, manifestFile.Failed, manifestFile.Unknown:
+		default:
+			return status.Errorf(codes.FailedPrecondition, "unable to relocate %s: the file is %s",
+				r.file.SourcePath, strings.ToLower(r.file.Status.String()))
+		}
+		relocated[r.file.Id] = r
+	}
+
+	targets := map[string]string{}
+	for _, file := range files {
+		if file.Status == manifestFile.Removed {
+			continue
+		}
+		target := path.Join(file.TargetPath, file.TargetName)
+		if r, ok := relocated[file.Id]; ok {
+			target = path.Join(r.targetPath, r.targetName)
+		}
+		if other, ok := targets[target]; ok {
+			return status.Errorf(codes.InvalidArgument, "%s and %s would both be uploaded to %s", other, file.SourcePath, target)
+		}
+		targets[target] = file.SourcePath
+	}
+	return nil
+}
+
+// checkPathTemplate returns an error if the template refers to groups that the pattern does not have.
+func checkPathTemplate(template string, pattern *regexp.Regexp) error {
+	for _, field := range templateField.FindAllStringSubmatch(template, -1) {
+		if i, err := strconv.Atoi(field[1]); err == nil {
+			if i > pattern.NumSubexp() {
+				return fmt.Errorf("path template refers to group %d, but the source pattern has %d groups", i, pattern.NumSubexp())
+			}
+		} else if pattern.SubexpIndex(field[1]) < 0 {
+			return fmt.Errorf("path template refers to group %q, which is not in the source pattern", field[1])
+		}
+	}
+	return nil
+}
+
+// applyPathTemplate replaces the fields in the template by the groups of the match.
+func applyPathTemplate(template string, pattern *regexp.Regexp, match []string) string {
+	result := templateField.ReplaceAllStringFunc(template, func(field string) string {
+		name := field[1 : len(field)-1]
+		if i, err := strconv.Atoi(name); err == nil {
+			return match[i]
+		}
+		return match[pattern.SubexpIndex(name)]
+	})
+	return normalizeTargetPath(result)
+}
+
+// normalizeTargetPath returns a clean target path with forward slashes and without leading or trailing slashes.
+func normalizeTargetPath(p string) string {
+	p = strings.ReplaceAll(p, `\`, "/")
+	return strings.Trim(path.Clean("/"+p), "/")
+}
+
+// cutPathPrefix returns the part of the target path after the prefix, if the target path is inside the prefix.
+func cutPathPrefix(targetPath string, prefix string) (string, bool) {
+	if prefix == "" {
+		return targetPath, true
+	}
+	if targetPath == prefix {
+		return "", true
+	}
+	rest, ok := strings.CutPrefix(targetPath, prefix+"/")
+	return rest, ok
+}
+
+// allManifestFiles returns all files in a manifest, reading the manifest a page at a time.
+func (s *agentServer) allManifestFiles(manifestId int32) ([]store.ManifestFile, error) {
+	var files []store.ManifestFile
+	for offset := int32(0); ; offset += relocatePageSize {
+		page, err := s.ManifestService().GetFiles(manifestId, relocatePageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, page...)
+		if len(page) < relocatePageSize {
+			return files, nil
+		}
+	}
+}
+
+func (s *agentServer) callSyncManifest(ctx context.Context, req *pb.SyncManifestRequest) (*pb.SyncManifestResponse, error) {
+	if s.syncManifestOverride != nil {
+		return s.syncManifestOverride(ctx, req)
+	}
+	return s.SyncManifest(ctx, req)
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newRelocateTestServer() (*agentServer, *stubManifestService, *[]int32) {
+	m := newStubManifestService().withFiles([]store.ManifestFile{
+		{Id: 1, SourcePath: "/data/raw/001_01.edf", TargetPath: "raw", TargetName: "001_01.edf", Status: manifestFile.Local},
+		{Id: 2, SourcePath: "/data/raw/001_02.edf", TargetPath: "raw", TargetName: "001_02.edf", Status: manifestFile.Registered},
+		{Id: 3, SourcePath: "/data/raw/notes/readme.txt", TargetPath: "raw/notes", TargetName: "readme.txt", Status: manifestFile.Local},
+		{Id: 4, SourcePath: "/data/rawdata/002_01.edf", TargetPath: "rawdata", TargetName: "002_01.edf", Status: manifestFile.Local},
+	})
+	var synced []int32
+	s := &agentServer{
+		manifest: m,
+		syncManifestOverride: func(ctx context.Context, req *pb.SyncManifestRequest) (*pb.SyncManifestResponse, error) {
+			synced = append(synced, req.ManifestId)
+			return &pb.SyncManifestResponse{}, nil
+		},
+	}
+	return s, m, &synced
+}
+
+func TestRelocateMovesPrefix(t *testing.T) {
+	s, m, synced := newRelocateTestServer()
+
+	response, err := s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw", UpdatedPath: "/source/eeg/",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), response.NrFilesUpdated)
+	assert.Equal(t, int32(1), response.NrFilesResynced)
+	assert.Equal(t, []int32{1}, *synced)
+
+	assert.Equal(t, "source/eeg", m.file(1, 2).TargetPath)
+	assert.Equal(t, manifestFile.Changed, m.file(1, 2).Status, "Expect registered files to be synchronized again.")
+
+	targetPath := m.file(1, 3).TargetPath
+	assert.Equal(t, "source/eeg/notes", targetPath)
+
+	targetPath = m.file(1, 4).TargetPath
+	assert.Equal(t, "rawdata", targetPath, "Expect folders that share a prefix not to be moved.")
+}
+
+func TestRelocateRenamesFile(t *testing.T) {
+	s, m, synced := newRelocateTestServer()
+
+	response, err := s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw/notes/readme.txt", TargetName: "README.md",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), response.NrFilesUpdated)
+	assert.Empty(t, *synced)
+
+	targetName := m.file(1, 3).TargetName
+	assert.Equal(t, "README.md", targetName)
+
+	// Files are also found by their source path
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "/data/raw/001_01.edf", TargetName: "first.edf",
+	})
+	require.NoError(t, err)
+	targetName = m.file(1, 1).TargetName
+	assert.Equal(t, "first.edf", targetName)
+
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw/missing.txt", TargetName: "x.txt",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw/first.edf", TargetName: "001_02.edf",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expect renames that collide with other files to fail.")
+}
+
+func TestRelocateAppliesTemplate(t *testing.T) {
+	s, m, _ := newRelocateTestServer()
+
+	request := &pb.RelocateManifestFilesRequest{
+		ManifestId:    1,
+		SourcePattern: `(?P<subject>\d+)_(\d+)\.edf$`,
+		PathTemplate:  "sub-{subject}/ses-{2}",
+		DryRun:        true,
+	}
+	response, err := s.RelocateManifestFiles(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), response.NrFilesUpdated)
+	require.Len(t, response.Relocations, 3)
+	assert.Equal(t, "sub-001/ses-01", response.Relocations[0].UpdatedTargetPath)
+	assert.Equal(t, "sub-002/ses-01", response.Relocations[2].UpdatedTargetPath)
+
+	targetPath := m.file(1, 1).TargetPath
+	assert.Equal(t, "raw", targetPath, "Expect a dry run not to change files.")
+
+	request.DryRun = false
+	_, err = s.RelocateManifestFiles(context.Background(), request)
+	require.NoError(t, err)
+	targetPath = m.file(1, 1).TargetPath
+	assert.Equal(t, "sub-001/ses-01", targetPath)
+	targetPath = m.file(1, 3).TargetPath
+	assert.Equal(t, "raw/notes", targetPath, "Expect files that do not match the pattern to keep their target.")
+
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, SourcePattern: `(?P<subject>\d+)`, PathTemplate: "sub-{session}",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRelocateUploadedFiles(t *testing.T) {
+	s, m, _ := newRelocateTestServer()
+	m.files[1][0].Status = manifestFile.Finalized
+
+	_, err := s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw", UpdatedPath: "eeg",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	targetPath := m.file(1, 2).TargetPath
+	assert.Equal(t, "raw", targetPath, "Expect no files to change if one of them cannot be relocated.")
+
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{ManifestId: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{ManifestId: 2, Path: "raw"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRelocateFilesChangedConcurrently(t *testing.T) {
+	s, m, synced := newRelocateTestServer()
+	m.updateTargetsErr = fmt.Errorf("%w: file 2 is uploaded", store.ErrManifestFileChanged)
+
+	_, err := s.RelocateManifestFiles(context.Background(), &pb.RelocateManifestFilesRequest{
+		ManifestId: 1, Path: "raw", UpdatedPath: "eeg",
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err), "Expect the relocation to be aborted if a file changed in the meantime.")
+	assert.Empty(t, *synced)
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	models2 "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
+	log "github.com/sirupsen/logrus"
+)
+
+// ResolveConflict resolves a file in a mapped dataset that changed locally and on Pennsieve since it was pulled.
+func (s *agentServer) ResolveConflict(ctx context.Context, req *api.ResolveConflictRequest) (*api.SimpleStatusResponse, error) {
+
+	// Check if the provided path is part of a mapped dataset
+	datasetRoot, found, err := findMappedDatasetRoot(req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &api.SimpleStatusResponse{Status: "The provided path is not part of a Pennsieve mapped dataset."}, nil
+	}
+
+	workspaceManifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	stateFileLocation := filepath.Join(datasetRoot, ".pennsieve", "state.json")
+	defer shared.LockStateFile(stateFileLocation)()
+
+	mapState, err := shared.ReadStateFile(stateFileLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	relLocation, err := filepath.Rel(datasetRoot, filepath.FromSlash(req.Path))
+	if err != nil {
+		return nil, err
+	}
+	relLocation = filepath.ToSlash(relLocation)
+
+	var remote *models2.ManifestDTO
+	for i, f := range workspaceManifest.Files {
+		if f.FileName.Valid && path.Join(f.Path, f.PackageName) == relLocation {
+			remote = &workspaceManifest.Files[i]
+			break
+		}
+	}
+	if remote == nil {
+		return &api.SimpleStatusResponse{Status: "The provided file is not part of the mapped dataset."}, nil
+	}
+
+	recordIndex := -1
+	for i, r := range mapState.Files {
+		if r.Path == relLocation && r.IsLocal {
+			recordIndex = i
+			break
+		}
+	}
+	if recordIndex < 0 {
+		return &api.SimpleStatusResponse{Status: "The provided file has not been pulled."}, nil
+	}
+
+	hasher := newFingerprinter(diffStrengthFromConfig(), mapState.Hashes)
+	conflict, err := hasConflict(datasetRoot, relLocation, mapState.Files[recordIndex], *remote, hasher)
+	if err != nil {
+		return nil, err
+	}
+	if !conflict {
+		return &api.SimpleStatusResponse{Status: "The provided file is not in conflict."}, nil
+	}
+
+	switch req.Resolution {
+	case api.ResolveConflictRequest_KEEP_LOCAL:
+		// Record the current remote file as the pulled version. The local file is then
+		// treated as a regular local change to the latest remote file.
+		mapState.Files[recordIndex].FileId = remote.FileNodeId.String
+		mapState.Files[recordIndex].Size = remote.Size.Int64
+		mapState.Files[recordIndex].CheckSum = remote.CheckSum.String
+
+	case api.ResolveConflictRequest_KEEP_REMOTE:
+		client, err := s.PennsieveClient()
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.pullPackage(client, datasetRoot, newPackageRecord(datasetRoot, *remote), mapState)
+		if err != nil {
+			return nil, err
+		}
+
+	case api.ResolveConflictRequest_KEEP_BOTH:
+		client, err := s.PennsieveClient()
+		if err != nil {
+			return nil, err
+		}
+
+		localCopy, err := keepLocalCopy(filepath.Join(datasetRoot, filepath.FromSlash(relLocation)))
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Renamed local copy of %s to %s", relLocation, localCopy)
+
+		err = s.pullPackage(client, datasetRoot, newPackageRecord(datasetRoot, *remote), mapState)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown resolution: %v", req.Resolution)
+	}
+
+	err = shared.WriteStateFile(stateFileLocation, mapState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.SimpleStatusResponse{Status: "Success"}, nil
+}
+
+// hasConflict returns true if both the local file and the remote file changed since the file was pulled.
+func hasConflict(datasetRoot string, relLocation string, record models.MapStateRecord, remote models2.ManifestDTO, hasher *fingerprinter) (bool, error) {
+	if !remoteChangedSincePull(record, remote) {
+		return false, nil
+	}
+
+	same, err := hasher.matchesPulled(datasetRoot, relLocation, record)
+	if err != nil {
+		return false, err
+	}
+
+	return !same, nil
+}
+
+// keepLocalCopy renames a file to "<name> (local)<ext>" and returns the new location.
+// A number is added to the name if a local copy already exists.
+func keepLocalCopy(location string) (string, error) {
+	ext := filepath.Ext(location)
+	base := strings.TrimSuffix(location, ext)
+
+	target := fmt.Sprintf("%s (local)%s", base, ext)
+	for i := 2; ; i++ {
+		found, err := exists(target)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			break
+		}
+		target = fmt.Sprintf("%s (local %d)%s", base, i, ext)
+	}
+
+	if err := os.Rename(location, target); err != nil {
+		return "", err
+	}
+
+	return target, nil
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveConflict_KeepLocal(t *testing.T) {
+	datasetRoot, _ := writeConflictDataset(t, "locally changed content")
+	s := &agentServer{}
+
+	resp, err := s.ResolveConflict(context.Background(), &api.ResolveConflictRequest{
+		Path:       filepath.Join(datasetRoot, "file_1.txt"),
+		Resolution: api.ResolveConflictRequest_KEEP_LOCAL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Success", resp.Status)
+
+	state, err := shared.ReadStateFile(filepath.Join(datasetRoot, ".pennsieve", "state.json"))
+	require.NoError(t, err)
+	require.Len(t, state.Files, 1)
+	assert.Equal(t, "remote-checksum-2", state.Files[0].CheckSum)
+	assert.Equal(t, int64(40), state.Files[0].Size)
+
+	// The file is now a regular local change on top of the latest remote file.
+	files, err := createFolderManifest(datasetRoot)
+	require.NoError(t, err)
+	manifest, err := shared.ReadWorkspaceManifest(filepath.Join(datasetRoot, ".pennsieve", "manifest.json"))
+	require.NoError(t, err)
+	result, err := compareManifestToFolder(datasetRoot, manifest.Files, files, newFingerprinter(api.MapDiffRequest_FAST, nil))
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, api.PackageStatus_CHANGED, result[0].Type)
+
+	// Resolving again reports that the file is no longer in conflict.
+	resp, err = s.ResolveConflict(context.Background(), &api.ResolveConflictRequest{
+		Path:       filepath.Join(datasetRoot, "file_1.txt"),
+		Resolution: api.ResolveConflictRequest_KEEP_LOCAL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "The provided file is not in conflict.", resp.Status)
+}
+
+func TestKeepLocalCopy(t *testing.T) {
+	dir := t.TempDir()
+	location := filepath.Join(dir, "recording.edf")
+	require.NoError(t, os.WriteFile(location, []byte("first"), 0644))
+
+	target, err := keepLocalCopy(location)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "recording (local).edf"), target)
+	assert.NoFileExists(t, location)
+
+	require.NoError(t, os.WriteFile(location, []byte("second"), 0644))
+	target, err = keepLocalCopy(location)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "recording (local 2).edf"), target)
+}
@@ -4,12 +4,21 @@ import (
 	"context"
 	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"time"
 )
 
+// Stop stops the agent. Unless forced, active uploads are drained and local state is flushed before
+// the server stops; the agent stops in the background after the response is sent.
 func (s *agentServer) Stop(ctx context.Context, request *pb.StopRequest) (*pb.StopResponse, error) {
 
+	timeout := time.Duration(request.GetTimeout()) * time.Second
+	if timeout <= 0 {
+		timeout = time.Duration(viper.GetInt("agent.shutdown_timeout")) * time.Second
+	}
+
 	log.Info("Stopping Agent Server.")
-	go s.grpcServer.Stop()
+	go s.shutdown(request.GetForce(), timeout)
 
 	return &pb.StopResponse{Success: true}, nil
 }
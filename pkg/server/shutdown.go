@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadFlushTimeout bounds how long a shutdown waits for cancelled upload sessions to
+// flush their status updates and pending finalize calls.
+const uploadFlushTimeout = 30 * time.Second
+
+// StartBackgroundTasks launches the reconciler and the timeseries cache evictor. They run
+// until the provided context is cancelled or the agent is stopped.
+func (s *agentServer) StartBackgroundTasks(ctx context.Context) {
+	ctx, s.stopBackground = context.WithCancel(ctx)
+
+	// Workflow runs do not survive a restart of the agent.
+	if n, err := s.WorkflowRunStore().FailInterruptedRuns(ctx); err != nil {
+		log.Errorf("Unable to update interrupted workflow runs: %v", err)
+	} else if n > 0 {
+		log.Infof("Marked %d interrupted workflow runs as failed.", n)
+	}
+
+	// Neither do sync and upload sessions, so active manifests move to the state of their files.
+	if n, err := s.ManifestStore().ResetInterruptedStates(); err != nil {
+		log.Errorf("Unable to update the state of interrupted manifests: %v", err)
+	} else if n > 0 {
+		log.Infof("Updated the state of %d manifests with interrupted sessions.", n)
+	}
+
+	s.background.Add(2)
+	go func() {
+		defer s.background.Done()
+		s.StartReconciler(ctx)
+	}()
+	go func() {
+		defer s.background.Done()
+		s.StartTimeseriesCacheEvictor(ctx)
+	}()
+}
+
+// stopBackgroundTasks stops the reconciler and the timeseries cache evictor and waits for them to exit.
+func (s *agentServer) stopBackgroundTasks() {
+	if s.stopBackground != nil {
+		s.stopBackground()
+	}
+	s.background.Wait()
+}
+
+// startUploadSession registers a new upload session, or returns an error if the agent is stopping.
+// Callers must call s.uploads.Done() once the session has flushed its status updates.
+func (s *agentServer) startUploadSession() error {
+	s.shutdownMu.Lock()
+	defer s.shutdownMu.Unlock()
+
+	if s.draining {
+		return status.Error(codes.Unavailable, "the agent is shutting down and does not accept new uploads")
+	}
+	s.uploads.Add(1)
+	return nil
+}
+
+// shutdown stops the agent.
+//
+// A graceful shutdown stops accepting uploads and workflows and waits up to the timeout for active
+// upload sessions to finish. Sessions that are still running are cancelled; their remaining files keep
+// their status and are picked up by the next upload. Active workflow runs are cancelled. Once all
+// sessions have flushed their status updates and finalize calls and all workflow runs have stored
+// their result, the background tasks are stopped and the gRPC server stops gracefully, falling back
+// to a hard stop if open requests do not finish within the timeout.
+//
+// A forced shutdown stops the gRPC server immediately.
+func (s *agentServer) shutdown(force bool, timeout time.Duration) {
+	if force {
+		log.Info("Forcing Agent Server to stop.")
+		s.grpcServer.Stop()
+		return
+	}
+
+	s.shutdownMu.Lock()
+	s.draining = true
+	s.shutdownMu.Unlock()
+
+	s.cancelWorkflowRuns()
+
+	uploadsDone := make(chan struct{})
+	go func() {
+		s.uploads.Wait()
+		s.workflows.Wait()
+		close(uploadsDone)
+	}()
+
+	select {
+	case <-uploadsDone:
+	case <-time.After(timeout):
+		log.Warn("Active uploads did not finish before the shutdown timeout; cancelling them.")
+		s.messageSubscribers("Agent is stopping: cancelling active uploads.")
+		s.cancelFncs.Range(func(k, v any) bool {
+			v.(uploadSession).cancelFnc()
+			return true
+		})
+
+		select {
+		case <-uploadsDone:
+		case <-time.After(uploadFlushTimeout):
+			log.Error("Cancelled uploads did not flush their status before the shutdown timeout.")
+		}
+	}
+
+	s.stopBackgroundTasks()
+	s.closeSubscribers()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		log.Warn("Open requests did not finish before the shutdown timeout; stopping Agent Server.")
+		s.grpcServer.Stop()
+	}
+
+	log.Info("Agent Server stopped.")
+}
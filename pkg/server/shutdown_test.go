@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startTestServer serves an agent server on a random port and returns a channel
+// that is closed when Serve returns.
+func startTestServer(t *testing.T) (*agentServer, <-chan struct{}) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	s, _ := NewAgentServer(grpcServer)
+
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return s, served
+}
+
+func TestGracefulShutdownDrainsUploads(t *testing.T) {
+	s, served := startTestServer(t)
+
+	backgroundStopped := make(chan struct{})
+	s.stopBackground = func() { close(backgroundStopped) }
+
+	require.NoError(t, s.startUploadSession())
+	go s.shutdown(false, 5*time.Second)
+
+	// New uploads are rejected while the agent drains
+	require.Eventually(t, func() bool {
+		return status.Code(s.startUploadSession()) == codes.Unavailable
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case <-served:
+		t.Fatal("Expect server to keep running while an upload is active.")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	s.uploads.Done()
+
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect server to stop after the upload finished.")
+	}
+
+	select {
+	case <-backgroundStopped:
+	default:
+		t.Fatal("Expect background tasks to be stopped.")
+	}
+}
+
+func TestGracefulShutdownCancelsUploadsAfterTimeout(t *testing.T) {
+	s, served := startTestServer(t)
+
+	require.NoError(t, s.startUploadSession())
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancelFncs.Store(int32(1), uploadSession{manifestId: 1, cancelFnc: cancel})
+
+	// The upload session flushes its status once it is cancelled
+	go func() {
+		<-ctx.Done()
+		s.uploads.Done()
+	}()
+
+	go s.shutdown(false, 50*time.Millisecond)
+
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect server to stop after the upload was cancelled.")
+	}
+	assert.Error(t, ctx.Err())
+}
+
+func TestForcedShutdown(t *testing.T) {
+	s, served := startTestServer(t)
+
+	require.NoError(t, s.startUploadSession())
+	defer s.uploads.Done()
+
+	go s.shutdown(true, time.Minute)
+
+	select {
+	case <-served:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect forced shutdown to stop the server immediately.")
+	}
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+)
+
+// stubManifestService keeps manifests, their files and the created manifests and added files in memory.
+type stubManifestService struct {
+	mu         sync.Mutex
+	nextID     int32
+	manifests  map[int32]*store.Manifest
+	files      map[int32][]store.ManifestFile
+	created    []store.ManifestParams
+	addedFiles []store.ManifestFileParams
+	metadata   map[string]map[string]string
+	counts     map[int32]map[manifestFile.Status]int64
+	filter     store.ManifestFileFilter
+
+	addFilesErr      error
+	updateTargetsErr error
+}
+
+func newStubManifestService() *stubManifestService {
+	return &stubManifestService{
+		manifests: make(map[int32]*store.Manifest),
+		files:     make(map[int32][]store.ManifestFile),
+		counts:    make(map[int32]map[manifestFile.Status]int64),
+	}
+}
+
+// withFiles adds manifest 1 of dataset N:dataset:1 with the given files.
+func (s *stubManifestService) withFiles(files []store.ManifestFile) *stubManifestService {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.manifests[1] = &store.Manifest{Id: 1, DatasetId: "N:dataset:1", DatasetName: "dataset"}
+	s.files[1] = files
+	s.nextID = max(s.nextID, 1)
+	return s
+}
+
+func (s *stubManifestService) Add(params store.ManifestParams) (*store.Manifest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	manifest := &store.Manifest{
+		Id:          s.nextID,
+		DatasetId:   params.DatasetId,
+		DatasetName: params.DatasetName,
+	}
+	s.manifests[manifest.Id] = manifest
+	s.created = append(s.created, params)
+	return manifest, nil
+}
+
+func (s *stubManifestService) GetManifest(manifestId int32) (*store.Manifest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if manifest, ok := s.manifests[manifestId]; ok {
+		return manifest, nil
+	}
+	return nil, fmt.Errorf("manifest %d not found", manifestId)
+}
+
+func (s *stubManifestService) GetAll() ([]store.Manifest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var manifests []store.Manifest
+	for _, m := range s.manifests {
+		manifests = append(manifests, *m)
+	}
+	slices.SortFunc(manifests, func(a, b store.Manifest) int { return int(a.Id - b.Id) })
+	return manifests, nil
+}
+
+func (s *stubManifestService) RemoveFromManifest(manifestId int32, removePath string) (models.RemoveFromManifestResponse, error) {
+	return models.RemoveFromManifestResponse{}, nil
+}
+
+func (s *stubManifestService) RemoveManifest(manifestId int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.manifests, manifestId)
+	return nil
+}
+
+func (s *stubManifestService) GetFiles(manifestId int32, limit int32, offset int32) ([]store.ManifestFile, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := s.files[manifestId]
+	if int(offset) >= len(files) {
+		return nil, nil
+	}
+	return files[offset:min(int(offset+limit), len(files))], nil
+}
+
+func (s *stubManifestService) ResetStatusForManifest(manifestId int32) error {
+	return nil
+}
+
+func (s *stubManifestService) GetNumberOfRowsForStatus(manifestId int32, statusArr []manifestFile.Status, invert bool) (int64, error) {
+	return 0, nil
+}
+
+func (s *stubManifestService) ManifestFilesToChannel(ctx context.Context, manifestId int32, statusArr []manifestFile.Status, walker chan<- store.ManifestFile) {
+}
+
+func (s *stubManifestService) SyncResponseStatusUpdate(manifestId int32, statusList []manifestFile.FileStatusDTO) error {
+	return nil
+}
+
+func (s *stubManifestService) SetManifestNodeId(m *store.Manifest, nodeId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.manifests[m.Id]
+	if !ok {
+		stored = m
+		s.manifests[m.Id] = stored
+	}
+	stored.NodeId = m.NodeId
+	return nil
+}
+
+func (s *stubManifestService) BatchSetFileStatus(uploadIds []string, status manifestFile.Status) error {
+	return nil
+}
+
+func (s *stubManifestService) AddFiles(records []store.ManifestFileParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.addFilesErr != nil {
+		return s.addFilesErr
+	}
+	s.addedFiles = append(s.addedFiles, records...)
+	return nil
+}
+
+func (s *stubManifestService) UpdateFileTargets(manifestId int32, targets []store.ManifestFileTarget) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.updateTargetsErr != nil {
+		return s.updateTargetsErr
+	}
+	files := s.files[manifestId]
+	for _, target := range targets {
+		i := slices.IndexFunc(files, func(f store.ManifestFile) bool { return f.Id == target.Id })
+		if i < 0 {
+			return fmt.Errorf("file %d is not in manifest %d", target.Id, manifestId)
+		}
+		if files[i].Status != target.PreviousStatus {
+			return store.ErrManifestFileChanged
+		}
+	}
+	for _, target := range targets {
+		i := slices.IndexFunc(files, func(f store.ManifestFile) bool { return f.Id == target.Id })
+		files[i].TargetPath = target.TargetPath
+		files[i].TargetName = target.TargetName
+		files[i].Status = target.Status
+	}
+	return nil
+}
+
+// file returns a file of a manifest.
+func (s *stubManifestService) file(manifestId int32, id int32) store.ManifestFile {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.files[manifestId] {
+		if f.Id == id {
+			return f
+		}
+	}
+	return store.ManifestFile{}
+}
+
+func (s *stubManifestService) SetFileMetadata(manifestId int32, metadata map[string]map[string]string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metadata = metadata
+	var missing []string
+	for path := range metadata {
+		found := slices.ContainsFunc(s.files[manifestId], func(f store.ManifestFile) bool { return f.SourcePath == path }) ||
+			slices.ContainsFunc(s.addedFiles, func(f store.ManifestFileParams) bool { return f.ManifestId == manifestId && f.SourcePath == path })
+		if !found {
+			missing = append(missing, path)
+		}
+	}
+	return missing, nil
+}
+
+func (s *stubManifestService) GetStatusCounts(manifestId int32) (map[manifestFile.Status]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.counts[manifestId], nil
+}
+
+func (s *stubManifestService) UpdateState(manifestId int32, event models.ManifestEvent) (models.ManifestState, error) {
+	return models.ManifestIndexed, nil
+}
+
+// FindFiles records the filter and returns the files of the manifest with one of its statuses; paths are not matched.
+func (s *stubManifestService) FindFiles(manifestId int32, filter store.ManifestFileFilter) ([]store.ManifestFile, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filter = filter
+	var files []store.ManifestFile
+	for _, f := range s.files[manifestId] {
+		if len(filter.Statuses) == 0 || slices.Contains(filter.Statuses, f.Status) {
+			files = append(files, f)
+		}
+	}
+	total := int64(len(files))
+	files = files[min(int(filter.Offset), len(files)):]
+	if filter.Limit > 0 {
+		files = files[:min(int(filter.Limit), len(files))]
+	}
+	return files, total, nil
+}
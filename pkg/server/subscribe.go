@@ -35,7 +35,7 @@ func (s *agentServer) Subscribe(request *pb.SubscribeRequest, stream pb.Agent_Su
 
 	fin := make(chan bool)
 	// Save the subscriber Stream according to the given client ID
-	s.subscribers.Store(request.Id, shared.Sub{Stream: stream, Finished: fin})
+	s.subscribers.Store(request.Id, shared.NewSub(stream, fin))
 
 	ctx := stream.Context()
 	// Keep finishedpe alive because once this scope exits - the Stream is closed
@@ -73,3 +73,56 @@ func (s *agentServer) Unsubscribe(ctx context.Context, request *pb.SubscribeRequ
 	s.subscribers.Delete(request.Id)
 	return &pb.SubscribeResponse{}, nil
 }
+
+// sendSubscribers sends a message to all grpc-update subscribers. Clients to which the message cannot be
+// sent are unsubscribed.
+func (s *agentServer) sendSubscribers(resp *pb.SubscribeResponse) {
+	// A list of clients to unsubscribe in case of error
+	var unsubscribe []int32
+
+	// Iterate over all subscribers and send data to each client
+	s.subscribers.Range(func(k, v any) bool {
+		id, ok := k.(int32)
+		if !ok {
+			log.Error(fmt.Sprintf("Failed to cast subscriber key: %T", k))
+			return false
+		}
+		sub, ok := v.(shared.Sub)
+		if !ok {
+			log.Error(fmt.Sprintf("Failed to cast subscriber value: %T", v))
+			return false
+		}
+		// Send data over the gRPC Stream to the client
+		if err := sub.Send(resp); err != nil {
+			log.Warn(fmt.Sprintf("Failed to send data to client: %v", err))
+			select {
+			case sub.Finished <- true:
+				log.Info(fmt.Sprintf("Unsubscribed client: %d", id))
+			default:
+				// Default case is to avoid blocking in case client has already unsubscribed
+			}
+			// In case of error the client would re-subscribe so close the subscriber Stream
+			unsubscribe = append(unsubscribe, id)
+		}
+		return true
+	})
+
+	// Unsubscribe erroneous client streams
+	for _, id := range unsubscribe {
+		s.subscribers.Delete(id)
+	}
+}
+
+// closeSubscribers ends the streams of all subscribed clients so the gRPC server can stop gracefully.
+func (s *agentServer) closeSubscribers() {
+	s.subscribers.Range(func(k, v any) bool {
+		if sub, ok := v.(shared.Sub); ok {
+			select {
+			case sub.Finished <- true:
+			default:
+			}
+		}
+		s.subscribers.Delete(k)
+		return true
+	})
+}
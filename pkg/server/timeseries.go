@@ -5,9 +5,11 @@ import (
 	"fmt"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/service"
 	log "github.com/sirupsen/logrus"
+	"math"
+	"path/filepath"
 	"slices"
-	"sync"
 )
 
 func (s *agentServer) GetTimeseriesChannels(ctx context.Context, req *api.GetTimeseriesChannelsRequest) (*api.GetTimeseriesChannelsResponse, error) {
@@ -21,12 +23,15 @@ func (s *agentServer) GetTimeseriesChannels(ctx context.Context, req *api.GetTim
 	var response []*api.TimeseriesChannel
 	for _, channel := range channels {
 		ch := api.TimeseriesChannel{
-			Id:        channel.ChannelNodeId,
-			Name:      channel.Name,
-			StartTime: uint64(channel.Start),
-			EndTime:   uint64(channel.End),
-			Unit:      channel.Unit,
-			Rate:      float32(channel.Rate),
+			Id:         channel.ChannelNodeId,
+			Name:       channel.Name,
+			StartTime:  uint64(channel.Start),
+			EndTime:    uint64(channel.End),
+			Unit:       channel.Unit,
+			Rate:       float32(channel.Rate),
+			Group:      channel.Group,
+			Type:       channel.Type,
+			Properties: channel.Properties,
 		}
 
 		response = append(response, &ch)
@@ -43,19 +48,20 @@ func (s *agentServer) GetTimeseriesRangeForChannels(req *api.GetTimeseriesRangeR
 	ctx := context.Background()
 
 	tsService := s.TimeseriesService()
+	encoding := service.RangeEncoding{Default: req.Encoding, Channels: req.ChannelEncodings}
 
 	// If channelIds not provided, then get all channels
 	channels := req.ChannelIds
 	minStartTime := 0
 
-	response, err := tsService.GetChannelsForPackage(ctx, req.GetDatasetId(), req.GetPackageId(), req.Refresh)
+	response, err := tsService.GetChannelsForPackage(ctx, req.GetDatasetId(), req.GetPackageId(), req.Refresh && !req.Offline)
 
 	if len(channels) == 0 {
 		log.Info("No Channels Supplied --> Returning all channels")
 		for _, ch := range response {
 
 			// Sending channel info to client.
-			err = tsService.StreamChannelInfoToClient(ctx, ch, stream)
+			err = tsService.StreamChannelInfoToClient(ctx, ch, encoding.ForChannel(ch.ChannelNodeId), stream)
 			if err != nil {
 				log.Error("Error streaming channel info to client: ", err)
 			}
@@ -72,7 +78,7 @@ func (s *agentServer) GetTimeseriesRangeForChannels(req *api.GetTimeseriesRangeR
 				minStartTime = int(ch.Start)
 
 				// Sending channel info to client.
-				err = tsService.StreamChannelInfoToClient(ctx, ch, stream)
+				err = tsService.StreamChannelInfoToClient(ctx, ch, encoding.ForChannel(ch.ChannelNodeId), stream)
 				if err != nil {
 					log.Error("Error streaming channel info to client: ", err)
 				}
@@ -95,31 +101,48 @@ func (s *agentServer) GetTimeseriesRangeForChannels(req *api.GetTimeseriesRangeR
 	}
 	log.Debug("use time: ", useStartTime, useEndTime)
 
-	// Create WaitGroup: The waitgroup is canceled when all blocks are streamed to client
-	var streamWg sync.WaitGroup
-	streamWg.Add(1)
+	// Use the service to get the blocks for range and put them on channel once available locally.
+	// The coverage of the range is sent on coverageChannel when all blocks are sent, or when the
+	// range context is cancelled.
+	rangeCtx, cancelRange := context.WithCancel(ctx)
+	defer cancelRange()
 
-	// Use the service to get the blocks for range and put them on channel once available locally
+	coverageChannel := make(chan models.TsCoverage, 1)
 	go func() {
 		defer close(rangeChannel)
 
 		// TODO: Update service to take a list of channels
-		err := tsService.GetRangeBlocksForChannels(
-			ctx, req.DatasetId, req.PackageId, channels, useStartTime, useEndTime, rangeChannel)
+		coverage, err := tsService.GetRangeBlocksForChannels(
+			rangeCtx, req.DatasetId, req.PackageId, channels, useStartTime, useEndTime, req.ReadAhead, req.Offline, rangeChannel)
 		if err != nil {
 			log.Error("GetTimeseriesRangeForChannels err: ", err)
 		}
-
+		coverageChannel <- coverage
 	}()
 
 	// Use the service to grab local blocks of data and Stream to client over gRPC
-	go func() {
-		tsService.StreamBlocksToClient(ctx, rangeChannel, useStartTime, useEndTime, stream)
-		defer streamWg.Done()
-	}()
+	decimation := service.NewDecimation(req.Decimation, useStartTime, useEndTime, req.PixelWidth, req.SamplePeriod)
+	streamErr := tsService.StreamBlocksToClient(ctx, rangeChannel, useStartTime, useEndTime, decimation, encoding, stream)
+
+	// If streaming stopped early, the remaining blocks are not read, so the range is cancelled before
+	// waiting for the coverage.
+	cancelRange()
+	coverage := <-coverageChannel
+	if streamErr != nil {
+		log.Error("Error streaming blocks to client: ", streamErr)
+		return streamErr
+	}
 
-	// Wait for WaitGroup to be Done to keep gRPC Stream open until all data is sent to client.
-	streamWg.Wait()
+	err = stream.Send(&api.GetTimeseriesRangeResponse{
+		Type: api.GetTimeseriesRangeResponse_RANGE_STATUS,
+		MessageData: &api.GetTimeseriesRangeResponse_Status{Status: &api.GetTimeseriesRangeResponse_RangeStatus{
+			Offline: coverage.Offline,
+			Partial: coverage.Partial,
+		}},
+	})
+	if err != nil {
+		log.Error("Error streaming range status to client: ", err)
+	}
 	log.Debug("returning from function")
 
 	return nil
@@ -146,3 +169,230 @@ func (s *agentServer) ResetCache(ctx context.Context, req *api.ResetCacheRequest
 	response := api.SimpleStatusResponse{Status: "Success"}
 	return &response, nil
 }
+
+func (s *agentServer) GetTimeseriesCacheStats(ctx context.Context, req *api.GetTimeseriesCacheStatsRequest) (*api.GetTimeseriesCacheStatsResponse, error) {
+
+	cache := s.TimeseriesCache()
+	stats, err := cache.Stats(ctx)
+	if err != nil {
+		log.Error("GetTimeseriesCacheStats err: ", err)
+		return nil, err
+	}
+
+	response := api.GetTimeseriesCacheStatsResponse{MaxSize: cache.MaxSize()}
+	for _, st := range stats {
+		response.TotalSize += st.Size
+		response.Packages = append(response.Packages, &api.GetTimeseriesCacheStatsResponse_PackageCacheStats{
+			PackageId:  st.PackageNodeId,
+			Blocks:     st.Blocks,
+			Size:       st.Size,
+			LastAccess: st.LastAccess,
+			Pinned:     st.Pinned,
+		})
+	}
+
+	return &response, nil
+}
+
+func (s *agentServer) ExportTimeseries(ctx context.Context, req *api.ExportTimeseriesRequest) (*api.ExportTimeseriesResponse, error) {
+
+	log.Info(fmt.Sprintf("ExportTimeseries called %s - %s (%s)", req.DatasetId, req.PackageId, req.Format))
+
+	if !filepath.IsAbs(req.TargetPath) {
+		return &api.ExportTimeseriesResponse{Status: "The target path must be an absolute path."}, nil
+	}
+
+	tsService := s.TimeseriesService()
+	channels, minStartTime, err := selectChannels(ctx, tsService, req.DatasetId, req.PackageId, req.ChannelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(channels) == 0 {
+		return &api.ExportTimeseriesResponse{Status: "No matching channels found for the package."}, nil
+	}
+
+	useStartTime, useEndTime := rangeTimes(req.StartTime, req.EndTime, req.RelativeTime, minStartTime)
+
+	files, samples, err := tsService.ExportRange(
+		ctx, req.DatasetId, req.PackageId, channels, useStartTime, useEndTime, req.Format, req.TargetPath)
+	if err != nil {
+		log.Error("ExportTimeseries err: ", err)
+		return nil, err
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Exported %d samples of %d channel(s) to %s", samples, len(channels), req.TargetPath))
+
+	return &api.ExportTimeseriesResponse{
+		Status:  "Success",
+		Files:   files,
+		Samples: samples,
+	}, nil
+}
+
+func (s *agentServer) PinTimeseries(ctx context.Context, req *api.PinTimeseriesRequest) (*api.PinTimeseriesResponse, error) {
+
+	log.Info(fmt.Sprintf("PinTimeseries called %s - %s", req.DatasetId, req.PackageId))
+
+	blocks, downloaded, err := s.TimeseriesService().PinPackage(ctx, req.DatasetId, req.PackageId)
+	if err != nil {
+		log.Error("PinTimeseries err: ", err)
+		return nil, err
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Pinned %s for offline use: downloaded %d of %d block(s)", req.PackageId, downloaded, blocks))
+
+	return &api.PinTimeseriesResponse{
+		Status:     "Success",
+		Blocks:     blocks,
+		Downloaded: downloaded,
+	}, nil
+}
+
+func (s *agentServer) UnpinTimeseries(ctx context.Context, req *api.PinTimeseriesRequest) (*api.SimpleStatusResponse, error) {
+
+	err := s.TimeseriesService().UnpinPackage(ctx, req.PackageId)
+	if err != nil {
+		log.Error("UnpinTimeseries err: ", err)
+		return nil, err
+	}
+
+	return &api.SimpleStatusResponse{Status: "Success"}, nil
+}
+
+func (s *agentServer) GetTimeseriesGaps(ctx context.Context, req *api.GetTimeseriesGapsRequest) (*api.GetTimeseriesGapsResponse, error) {
+
+	log.Info(fmt.Sprintf("GetTimeseriesGaps called %s - %s", req.DatasetId, req.PackageId))
+
+	tsService := s.TimeseriesService()
+	channels, minStartTime, err := selectChannels(ctx, tsService, req.DatasetId, req.PackageId, req.ChannelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	useStartTime, useEndTime := rangeTimes(req.StartTime, req.EndTime, req.RelativeTime, minStartTime)
+
+	var channelIds []string
+	for _, ch := range channels {
+		channelIds = append(channelIds, ch.ChannelNodeId)
+	}
+
+	gaps, err := tsService.GetGapsForChannels(ctx, req.DatasetId, req.PackageId, channelIds, useStartTime, useEndTime)
+	if err != nil {
+		log.Error("GetTimeseriesGaps err: ", err)
+		return nil, err
+	}
+
+	// Summarize the gaps per channel, including channels without gaps
+	response := api.GetTimeseriesGapsResponse{}
+	summaries := make(map[string]*api.GetTimeseriesGapsResponse_ChannelGaps)
+	for _, ch := range channels {
+		summary := &api.GetTimeseriesGapsResponse_ChannelGaps{
+			ChannelId: ch.ChannelNodeId,
+			Name:      ch.Name,
+		}
+		summaries[ch.ChannelNodeId] = summary
+		response.Channels = append(response.Channels, summary)
+	}
+
+	for _, gap := range gaps {
+		summary, ok := summaries[gap.ChannelNodeId]
+		if !ok {
+			continue
+		}
+
+		summary.Gaps = append(summary.Gaps, &api.GetTimeseriesGapsResponse_Gap{
+			Start: uint64(gap.StartTime),
+			End:   uint64(gap.EndTime),
+		})
+		summary.TotalDuration += uint64(gap.EndTime - gap.StartTime)
+	}
+
+	return &response, nil
+}
+
+func (s *agentServer) GetTimeseriesAnnotations(ctx context.Context, req *api.GetTimeseriesAnnotationsRequest) (*api.GetTimeseriesAnnotationsResponse, error) {
+
+	log.Info(fmt.Sprintf("GetTimeseriesAnnotations called %s - %s", req.DatasetId, req.PackageId))
+
+	tsService := s.TimeseriesService()
+
+	useStartTime, useEndTime := uint64(req.StartTime), uint64(req.EndTime)
+	if req.RelativeTime {
+		_, minStartTime, err := selectChannels(ctx, tsService, req.DatasetId, req.PackageId, nil)
+		if err != nil {
+			return nil, err
+		}
+		useStartTime, useEndTime = rangeTimes(req.StartTime, req.EndTime, true, minStartTime)
+	}
+	if req.EndTime <= req.StartTime {
+		useEndTime = math.MaxInt64
+	}
+
+	layers, annotations, offline, err := tsService.GetAnnotationsForPackage(
+		ctx, req.DatasetId, req.PackageId, req.LayerIds, req.ChannelIds, useStartTime, useEndTime, req.Refresh)
+	if err != nil {
+		log.Error("GetTimeseriesAnnotations err: ", err)
+		return nil, err
+	}
+
+	response := api.GetTimeseriesAnnotationsResponse{Offline: offline}
+	for _, layer := range layers {
+		response.Layers = append(response.Layers, &api.GetTimeseriesAnnotationsResponse_Layer{
+			Id:          layer.LayerId,
+			Name:        layer.Name,
+			Description: layer.Description,
+			Color:       layer.Color,
+		})
+	}
+
+	for _, annotation := range annotations {
+		response.Annotations = append(response.Annotations, &api.GetTimeseriesAnnotationsResponse_Annotation{
+			Id:          annotation.AnnotationId,
+			LayerId:     annotation.LayerId,
+			ChannelIds:  annotation.ChannelNodeIds,
+			Name:        annotation.Name,
+			Label:       annotation.Label,
+			Description: annotation.Description,
+			Start:       uint64(annotation.StartTime),
+			End:         uint64(annotation.EndTime),
+		})
+	}
+
+	return &response, nil
+}
+
+// selectChannels returns the requested channels of the package, or all channels if none are requested,
+// and the earliest start time of the returned channels.
+func selectChannels(ctx context.Context, tsService service.TimeseriesService, datasetId string, packageId string,
+	channelIds []string) ([]models.TsChannel, int64, error) {
+
+	response, err := tsService.GetChannelsForPackage(ctx, datasetId, packageId, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var channels []models.TsChannel
+	var minStartTime int64
+	for _, ch := range response {
+		if len(channelIds) > 0 && !slices.Contains(channelIds, ch.ChannelNodeId) {
+			continue
+		}
+
+		if len(channels) == 0 || ch.Start < minStartTime {
+			minStartTime = ch.Start
+		}
+		channels = append(channels, ch)
+	}
+
+	return channels, minStartTime, nil
+}
+
+// rangeTimes returns the requested range in uUTC. Relative times are in seconds from minStartTime.
+func rangeTimes(startTime float64, endTime float64, relativeTime bool, minStartTime int64) (uint64, uint64) {
+	if relativeTime {
+		return uint64(startTime*1e6) + uint64(minStartTime), uint64(endTime*1e6) + uint64(minStartTime)
+	}
+
+	return uint64(startTime), uint64(endTime)
+}
@@ -0,0 +1,17 @@
+package server
+
+import (
+	"context"
+	"time"
+)
+
+// timeseriesCacheEvictionInterval is the period between checks of the timeseries
+// cache size. The evictor also runs whenever new blocks are downloaded.
+const timeseriesCacheEvictionInterval = 5 * time.Minute
+
+// StartTimeseriesCacheEvictor launches the timeseries cache evictor on the given
+// context and blocks until the context is cancelled. Like the reconciler, it is
+// intended to be invoked in its own goroutine from the agent's startup path.
+func (s *agentServer) StartTimeseriesCacheEvictor(ctx context.Context) {
+	s.TimeseriesCache().Run(ctx, timeseriesCacheEvictionInterval)
+}
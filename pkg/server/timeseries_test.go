@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// streamingTimeseriesService sends blocks until the range request is cancelled, and fails to stream them.
+type streamingTimeseriesService struct {
+	service.TimeseriesService
+	cancelled chan struct{}
+}
+
+func (s *streamingTimeseriesService) GetChannelsForPackage(ctx context.Context, datasetNodeId string, packageNodeId string, refresh bool) ([]models.TsChannel, error) {
+	return nil, nil
+}
+
+func (s *streamingTimeseriesService) GetRangeBlocksForChannels(ctx context.Context, datasetNodeId string, packageNodeId string,
+	channelNodeIds []string, startTime uint64, endTime uint64, readAhead bool, offline bool, rangeChannel chan<- models.TsBlock) (models.TsCoverage, error) {
+	for {
+		select {
+		case rangeChannel <- models.TsBlock{BlockNodeId: "block"}:
+		case <-ctx.Done():
+			close(s.cancelled)
+			return models.TsCoverage{}, ctx.Err()
+		}
+	}
+}
+
+func (s *streamingTimeseriesService) StreamBlocksToClient(ctx context.Context, blocks <-chan models.TsBlock, startTime uint64, endTime uint64,
+	decimation service.Decimation, encoding service.RangeEncoding, stream api.Agent_GetTimeseriesRangeForChannelsServer) error {
+	<-blocks
+	return errors.New("client disconnected")
+}
+
+type rangeStream struct {
+	grpc.ServerStream
+}
+
+func (rangeStream) Send(*api.GetTimeseriesRangeResponse) error {
+	return nil
+}
+
+func TestGetTimeseriesRangeStopsBlocksWhenStreamFails(t *testing.T) {
+	tsService := &streamingTimeseriesService{cancelled: make(chan struct{})}
+	s := &agentServer{timeseriesService: tsService}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.GetTimeseriesRangeForChannels(&api.GetTimeseriesRangeRequest{ChannelIds: []string{"N:channel:1"}, EndTime: 10}, rangeStream{})
+	}()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expect the request to return when streaming fails.")
+	}
+
+	// The request waits for the blocks to stop before returning.
+	select {
+	case <-tsService.cancelled:
+	default:
+		assert.Fail(t, "Expect the range request to be cancelled before the handler returns.")
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
-	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
 	"github.com/pennsieve/pennsieve-go/pkg/pennsieve"
@@ -44,12 +43,14 @@ func (s *agentServer) CancelUpload(
 		if !request.CancelAll { // only cancel if the manifest id matches requested id
 			if session.manifestId == request.ManifestId {
 				session.cancelFnc()
+				s.updateManifestState(session.manifestId, models.ManifestCancelRequested)
 				s.sendCancelSubscribers("Cancelling all uploads.")
 				cancelCount += 1
 				return false
 			}
 		} else { // cancel all upload sessions
 			session.cancelFnc()
+			s.updateManifestState(session.manifestId, models.ManifestCancelRequested)
 			s.sendCancelSubscribers(fmt.Sprintf("Cancelling uploading manifest: %d", session.manifestId))
 			cancelCount += 1
 		}
@@ -67,15 +68,21 @@ func (s *agentServer) UploadManifest(
 	ctx context.Context,
 	request *pb.UploadManifestRequest,
 ) (*pb.SimpleStatusResponse, error) {
+	if err := s.startUploadSession(); err != nil {
+		return nil, err
+	}
+
 	s.messageSubscribers(fmt.Sprintf("Server starting upload manifest %d.", request.ManifestId))
 
 	manifest, err := s.ManifestService().GetManifest(request.ManifestId)
 	if err != nil {
 		log.Error("Cannot get Manifest based on ID.")
+		s.uploads.Done()
 		return nil, err
 	}
 
 	s.messageSubscribers("Uploading files to cloud.")
+	s.updateManifestState(request.ManifestId, models.ManifestUploadStarted)
 
 	// On runtime panic, log the stacktrace but keep server alive
 	defer func() {
@@ -86,9 +93,15 @@ func (s *agentServer) UploadManifest(
 		}
 	}()
 
-	// collect all file status updates in a single buffered channel to serialize writes
+	// collect all file status updates in a single buffered channel to serialize writes.
+	// The upload session ends once the batch writer has flushed the last status update, after which the
+	// state of the manifest is derived from the status of its files.
 	statusUpdates := make(chan models.UploadStatusUpdateMessage, 100)
-	go s.startStatusUpdateBatchWriter(statusUpdates)
+	go func() {
+		defer s.uploads.Done()
+		s.startStatusUpdateBatchWriter(statusUpdates)
+		s.updateManifestState(request.ManifestId, models.ManifestUploadEnded)
+	}()
 
 	// Post-upload reconciliation between local state and server-side
 	// Finalized status is handled by pkg/reconciler, which runs for the
@@ -485,7 +498,22 @@ func (s *agentServer) finalizeBatcher(
 		case <-ticker.C:
 			flush()
 		case <-ctx.Done():
-			flush()
+			// The session was cancelled, e.g. by a shutdown of the agent. Files that were
+			// uploaded must still be finalized, so flush on a context that is not cancelled.
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), uploadFlushTimeout)
+			for job := range in {
+				batch = append(batch, pennsieve.FinalizeFile{
+					UploadID: job.UploadID,
+					Size:     job.Size,
+					SHA256:   job.SHA256,
+				})
+			}
+			for len(batch) > 0 {
+				n := min(len(batch), maxBatch)
+				s.callFinalize(flushCtx, client, manifest, batch[:n], statusUpdates, onConflict)
+				batch = batch[n:]
+			}
+			cancel()
 			return
 		}
 	}
@@ -727,91 +755,24 @@ func (s *agentServer) updateSubscribers(
 	workerId int32,
 	status pb.SubscribeResponse_UploadResponse_UploadStatus,
 ) {
-	// A list of clients to unsubscribe in case of error
-	var unsubscribe []int32
-
-	// Iterate over all subscribers and send data to each client
-	s.subscribers.Range(func(k, v any) bool {
-		id, ok := k.(int32)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber key: %T", k))
-			return false
-		}
-		sub, ok := v.(shared.Sub)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber value: %T", v))
-			return false
-		}
-		// Send data over the gRPC Stream to the client
-		if err := sub.Stream.Send(&pb.SubscribeResponse{
-			Type: 1,
-			MessageData: &pb.SubscribeResponse_UploadStatus{
-				UploadStatus: &pb.SubscribeResponse_UploadResponse{
-					FileId:   name,
-					Total:    total,
-					Current:  current,
-					WorkerId: workerId,
-					Status:   status,
-				}},
-		}); err != nil {
-
-			select {
-			case sub.Finished <- true:
-				log.Info(fmt.Sprintf("Unsubscribed client: %d", id))
-			default:
-				log.Warn(fmt.Sprintf("Failed to send data to client: %v", err))
-				// Default case is to avoid blocking in case client has already unsubscribed
-			}
-			// In case of error the client would re-subscribe so close the subscriber Stream
-			unsubscribe = append(unsubscribe, id)
-		}
-		return true
+	s.sendSubscribers(&pb.SubscribeResponse{
+		Type: 1,
+		MessageData: &pb.SubscribeResponse_UploadStatus{
+			UploadStatus: &pb.SubscribeResponse_UploadResponse{
+				FileId:   name,
+				Total:    total,
+				Current:  current,
+				WorkerId: workerId,
+				Status:   status,
+			}},
 	})
-
-	// Unsubscribe erroneous client streams
-	for _, id := range unsubscribe {
-		s.subscribers.Delete(id)
-	}
 }
 
 // sendCancelSubscribers Send Cancel Message to subscribers
 func (s *agentServer) sendCancelSubscribers(message string) {
-	// A list of clients to unsubscribe in case of error
-	var unsubscribe []int32
-
-	// Iterate over all subscribers and send data to each client
-	s.subscribers.Range(func(k, v any) bool {
-		id, ok := k.(int32)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber key: %T", k))
-			return false
-		}
-		sub, ok := v.(shared.Sub)
-		if !ok {
-			log.Error(fmt.Sprintf("Failed to cast subscriber value: %T", v))
-			return false
-		}
-		// Send data over the gRPC Stream to the client
-		if err := sub.Stream.Send(&pb.SubscribeResponse{
-			Type: pb.SubscribeResponse_UPLOAD_CANCEL,
-			MessageData: &pb.SubscribeResponse_EventInfo{
-				EventInfo: &pb.SubscribeResponse_EventResponse{Details: message}},
-		}); err != nil {
-			select {
-			case sub.Finished <- true:
-				log.Info(fmt.Sprintf("Unsubscribed client: %d", id))
-			default:
-				log.Warn(fmt.Sprintf("Failed to send data to client: %v", err))
-				// Default case is to avoid blocking in case client has already unsubscribed
-			}
-			// In case of error the client would re-subscribe so close the subscriber Stream
-			unsubscribe = append(unsubscribe, id)
-		}
-		return true
+	s.sendSubscribers(&pb.SubscribeResponse{
+		Type: pb.SubscribeResponse_UPLOAD_CANCEL,
+		MessageData: &pb.SubscribeResponse_EventInfo{
+			EventInfo: &pb.SubscribeResponse_EventResponse{Details: message}},
 	})
-
-	// Unsubscribe erroneous client streams
-	for _, id := range unsubscribe {
-		s.subscribers.Delete(id)
-	}
 }
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/bids"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bidsStandard is the name of the Brain Imaging Data Structure standard.
+const bidsStandard = "bids"
+
+// ValidateManifest checks the layout of the files in a manifest against a data standard, and returns the
+// errors and warnings for each file. Removed files are not part of the layout.
+func (s *agentServer) ValidateManifest(ctx context.Context, request *pb.ValidateManifestRequest) (*pb.ValidateManifestResponse, error) {
+
+	if !strings.EqualFold(request.GetStandard(), bidsStandard) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported standard %q; only %s is supported", request.GetStandard(), bidsStandard)
+	}
+
+	if _, err := s.ManifestService().GetManifest(request.GetManifestId()); err != nil {
+		return nil, status.Errorf(codes.NotFound, "manifest %d not found: %v", request.GetManifestId(), err)
+	}
+
+	files, err := s.allManifestFiles(request.GetManifestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list files in manifest %d: %v", request.GetManifestId(), err)
+	}
+
+	var bidsFiles []bids.File
+	for _, file := range files {
+		if file.Status == manifestFile.Removed {
+			continue
+		}
+		bidsFiles = append(bidsFiles, bids.File{
+			Path:       path.Join(normalizeTargetPath(file.TargetPath), file.TargetName),
+			SourcePath: file.SourcePath,
+		})
+	}
+
+	response := &pb.ValidateManifestResponse{}
+	for _, issue := range bids.Validate(bidsFiles) {
+		severity := pb.ValidateManifestResponse_Issue_ERROR
+		if issue.Severity == bids.Warning {
+			severity = pb.ValidateManifestResponse_Issue_WARNING
+			response.NrWarnings++
+		} else {
+			response.NrErrors++
+		}
+		response.Issues = append(response.Issues, &pb.ValidateManifestResponse_Issue{
+			Severity:   severity,
+			Code:       issue.Code,
+			Message:    issue.Message,
+			Target:     issue.Path,
+			SourcePath: issue.SourcePath,
+		})
+	}
+	response.Valid = response.NrErrors == 0
+
+	s.messageSubscribers(fmt.Sprintf("Validated manifest %d: %d errors, %d warnings.",
+		request.GetManifestId(), response.NrErrors, response.NrWarnings))
+	return response, nil
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateManifestBIDS(t *testing.T) {
+	s := &agentServer{manifest: newStubManifestService().withFiles([]store.ManifestFile{
+		{SourcePath: "/data/dataset_description.json", TargetPath: "/", TargetName: "dataset_description.json", Status: manifestFile.Local},
+		{SourcePath: "/data/README", TargetName: "README", Status: manifestFile.Local},
+		{SourcePath: "/data/participants.tsv", TargetName: "participants.tsv", Status: manifestFile.Registered},
+		{SourcePath: "/data/sub-01/eeg/sub-01_task-rest_eeg.edf", TargetPath: "sub-01/eeg", TargetName: "sub-01_task-rest_eeg.edf", Status: manifestFile.Local},
+		{SourcePath: "/data/sub-01/eeg/sub-01_task-rest_eeg.json", TargetPath: "sub-01/eeg", TargetName: "sub-01_task-rest_eeg.json", Status: manifestFile.Removed},
+		{SourcePath: "/data/notes.docx", TargetName: "notes.docx", Status: manifestFile.Local},
+	})}
+
+	response, err := s.ValidateManifest(context.Background(), &pb.ValidateManifestRequest{ManifestId: 1, Standard: "BIDS"})
+	require.NoError(t, err)
+	assert.False(t, response.Valid)
+	assert.Equal(t, int32(1), response.NrErrors)
+	assert.Equal(t, int32(1), response.NrWarnings)
+
+	require.Len(t, response.Issues, 2)
+	assert.Equal(t, "notes.docx", response.Issues[0].Target)
+	assert.Equal(t, pb.ValidateManifestResponse_Issue_WARNING, response.Issues[0].Severity)
+	assert.Equal(t, "sub-01/eeg/sub-01_task-rest_eeg.edf", response.Issues[1].Target)
+	assert.Equal(t, "/data/sub-01/eeg/sub-01_task-rest_eeg.edf", response.Issues[1].SourcePath)
+	assert.Equal(t, "MISSING_SIDECAR", response.Issues[1].Code, "Expect removed sidecars not to count.")
+
+	_, err = s.ValidateManifest(context.Background(), &pb.ValidateManifestRequest{ManifestId: 1, Standard: "nwb"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ValidateManifest(context.Background(), &pb.ValidateManifestRequest{ManifestId: 2, Standard: "bids"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
@@ -8,20 +10,36 @@ import (
 	"fmt"
 	guuid "github.com/google/uuid"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/service"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-agent/v2/workflow"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
-	"golang.org/x/exp/slices"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
 	"io/fs"
 	"os"
 	"os/exec"
-	"os/user"
+	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
+// workflowPageSize is the number of manifest files that are read at a time when writing the input of a workflow.
+const workflowPageSize = 1000
+
+// defaultDerivativesTargetPath is the target path of uploaded derivatives if none is provided.
+const defaultDerivativesTargetPath = "derivatives/{workflow}/{run}"
+
+// workflowWaitDelay is the time given to the output of a cancelled workflow to be read before its pipes are closed.
+const workflowWaitDelay = 10 * time.Second
+
 type WorkOrder struct {
 	ProcessID          guuid.UUID                        `json:"ProcessID"`
 	FilePath           string                            `json:"FilePath"`
@@ -33,133 +51,622 @@ type WorkOrder struct {
 	WorkFlowOutput     string                            `json:"WorkFlowOutput"`
 	ManifestRoots      []string                          `json:"ManifestRoots"`
 	NextflowConfigFile string                            `json:"NextflowConfigFile"`
+	Executor           string                            `json:"Executor"`
+	Image              string                            `json:"Image"`
+	Mounts             []WorkflowMount                   `json:"Mounts"`
+	Name               string                            `json:"Name"`
+	Params             map[string]any                    `json:"Params"`
+	ParamsFile         string                            `json:"ParamsFile"`
+
+	// Derivatives of a successful run are uploaded to the target path in the dataset of the manifest
+	UploadDerivatives     bool   `json:"UploadDerivatives"`
+	DerivativesTargetPath string `json:"DerivativesTargetPath"`
+	DerivativesManifestID int32  `json:"DerivativesManifestID"`
 }
 
 func isPath(path string) bool {
 	_, err := os.Stat(path)
+	return err == nil
+}
+
+// StartWorkflow starts a run of the workflow on the files in the manifest. The workflow is the name of a
+// workflow in the registry, whose parameters are validated before the run starts, or the absolute path of a
+// workflow file. Registered workflows take precedence over files. The run continues in the background; use
+// GetWorkflowStatus or Subscribe to follow its progress.
+func (s *agentServer) StartWorkflow(ctx context.Context, request *api.StartWorkflowRequest) (*api.WorkflowResponse, error) {
+
+	workflowType := api.WorkflowResponse_PATH
+	var workflowPath, workflowName string
+	var params map[string]any
 
-	if errors.Is(err, fs.ErrNotExist) {
-		fmt.Printf("Path error: %v", err)
+	definition, err := s.workflowRegistry().Get(request.WorkflowFlag)
+	switch {
+	case err == nil:
+		params, err = definition.ValidateParams(request.Params)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parameters for workflow %q: %v", definition.Name, err)
+		}
+		workflowType = api.WorkflowResponse_NAMED
+		workflowPath = definition.EntryPointPath()
+		workflowName = definition.Name
+	case !errors.Is(err, service.ErrWorkflowNotRegistered):
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid workflow %q: %v", request.WorkflowFlag, err)
+	case !filepath.IsAbs(request.WorkflowFlag):
+		// Relative paths cannot be resolved, as the agent does not run in the working directory of the client.
+		return nil, status.Errorf(codes.InvalidArgument, "workflow %q is not a registered workflow or an absolute path", request.WorkflowFlag)
+	case !isPath(request.WorkflowFlag):
+		return nil, status.Errorf(codes.InvalidArgument, "workflow file %q does not exist", request.WorkflowFlag)
+	default:
+		workflowPath = filepath.Clean(request.WorkflowFlag)
+		workflowName = strings.TrimSuffix(filepath.Base(workflowPath), filepath.Ext(workflowPath))
+		for k, v := range request.Params {
+			if params == nil {
+				params = map[string]any{}
+			}
+			params[k] = v
+		}
 	}
-	if err == nil {
-		return true
+
+	if _, err := s.ManifestService().GetManifest(request.ManifestId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "manifest %d not found: %v", request.ManifestId, err)
+	}
+
+	executorName := request.Executor
+	if executorName == "" && definition != nil {
+		executorName = definition.Executor
+	}
+	if executorName == "" {
+		executorName = viper.GetString("agent.workflow_executor")
+	}
+	if executorName == "" {
+		executorName = nextflowExecutor
+	}
+	image := request.Image
+	if image == "" && definition != nil {
+		image = definition.Image
+	}
+	if image == "" {
+		image = viper.GetString("agent.workflow_image")
+	}
+	executor, err := newWorkflowExecutor(executorName, viper.GetString("agent.workflow_container_engine"), image)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if executorName == localExecutor {
+		if err := checkLocalWorkflow(ctx, workflowPath, definition != nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.startWorkflowRun(); err != nil {
+		return nil, err
+	}
+
+	workOrder := WorkOrder{
+		ProcessID:         guuid.New(),
+		ManifestID:        request.ManifestId,
+		WorkFlowType:      workflowType,
+		Input:             workflowPath,
+		Executor:          executorName,
+		Image:             image,
+		Name:              workflowName,
+		Params:            params,
+		UploadDerivatives: request.UploadDerivatives,
+	}
+	if workOrder.UploadDerivatives {
+		workOrder.DerivativesTargetPath = derivativesTargetPath(request.DerivativesTargetPath, workflowName, workOrder.ProcessID.String())
 	}
 
-	return false
+	newJobFolder, err := createWorkflowFolder(workOrder)
+	if err != nil {
+		s.workflows.Done()
+		return nil, status.Errorf(codes.Internal, "unable to create workflow folder: %v", err)
+	}
+	workOrder.FilePath = newJobFolder
+
+	run := models.WorkflowRun{
+		Id:         workOrder.ProcessID.String(),
+		ManifestId: request.ManifestId,
+		Workflow:   workflowPath,
+		Status:     models.WorkflowPending,
+		JobDir:     newJobFolder,
+		LogPath:    filepath.Join(newJobFolder, "workflow", "workflow.log"),
+		CreatedAt:  time.Now().Unix(),
+	}
+	// Runs of named workflows are listed by name
+	if definition != nil {
+		run.Workflow = definition.Name
+	}
+	if err := s.WorkflowRunStore().CreateRun(ctx, run); err != nil {
+		s.workflows.Done()
+		return nil, status.Errorf(codes.Internal, "unable to store workflow run: %v", err)
+	}
+
+	runCtx, cancelFnc := context.WithCancel(context.Background())
+	s.workflowRuns.Store(run.Id, cancelFnc)
+
+	go func() {
+		defer s.workflows.Done()
+		defer s.workflowRuns.Delete(run.Id)
+		defer cancelFnc()
+
+		s.executeWorkflow(runCtx, executor, run, workOrder)
+	}()
+
+	s.messageSubscribers(fmt.Sprintf("Started workflow run %s for manifest %d.", run.Id, run.ManifestId))
+
+	return &api.WorkflowResponse{
+		Success:      true,
+		Derivatives:  filepath.Join(newJobFolder, ".derivatives"),
+		WorkflowType: workOrder.WorkFlowType,
+		RunId:        run.Id,
+	}, nil
 }
 
-func (s *agentServer) StartWorkflow(ctx context.Context, request *api.StartWorkflowRequest) (*api.WorkflowResponse, error) {
+// ListWorkflows returns the workflow runs for a manifest, or all runs if no manifest is provided.
+func (s *agentServer) ListWorkflows(ctx context.Context, request *api.ListWorkflowsRequest) (*api.ListWorkflowsResponse, error) {
 
-	id := guuid.New()
-	fmt.Println("\nStarting workflow")
-	fmt.Println("ID:" + id.String())
+	runs, err := s.WorkflowRunStore().ListRuns(ctx, request.GetManifestId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list workflow runs: %v", err)
+	}
 
-	var workOrder WorkOrder
-	var workflowType api.WorkflowResponse_WorkflowType
+	response := &api.ListWorkflowsResponse{}
+	for _, run := range runs {
+		response.Runs = append(response.Runs, workflowRunToProto(run))
+	}
+	return response, nil
+}
 
-	workOrder.ProcessID = id
-	workOrder.Status = false
-	workOrder.ManifestID = request.ManifestId
-	workOrder.Input = request.WorkflowFlag
-	offset := int32(0)
-	limit := int32(100)
+// GetWorkflowStatus returns a workflow run and the last lines of its log.
+func (s *agentServer) GetWorkflowStatus(ctx context.Context, request *api.GetWorkflowStatusRequest) (*api.GetWorkflowStatusResponse, error) {
 
-	switch isPath(workOrder.Input) {
+	run, err := s.WorkflowRunStore().GetRun(ctx, request.GetRunId())
+	if errors.Is(err, store.ErrWorkflowRunNotFound) {
+		return nil, status.Errorf(codes.NotFound, "workflow run %s not found", request.GetRunId())
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to get workflow run: %v", err)
+	}
 
-	case true:
-		workOrder.WorkFlowType = api.WorkflowResponse_PATH
+	response := &api.GetWorkflowStatusResponse{Run: workflowRunToProto(*run)}
+	if request.GetLogLines() > 0 {
+		response.Log, err = tailLines(run.LogPath, int(request.GetLogLines()))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf("Unable to read log of workflow run %s: %v", run.Id, err)
+		}
+	}
+	return response, nil
+}
 
-		newJobFolder := createWorkflowFolder(workOrder)
-		workOrder.FilePath = newJobFolder
+// CancelWorkflow cancels an active workflow run.
+func (s *agentServer) CancelWorkflow(ctx context.Context, request *api.CancelWorkflowRequest) (*api.SimpleStatusResponse, error) {
 
-		req := api.ListManifestFilesRequest{
-			ManifestId: workOrder.ManifestID,
-			Offset:     offset,
-			Limit:      limit,
+	v, ok := s.workflowRuns.Load(request.GetRunId())
+	if !ok {
+		if _, err := s.WorkflowRunStore().GetRun(ctx, request.GetRunId()); errors.Is(err, store.ErrWorkflowRunNotFound) {
+			return nil, status.Errorf(codes.NotFound, "workflow run %s not found", request.GetRunId())
 		}
+		return nil, status.Errorf(codes.FailedPrecondition, "workflow run %s is not active", request.GetRunId())
+	}
+
+	v.(context.CancelFunc)()
+	return &api.SimpleStatusResponse{Status: fmt.Sprintf("Cancelling workflow run %s.", request.GetRunId())}, nil
+}
 
-		port := viper.GetString("agent.port")
-		conn, err := grpc.Dial(":"+port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// ListRegisteredWorkflows returns the named workflows in the workflow registry.
+func (s *agentServer) ListRegisteredWorkflows(ctx context.Context, request *api.ListRegisteredWorkflowsRequest) (*api.ListRegisteredWorkflowsResponse, error) {
 
+	registry := s.workflowRegistry()
+	definitions, err := registry.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to list registered workflows: %v", err)
+	}
+
+	response := &api.ListRegisteredWorkflowsResponse{Folder: registry.Folder()}
+	for _, definition := range definitions {
+		response.Workflows = append(response.Workflows, registeredWorkflowToProto(&definition))
+	}
+	return response, nil
+}
+
+// DescribeWorkflow returns a named workflow in the workflow registry and its parameters.
+func (s *agentServer) DescribeWorkflow(ctx context.Context, request *api.DescribeWorkflowRequest) (*api.DescribeWorkflowResponse, error) {
+
+	definition, err := s.workflowRegistry().Get(request.GetName())
+	if errors.Is(err, service.ErrWorkflowNotRegistered) {
+		return nil, status.Errorf(codes.NotFound, "workflow %q is not registered", request.GetName())
+	} else if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid workflow %q: %v", request.GetName(), err)
+	}
+
+	return &api.DescribeWorkflowResponse{Workflow: registeredWorkflowToProto(definition)}, nil
+}
+
+// workflowRegistry returns the registry of named workflows, which is ~/.pennsieve/workflows
+// unless the agent.workflow_registry setting points to another folder.
+func (s *agentServer) workflowRegistry() *service.WorkflowRegistry {
+	folder := viper.GetString("agent.workflow_registry")
+	if folder == "" {
+		home, err := os.UserHomeDir()
 		if err != nil {
-			fmt.Printf("%v", err)
+			log.Errorf("Unable to get home folder: %v", err)
+		}
+		folder = filepath.Join(home, ".pennsieve", "workflows")
+	}
+	return service.NewWorkflowRegistry(folder)
+}
+
+func registeredWorkflowToProto(definition *service.WorkflowDefinition) *api.RegisteredWorkflow {
+	workflow := &api.RegisteredWorkflow{
+		Name:        definition.Name,
+		Description: definition.Description,
+		Version:     definition.Version,
+		Entrypoint:  definition.EntryPoint,
+		Executor:    definition.Executor,
+		Image:       definition.Image,
+		Folder:      definition.Folder,
+	}
 
+	if definition.Parameters != nil {
+		if schema, err := json.Marshal(definition.Parameters); err == nil {
+			workflow.ParametersSchema = string(schema)
+		}
+	}
+	for _, name := range definition.ParameterNames() {
+		property := definition.Parameters.Properties[name]
+		parameter := &api.RegisteredWorkflow_Parameter{
+			Name:        name,
+			Type:        property.Type,
+			Description: property.Description,
+			Required:    definition.IsRequired(name),
 		}
-		defer conn.Close()
+		if property.Default != nil {
+			if value, err := json.Marshal(property.Default); err == nil {
+				parameter.Default = string(value)
+			}
+		}
+		for _, value := range property.Enum {
+			parameter.Enum = append(parameter.Enum, fmt.Sprint(value))
+		}
+		workflow.Parameters = append(workflow.Parameters, parameter)
+	}
+	return workflow
+}
+
+// startWorkflowRun registers a new workflow run, or returns an error if the agent is stopping.
+// Callers must call s.workflows.Done() once the result of the run is stored.
+func (s *agentServer) startWorkflowRun() error {
+	s.shutdownMu.Lock()
+	defer s.shutdownMu.Unlock()
+
+	if s.draining {
+		return status.Error(codes.Unavailable, "the agent is shutting down and does not accept new workflows")
+	}
+	s.workflows.Add(1)
+	return nil
+}
 
-		client := api.NewAgentClient(conn)
-		listFilesResponse, err := client.ListManifestFiles(context.Background(), &req)
+// executeWorkflow prepares the input and configuration of the run, runs the workflow with the executor and
+// stores the result. The output of the workflow is written to the log of the run and sent to subscribers.
+func (s *agentServer) executeWorkflow(ctx context.Context, executor workflowExecutor, run models.WorkflowRun, workOrder WorkOrder) {
 
-		err, errString := createInputCSV(&workOrder, listFilesResponse)
+	logFile, err := os.Create(run.LogPath)
+	if err != nil {
+		s.finishWorkflowRun(ctx, &run, &workOrder, fmt.Errorf("unable to create log file: %w", err))
+		return
+	}
+	defer logFile.Close()
+
+	if err := s.prepareWorkflow(ctx, executor, &workOrder); err != nil {
+		s.finishWorkflowRun(ctx, &run, &workOrder, err)
+		return
+	}
+
+	var cmd *exec.Cmd
+	if s.workflowCommandOverride != nil {
+		cmd = s.workflowCommandOverride(ctx, workOrder)
+	} else {
+		cmd = executor.Command(ctx, workOrder)
+	}
+	cmd.Dir = workOrder.FilePath
+	// Output pipes are closed if processes started by the workflow keep them open after it is cancelled.
+	cmd.WaitDelay = workflowWaitDelay
+
+	var logMu sync.Mutex
+	stdout := &workflowOutput{s: s, runId: run.Id, log: logFile, logMu: &logMu}
+	stderr := &workflowOutput{s: s, runId: run.Id, stderr: true, log: logFile, logMu: &logMu}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	if err := cmd.Start(); err != nil {
+		s.finishWorkflowRun(ctx, &run, &workOrder, fmt.Errorf("unable to start workflow: %w", err))
+		return
+	}
+
+	run.Status = models.WorkflowRunning
+	run.StartedAt = time.Now().Unix()
+	s.updateWorkflowRun(&run)
+
+	err = cmd.Wait()
+	stdout.Flush()
+	stderr.Flush()
+
+	s.finishWorkflowRun(ctx, &run, &workOrder, err)
+}
+
+// prepareWorkflow writes the input file, the configuration of the executor and the work order to the job folder.
+func (s *agentServer) prepareWorkflow(ctx context.Context, executor workflowExecutor, workOrder *WorkOrder) error {
+
+	roots, err := s.createInputCSV(ctx, workOrder)
+	if err != nil {
+		return err
+	}
+	if len(roots) == 0 {
+		return fmt.Errorf("manifest %d does not contain any files", workOrder.ManifestID)
+	}
+	workOrder.ManifestRoots = roots
+
+	if workOrder.Params != nil {
+		content, err := json.MarshalIndent(workOrder.Params, "", "  ")
 		if err != nil {
-			fmt.Println(errString)
-			return &api.WorkflowResponse{Success: false}, err
+			return fmt.Errorf("unable to encode parameters: %w", err)
 		}
-		workOrder.ManifestRoots = getRootDirectories(listFilesResponse)
-
-		fmt.Printf("%v", workOrder.ManifestRoots)
-
-		nextflowConfigContent := "" +
-			"process.failFast = true\n" +
-			"process.containerOptions = '--platform linux/amd64 --rm " +
-			"-v " + workOrder.ManifestRoots[0] + ":/data " +
-			"-v " + workOrder.FilePath + ":/job'" +
-			"\ndocker{" +
-			"\n    enabled = true" +
-			"\n}"
-
-		workOrder.NextflowConfigFile = filepath.Dir(newJobFolder) + "/" + workOrder.ProcessID.String() + "/nextflow.config"
-		err = os.WriteFile(workOrder.NextflowConfigFile, []byte(nextflowConfigContent), 0644)
-		runWorkflow(&workOrder)
-
-	case false:
-		fmt.Println("Named Workflow")
-		workOrder.WorkFlowType = api.WorkflowResponse_NAMED
-		workflowSteps := strings.Split(workOrder.Input, ",")
-
-		for _, workflow := range workflowSteps {
-			log.Println(workflow)
+		workOrder.ParamsFile = filepath.Join(workOrder.FilePath, "workflow", "params.json")
+		if err := os.WriteFile(workOrder.ParamsFile, content, 0644); err != nil {
+			return fmt.Errorf("unable to write parameters: %w", err)
 		}
 	}
 
-	response := api.WorkflowResponse{
-		Success:      workOrder.Status,
-		Derivatives:  "~/.pennsieve/derivatives",
-		WorkflowType: workflowType,
+	if err := executor.Prepare(workOrder); err != nil {
+		return err
 	}
 
-	return &response, nil
+	writeWorkOrder(workOrder)
+	return nil
 }
 
-func runWorkflow(workOrder *WorkOrder) {
+// workflowOutput writes the output of a workflow to the log of the run and to subscribers, a line at a time.
+type workflowOutput struct {
+	s      *agentServer
+	runId  string
+	stderr bool
+	log    io.Writer
+	logMu  *sync.Mutex // logMu serializes writes of stdout and stderr to the log.
+	buf    []byte
+}
 
-	writeWorkOrder(workOrder)
+func (w *workflowOutput) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.writeLine(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
 
-	currentDir, err := os.Getwd()
-	if err != nil {
-		fmt.Printf("Error getting current working directory: %v", err)
-		return
+// Flush writes the last line of output if it does not end with a newline.
+func (w *workflowOutput) Flush() {
+	if len(w.buf) > 0 {
+		w.writeLine(string(w.buf))
+		w.buf = nil
 	}
+}
 
-	targetFolder := "workflow"
-	targetFile := "master_workflow.nf"
-	targetPath := filepath.Join(currentDir, targetFolder, targetFile)
-	app := "nextflow"
-	cmd := exec.Command(app, targetPath, "--workflowJobId", workOrder.ProcessID.String(), "--userJob", workOrder.Input, "-c", workOrder.NextflowConfigFile)
+func (w *workflowOutput) writeLine(line string) {
+	line = strings.TrimSuffix(line, "\r")
 
-	output, err := cmd.Output()
-	fmt.Println(string(output))
+	w.logMu.Lock()
+	_, err := fmt.Fprintln(w.log, line)
+	w.logMu.Unlock()
 	if err != nil {
-		fmt.Println("Workflow failed. See output above or .nextflow.log")
+		log.Warnf("Unable to write log of workflow run %s: %v", w.runId, err)
+	}
 
-		workOrder.Status = false
-	} else {
-		// Command completed successfully
+	w.s.updateWorkflowSubscribers(&api.SubscribeResponse_WorkflowStatusResponse{
+		RunId:  w.runId,
+		Status: api.WorkflowRun_RUNNING,
+		Output: line,
+		Stderr: w.stderr,
+	})
+}
+
+// finishWorkflowRun stores the result of a run. A run whose context is cancelled is recorded as cancelled.
+// The derivatives of a successful run are uploaded if requested; the run fails if they cannot be uploaded.
+func (s *agentServer) finishWorkflowRun(ctx context.Context, run *models.WorkflowRun, workOrder *WorkOrder, err error) {
+
+	if err == nil && ctx.Err() == nil && workOrder.UploadDerivatives {
 		workOrder.Status = true
-		fmt.Println("Workflow completed successfully")
+		if uploadErr := s.uploadDerivatives(ctx, run, workOrder); uploadErr != nil {
+			err = fmt.Errorf("unable to upload derivatives: %w", uploadErr)
+		}
+	}
+
+	var exitErr *exec.ExitError
+	switch {
+	case ctx.Err() != nil:
+		run.Status = models.WorkflowCancelled
+	case err != nil:
+		run.Status = models.WorkflowFailed
+		run.Error = err.Error()
+		if errors.As(err, &exitErr) {
+			run.ExitCode = exitErr.ExitCode()
+		}
+	default:
+		run.Status = models.WorkflowSucceeded
+	}
+	run.FinishedAt = time.Now().Unix()
+
+	workOrder.Status = run.Status == models.WorkflowSucceeded
+	writeWorkOrder(workOrder)
+
+	s.updateWorkflowRun(run)
+	s.messageSubscribers(fmt.Sprintf("Workflow run %s %s.", run.Id, run.Status))
+}
+
+// uploadDerivatives creates a manifest for the derivatives of a run in the dataset of the manifest of the run,
+// and starts uploading it. The work order, which identifies the run, is uploaded with the derivatives.
+func (s *agentServer) uploadDerivatives(ctx context.Context, run *models.WorkflowRun, workOrder *WorkOrder) error {
+
+	derivativesFolder := filepath.Join(workOrder.FilePath, ".derivatives")
+	hasFiles, err := containsFiles(derivativesFolder)
+	if err != nil {
+		return err
+	}
+	if !hasFiles {
+		s.messageSubscribers(fmt.Sprintf("Workflow run %s did not produce derivatives.", run.Id))
+		return nil
+	}
+
+	source, err := s.ManifestService().GetManifest(workOrder.ManifestID)
+	if err != nil {
+		return fmt.Errorf("unable to get manifest %d: %w", workOrder.ManifestID, err)
+	}
+
+	derivativesManifest, err := s.ManifestService().Add(store.ManifestParams{
+		UserId:           source.UserId,
+		UserName:         source.UserName,
+		OrganizationId:   source.OrganizationId,
+		OrganizationName: source.OrganizationName,
+		DatasetId:        source.DatasetId,
+		DatasetName:      source.DatasetName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create manifest: %w", err)
 	}
 
+	run.DerivativesManifestId = derivativesManifest.Id
+	workOrder.DerivativesManifestID = derivativesManifest.Id
 	writeWorkOrder(workOrder)
+
+	nrFiles, _, _, err := s.addToManifest(derivativesFolder, workOrder.DerivativesTargetPath, nil, derivativesManifest.Id)
+	if err != nil {
+		return err
+	}
+	workOrderPath := filepath.Join(workOrder.FilePath, "workflow", "work_order.json")
+	if _, _, _, err := s.addToManifest("", workOrder.DerivativesTargetPath, []string{workOrderPath}, derivativesManifest.Id); err != nil {
+		return err
+	}
+
+	if _, err := s.callUploadManifest(ctx, &api.UploadManifestRequest{ManifestId: derivativesManifest.Id}); err != nil {
+		return err
+	}
+
+	s.messageSubscribers(fmt.Sprintf("Uploading %d derivative(s) of workflow run %s to %s with manifest %d.",
+		nrFiles, run.Id, workOrder.DerivativesTargetPath, derivativesManifest.Id))
+	return nil
+}
+
+// derivativesTargetPath returns the target path of the derivatives of a run, replacing {workflow}
+// and {run} in the template by the name of the workflow and the run id.
+func derivativesTargetPath(template string, workflowName string, runId string) string {
+	if template == "" {
+		template = defaultDerivativesTargetPath
+	}
+
+	target := strings.NewReplacer("{workflow}", workflowName, "{run}", runId).Replace(template)
+	target = strings.Trim(path.Clean("/"+filepath.ToSlash(target)), "/")
+	return target
+}
+
+// containsFiles returns true if the folder or one of its sub-folders contains a file.
+func containsFiles(folder string) (bool, error) {
+	found := false
+	err := filepath.WalkDir(folder, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			found = true
+			return fs.SkipAll
+		}
+		return nil
+	})
+	return found, err
+}
+
+// updateWorkflowRun stores the status of a run and sends it to subscribers.
+func (s *agentServer) updateWorkflowRun(run *models.WorkflowRun) {
+	if err := s.WorkflowRunStore().UpdateRun(context.Background(), *run); err != nil {
+		log.Errorf("Unable to update workflow run %s: %v", run.Id, err)
+	}
+
+	s.updateWorkflowSubscribers(&api.SubscribeResponse_WorkflowStatusResponse{
+		RunId:  run.Id,
+		Status: workflowStatusToProto(run.Status),
+	})
+}
+
+// updateWorkflowSubscribers sends workflow status and output messages to all grpc-update subscribers.
+func (s *agentServer) updateWorkflowSubscribers(update *api.SubscribeResponse_WorkflowStatusResponse) {
+	s.sendSubscribers(&api.SubscribeResponse{
+		Type:        api.SubscribeResponse_WORKFLOW_STATUS,
+		MessageData: &api.SubscribeResponse_WorkflowStatus{WorkflowStatus: update},
+	})
+}
+
+// cancelWorkflowRuns cancels all active workflow runs.
+func (s *agentServer) cancelWorkflowRuns() {
+	s.workflowRuns.Range(func(k, v any) bool {
+		v.(context.CancelFunc)()
+		return true
+	})
+}
+
+func workflowStatusToProto(status models.WorkflowRunStatus) api.WorkflowRun_Status {
+	switch status {
+	case models.WorkflowRunning:
+		return api.WorkflowRun_RUNNING
+	case models.WorkflowSucceeded:
+		return api.WorkflowRun_SUCCEEDED
+	case models.WorkflowFailed:
+		return api.WorkflowRun_FAILED
+	case models.WorkflowCancelled:
+		return api.WorkflowRun_CANCELLED
+	default:
+		return api.WorkflowRun_PENDING
+	}
+}
+
+func workflowRunToProto(run models.WorkflowRun) *api.WorkflowRun {
+	return &api.WorkflowRun{
+		Id:         run.Id,
+		ManifestId: run.ManifestId,
+		Workflow:   run.Workflow,
+		Status:     workflowStatusToProto(run.Status),
+		JobDir:     run.JobDir,
+		LogPath:    run.LogPath,
+		ExitCode:   int32(run.ExitCode),
+		Error:      run.Error,
+		CreatedAt:  run.CreatedAt,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+
+		DerivativesManifestId: run.DerivativesManifestId,
+	}
+}
+
+// tailLines returns the last n lines of a file.
+func tailLines(path string, n int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
 }
 
 func writeWorkOrder(workOrder *WorkOrder) {
@@ -176,103 +683,122 @@ func writeWorkOrder(workOrder *WorkOrder) {
 	}
 }
 
-func createWorkflowFolder(workOrder WorkOrder) string {
-	// 2. Create Folder structure
-	currentUserHomeFolder, err := user.Current()
+// writeWorkflowFiles writes the workflow that wraps the user workflow to the folder.
+func writeWorkflowFiles(folder string) error {
+	entries, err := fs.ReadDir(workflow.Files, ".")
 	if err != nil {
-		fmt.Printf("Error in getting home folder: %v", err)
+		return err
 	}
-
-	jobsFolder := filepath.Join(currentUserHomeFolder.HomeDir, ".pennsieve/.jobs")
-	if _, err := os.Stat(jobsFolder); errors.Is(err, os.ErrNotExist) {
-		if err := os.Mkdir(jobsFolder, os.ModePerm); err != nil {
-			log.Fatal(err)
+	for _, entry := range entries {
+		content, err := fs.ReadFile(workflow.Files, entry.Name())
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(folder, entry.Name()), content, 0644); err != nil {
+			return err
 		}
 	}
-	newJobFolder := filepath.Join(jobsFolder, workOrder.ProcessID.String())
-	if err := os.Mkdir(newJobFolder, os.ModePerm); err != nil {
-		log.Fatal(err)
+	return nil
+}
+
+func createWorkflowFolder(workOrder WorkOrder) (string, error) {
+	// 2. Create Folder structure
+	homeFolder, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error in getting home folder: %w", err)
 	}
 
-	os.Mkdir(filepath.Join(newJobFolder, "workflow"), os.ModePerm)
-	os.Mkdir(filepath.Join(newJobFolder, ".derivatives"), os.ModePerm)
+	jobsFolder := filepath.Join(homeFolder, ".pennsieve/.jobs")
+	newJobFolder := filepath.Join(jobsFolder, workOrder.ProcessID.String())
+	for _, folder := range []string{"workflow", ".derivatives"} {
+		if err := os.MkdirAll(filepath.Join(newJobFolder, folder), os.ModePerm); err != nil {
+			return "", err
+		}
+	}
 
-	return newJobFolder
+	return newJobFolder, nil
 }
 
-func createInputCSV(workOrder *WorkOrder, listFilesResponse *api.ListManifestFilesResponse) (error, string) {
-	errString := ""
+// createInputCSV writes all files in the manifest to the input file of the workflow, reading the
+// manifest a page at a time, and returns the root folders of the files.
+func (s *agentServer) createInputCSV(ctx context.Context, workOrder *WorkOrder) ([]string, error) {
 	f, err := os.Create(workOrder.FilePath + "/workflow/input.csv")
 	if err != nil {
-		errString = "Unable to create file"
-		fmt.Print(errString)
-		return err, errString
+		return nil, fmt.Errorf("unable to create input file: %w", err)
 	}
+	defer f.Close()
+
 	workOrder.Files = f.Name()
 	w := csv.NewWriter(f)
 	record := []string{"id", "source_path", "target_path"}
-	err = w.Write(record)
-	if err != nil {
-		errString = "Unable to write header"
-		fmt.Print(errString)
-		return err, errString
-	}
-	for _, file := range listFilesResponse.File {
-		record := []string{strconv.Itoa(int(file.Id)), file.SourcePath, file.TargetPath}
-		if err := w.Write(record); err != nil {
-			errString = fmt.Sprintf("error writing record to file: %v", err)
-			fmt.Print(errString)
-			return err, errString
-		}
+	if err := w.Write(record); err != nil {
+		return nil, fmt.Errorf("unable to write header: %w", err)
 	}
-	w.Flush()
-	err = f.Close()
-	if err != nil {
-		errString = fmt.Sprintf("Error closing file Stream: %v", err)
-		fmt.Print(errString)
-		return err, errString
-	}
-	return err, errString
-}
-
-func getRootDirectories(listFilesResponse *api.ListManifestFilesResponse) []string {
 
 	var dirs []string
-	// Push all paths into array
-	for _, file := range listFilesResponse.File {
-		dirs = append(dirs, filepath.Dir(file.SourcePath))
-	}
-	// Remove duplicates
-	var uniqueDirPaths []string
 	seen := map[string]bool{}
-	for _, dir := range dirs {
-		if seen[dir] == false {
-			seen[dir] = true
-			uniqueDirPaths = append(uniqueDirPaths, dir)
+	for offset := int32(0); ; offset += workflowPageSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
 		}
-	}
 
-	// Check for highest level folder
-	var skips []int
-	rootsMap := map[string]bool{}
-	for i := 0; i < len(uniqueDirPaths); i++ {
-		if slices.Contains(skips, i) {
-			continue
+		files, err := s.ManifestService().GetFiles(workOrder.ManifestID, workflowPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list files in manifest %d: %w", workOrder.ManifestID, err)
 		}
-		for j := 0; j < len(uniqueDirPaths); j++ {
-			if strings.Contains(uniqueDirPaths[j], uniqueDirPaths[i]) {
-				rootsMap[uniqueDirPaths[i]] = true
-				skips = append(skips, j)
+
+		for _, file := range files {
+			record := []string{strconv.Itoa(int(file.Id)), file.SourcePath, file.TargetPath}
+			if err := w.Write(record); err != nil {
+				return nil, fmt.Errorf("error writing record to file: %w", err)
+			}
+
+			dir := filepath.Dir(file.SourcePath)
+			if !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
 			}
 		}
+
+		if len(files) < workflowPageSize {
+			break
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("error writing input file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("error closing file Stream: %w", err)
 	}
+	return rootDirectories(dirs), nil
+}
 
-	// Pull dirs out of keys
-	rootDirs := make([]string, len(rootsMap))
-	i := 0
-	for k := range rootsMap {
-		rootDirs[i] = k
-		i++
+// rootDirectories returns the highest level folders of the provided folders.
+func rootDirectories(dirs []string) []string {
+	// Sorting places every folder after the folders that contain it
+	sorted := append([]string(nil), dirs...)
+	sort.Strings(sorted)
+
+	var rootDirs []string
+	for _, dir := range sorted {
+		isRoot := true
+		for _, root := range rootDirs {
+			if isSubFolder(root, dir) {
+				isRoot = false
+				break
+			}
+		}
+		if isRoot {
+			rootDirs = append(rootDirs, dir)
+		}
 	}
 	return rootDirs
 }
+
+// isSubFolder returns true if the folder is, or is inside, the parent folder.
+func isSubFolder(parent string, folder string) bool {
+	rel, err := filepath.Rel(parent, folder)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
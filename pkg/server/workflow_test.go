@@ -1,12 +1,28 @@
 package server
 
 import (
+	"context"
 	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/shared"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-agent/v2/workflow"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 type WorkflowTestSuite struct {
@@ -19,12 +35,20 @@ var (
 )
 
 /*
-getRootDirectories takes in *api.ListManifestFilesResponse
+rootDirectories takes in the folders of the files in a manifest
 and returns only the root most directories as an array
 */
-func (s *WorkflowTestSuite) TestGetRootDirectories() {
+func (s *WorkflowTestSuite) TestRootDirectories() {
+	sourceDirs := func(resp *api.ListManifestFilesResponse) []string {
+		var dirs []string
+		for _, file := range resp.File {
+			dirs = append(dirs, filepath.Dir(file.SourcePath))
+		}
+		return dirs
+	}
+
 	expectedSingleRootDirResponse := []string{filepath.Clean("/Users/pennUser/Documents/bids-data")}
-	actualSingleRootDirResponse := getRootDirectories(singleRootDir)
+	actualSingleRootDirResponse := rootDirectories(sourceDirs(singleRootDir))
 
 	// Add a different root dir path
 	multipleRootDirs := singleRootDir
@@ -39,7 +63,7 @@ func (s *WorkflowTestSuite) TestGetRootDirectories() {
 	multipleRootDirs.File = append(multipleRootDirs.File, &newFile)
 
 	expectedMultipleRootDirResponse := append(expectedSingleRootDirResponse, filepath.Clean("/Volumes/mounted/.background"))
-	actualMultipleRootDirs := getRootDirectories(singleRootDir)
+	actualMultipleRootDirs := rootDirectories(sourceDirs(multipleRootDirs))
 
 	assert.Equal(s.T(), expectedSingleRootDirResponse, actualSingleRootDirResponse)
 	assert.Contains(s.T(), expectedMultipleRootDirResponse, actualMultipleRootDirs[0])
@@ -85,3 +109,322 @@ func TestWorkflowSuite(t *testing.T) {
 	}
 	suite.Run(t, new(WorkflowTestSuite))
 }
+
+// memoryWorkflowRunStore keeps workflow runs in memory.
+type memoryWorkflowRunStore struct {
+	mu   sync.Mutex
+	runs map[string]models.WorkflowRun
+}
+
+func (m *memoryWorkflowRunStore) CreateRun(ctx context.Context, run models.WorkflowRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.runs[run.Id] = run
+	return nil
+}
+
+func (m *memoryWorkflowRunStore) UpdateRun(ctx context.Context, run models.WorkflowRun) error {
+	return m.CreateRun(ctx, run)
+}
+
+func (m *memoryWorkflowRunStore) GetRun(ctx context.Context, id string) (*models.WorkflowRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run, ok := m.runs[id]
+	if !ok {
+		return nil, store.ErrWorkflowRunNotFound
+	}
+	return &run, nil
+}
+
+func (m *memoryWorkflowRunStore) ListRuns(ctx context.Context, manifestId int32) ([]models.WorkflowRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var runs []models.WorkflowRun
+	for _, run := range m.runs {
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (m *memoryWorkflowRunStore) FailInterruptedRuns(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func newWorkflowTestServer(t *testing.T, nrFiles int, script string) *agentServer {
+	t.Setenv("HOME", t.TempDir())
+
+	files := make([]store.ManifestFile, nrFiles)
+	for i := range files {
+		files[i] = store.ManifestFile{
+			Id:         int32(i + 1),
+			ManifestId: 1,
+			SourcePath: filepath.Join("/data", "sub-"+strconv.Itoa(i%3), "file"+strconv.Itoa(i)+".edf"),
+		}
+	}
+
+	return &agentServer{
+		manifest:         newStubManifestService().withFiles(files),
+		workflowRunStore: &memoryWorkflowRunStore{runs: map[string]models.WorkflowRun{}},
+		workflowCommandOverride: func(ctx context.Context, workOrder WorkOrder) *exec.Cmd {
+			return exec.CommandContext(ctx, "sh", "-c", script)
+		},
+	}
+}
+
+func waitForWorkflowRun(t *testing.T, s *agentServer, runId string) *api.WorkflowRun {
+	var run *api.WorkflowRun
+	require.Eventually(t, func() bool {
+		response, err := s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: runId})
+		require.NoError(t, err)
+		run = response.Run
+		return run.FinishedAt != 0
+	}, 10*time.Second, 20*time.Millisecond)
+	return run
+}
+
+func TestStartWorkflow(t *testing.T) {
+	s := newWorkflowTestServer(t, 2500, "echo started; echo warning >&2; wc -l < workflow/input.csv")
+	workflowPath := filepath.Join(t.TempDir(), "workflow.nf")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("workflow {}"), 0644))
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: workflowPath})
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.NotEmpty(t, response.RunId)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	assert.Equal(t, api.WorkflowRun_SUCCEEDED, run.Status, run.Error)
+	assert.NotZero(t, run.StartedAt)
+
+	// All pages of the manifest are written to the input
+	status, err := s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: run.Id, LogLines: 10})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"started", "warning", "2501"}, status.Log)
+
+	// The workflow files and configuration are written to the job folder
+	assert.FileExists(t, filepath.Join(run.JobDir, "workflow", workflow.Main))
+	assert.FileExists(t, filepath.Join(run.JobDir, "nextflow.config"))
+
+	list, err := s.ListWorkflows(context.Background(), &api.ListWorkflowsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Runs, 1)
+}
+
+func TestStartWorkflowErrors(t *testing.T) {
+	s := newWorkflowTestServer(t, 1, "exit 3")
+
+	_, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: "does-not-exist.nf"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	workflowPath := filepath.Join(t.TempDir(), "workflow.nf")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("workflow {}"), 0644))
+
+	_, err = s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 2, WorkflowFlag: workflowPath})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Relative paths are not resolved against the working directory of the agent
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(filepath.Dir(workflowPath)))
+	_, err = s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: "workflow.nf"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NoError(t, os.Chdir(wd))
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: workflowPath})
+	require.NoError(t, err)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	assert.Equal(t, api.WorkflowRun_FAILED, run.Status)
+	assert.Equal(t, int32(3), run.ExitCode)
+
+	_, err = s.CancelWorkflow(context.Background(), &api.CancelWorkflowRequest{RunId: run.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expect finished runs not to be cancelled.")
+
+	_, err = s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelWorkflow(t *testing.T) {
+	s := newWorkflowTestServer(t, 1, "echo started; exec sleep 30")
+	workflowPath := filepath.Join(t.TempDir(), "workflow.nf")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("workflow {}"), 0644))
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: workflowPath})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		status, err := s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: response.RunId})
+		return err == nil && status.Run.Status == api.WorkflowRun_RUNNING
+	}, 10*time.Second, 20*time.Millisecond)
+
+	_, err = s.CancelWorkflow(context.Background(), &api.CancelWorkflowRequest{RunId: response.RunId})
+	require.NoError(t, err)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	assert.Equal(t, api.WorkflowRun_CANCELLED, run.Status)
+}
+
+func TestUploadDerivatives(t *testing.T) {
+	s := newWorkflowTestServer(t, 1, "mkdir -p .derivatives/sub-0 && echo result > .derivatives/sub-0/result.csv")
+	workflowPath := filepath.Join(t.TempDir(), "pipeline.nf")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("workflow {}"), 0644))
+
+	var uploaded []int32
+	s.uploadManifestOverride = func(ctx context.Context, req *api.UploadManifestRequest) (*api.SimpleStatusResponse, error) {
+		uploaded = append(uploaded, req.ManifestId)
+		return &api.SimpleStatusResponse{}, nil
+	}
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{
+		ManifestId:            1,
+		WorkflowFlag:          workflowPath,
+		UploadDerivatives:     true,
+		DerivativesTargetPath: "/results/{workflow}/",
+	})
+	require.NoError(t, err)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	require.Equal(t, api.WorkflowRun_SUCCEEDED, run.Status, run.Error)
+	assert.Equal(t, int32(2), run.DerivativesManifestId)
+	assert.Equal(t, []int32{2}, uploaded)
+
+	// The derivatives manifest targets the dataset of the source manifest
+	m := s.manifest.(*stubManifestService)
+	require.Len(t, m.created, 1)
+	assert.Equal(t, "N:dataset:1", m.created[0].DatasetId)
+
+	targets := map[string]string{}
+	for _, f := range m.addedFiles {
+		assert.Equal(t, int32(2), f.ManifestId)
+		targets[filepath.Base(f.SourcePath)] = f.TargetPath
+	}
+	assert.Equal(t, map[string]string{
+		"result.csv":      "results/pipeline/sub-0",
+		"work_order.json": "results/pipeline",
+	}, targets)
+}
+
+func TestUploadDerivativesWithoutOutput(t *testing.T) {
+	s := newWorkflowTestServer(t, 1, "true")
+	workflowPath := filepath.Join(t.TempDir(), "pipeline.nf")
+	require.NoError(t, os.WriteFile(workflowPath, []byte("workflow {}"), 0644))
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{
+		ManifestId:        1,
+		WorkflowFlag:      workflowPath,
+		UploadDerivatives: true,
+	})
+	require.NoError(t, err)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	assert.Equal(t, api.WorkflowRun_SUCCEEDED, run.Status, run.Error)
+	assert.Zero(t, run.DerivativesManifestId)
+	assert.Empty(t, s.manifest.(*stubManifestService).created)
+}
+
+func TestDerivativesTargetPath(t *testing.T) {
+	assert.Equal(t, "derivatives/pipeline/run-1", derivativesTargetPath("", "pipeline", "run-1"))
+	assert.Equal(t, "out/run-1", derivativesTargetPath("/out/{run}/", "pipeline", "run-1"))
+	assert.Equal(t, "pipeline", derivativesTargetPath("../{workflow}", "pipeline", "run-1"))
+}
+
+func TestStartNamedWorkflow(t *testing.T) {
+	s := newWorkflowTestServer(t, 1, "")
+	s.workflowCommandOverride = nil
+	viper.Set("agent.workflow_allow_local", true)
+	defer viper.Set("agent.workflow_allow_local", false)
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+	folder := filepath.Join(home, ".pennsieve", "workflows", "count")
+	require.NoError(t, os.MkdirAll(folder, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "count.sh"),
+		[]byte("#!/bin/sh\ncat \"$PENNSIEVE_WORKFLOW_PARAMS\"\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "workflow.json"), []byte(`{
+		"description": "Counts files",
+		"entrypoint": "count.sh",
+		"executor": "local",
+		"parameters": {"properties": {"level": {"type": "integer", "default": 1}}}
+	}`), 0644))
+
+	list, err := s.ListRegisteredWorkflows(context.Background(), &api.ListRegisteredWorkflowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Workflows, 1)
+	assert.Equal(t, "count", list.Workflows[0].Name)
+
+	describe, err := s.DescribeWorkflow(context.Background(), &api.DescribeWorkflowRequest{Name: "count"})
+	require.NoError(t, err)
+	require.Len(t, describe.Workflow.Parameters, 1)
+	assert.Equal(t, "1", describe.Workflow.Parameters[0].Default)
+
+	_, err = s.DescribeWorkflow(context.Background(), &api.DescribeWorkflowRequest{Name: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Parameters are validated before the run starts
+	_, err = s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: "count",
+		Params: map[string]string{"level": "high"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Registered workflows take precedence over files in the working directory of the agent
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	defer os.Chdir(wd)
+	require.NoError(t, os.WriteFile("count", []byte("#!/bin/sh\nexit 1\n"), 0755))
+
+	response, err := s.StartWorkflow(context.Background(), &api.StartWorkflowRequest{ManifestId: 1, WorkflowFlag: "count",
+		Params: map[string]string{"level": "3"}})
+	require.NoError(t, err)
+	assert.Equal(t, api.WorkflowResponse_NAMED, response.WorkflowType)
+
+	run := waitForWorkflowRun(t, s, response.RunId)
+	require.Equal(t, api.WorkflowRun_SUCCEEDED, run.Status, run.Error)
+	assert.Equal(t, "count", run.Workflow)
+
+	log, err := s.GetWorkflowStatus(context.Background(), &api.GetWorkflowStatusRequest{RunId: run.Id, LogLines: 10})
+	require.NoError(t, err)
+	assert.Contains(t, log.Log, `  "level": 3`)
+}
+
+// serialStream records whether Send is called concurrently.
+type serialStream struct {
+	api.Agent_SubscribeServer
+	inFlight   atomic.Int32
+	concurrent atomic.Bool
+	sent       atomic.Int32
+}
+
+func (s *serialStream) Send(resp *api.SubscribeResponse) error {
+	if s.inFlight.Add(1) > 1 {
+		s.concurrent.Store(true)
+	}
+	time.Sleep(time.Millisecond)
+	s.inFlight.Add(-1)
+	s.sent.Add(1)
+	return nil
+}
+
+func TestWorkflowOutputSerializesSends(t *testing.T) {
+	s := &agentServer{}
+	stream := &serialStream{}
+	s.subscribers.Store(int32(1), shared.NewSub(stream, make(chan bool)))
+
+	// The stdout and stderr of a workflow are written from separate goroutines
+	var logMu sync.Mutex
+	var wg sync.WaitGroup
+	for _, stderr := range []bool{false, true} {
+		out := &workflowOutput{s: s, runId: "run", stderr: stderr, log: io.Discard, logMu: &logMu}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				out.Write([]byte("line\n"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(40), stream.sent.Load())
+	assert.False(t, stream.concurrent.Load(), "Expect sends to a subscriber to be serialized.")
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"math"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+)
+
+// Decimation describes how timeseries data is downsampled before it is streamed to the client.
+type Decimation struct {
+	Method       api.GetTimeseriesRangeRequest_DecimationMethod
+	SamplePeriod float64 // Target period between points in microseconds; 0 disables decimation
+}
+
+// NewDecimation returns the decimation for a range request. The target sample period is the
+// largest of the requested sample period and the period that results in pixelWidth points
+// for the requested range.
+func NewDecimation(method api.GetTimeseriesRangeRequest_DecimationMethod, startTime uint64, endTime uint64,
+	pixelWidth uint32, samplePeriod float64) Decimation {
+
+	period := samplePeriod
+	if pixelWidth > 0 && endTime > startTime {
+		period = math.Max(period, float64(endTime-startTime)/float64(pixelWidth))
+	}
+
+	return Decimation{
+		Method:       method,
+		SamplePeriod: period,
+	}
+}
+
+// bucketSize returns the number of samples that are combined into a single point for data with the
+// provided sampling rate, or 1 if the data should not be decimated.
+func (d Decimation) bucketSize(rate float64) int {
+	if d.SamplePeriod <= 0 || rate <= 0 {
+		return 1
+	}
+
+	return max(int(d.SamplePeriod*rate/1e6), 1)
+}
+
+// minMaxDecimate returns the minimum and maximum value for each bucket of bucketSize samples.
+func minMaxDecimate(data []float64, bucketSize int) []float64 {
+	result := make([]float64, 0, 2*((len(data)+bucketSize-1)/bucketSize))
+	for start := 0; start < len(data); start += bucketSize {
+		end := min(start+bucketSize, len(data))
+
+		lo, hi := data[start], data[start]
+		for _, v := range data[start+1 : end] {
+			lo = min(lo, v)
+			hi = max(hi, v)
+		}
+
+		result = append(result, lo, hi)
+	}
+
+	return result
+}
+
+// lttbDecimate selects threshold samples using the Largest-Triangle-Three-Buckets algorithm and
+// returns the indices of the selected samples. The first and last samples are always selected.
+func lttbDecimate(data []float64, threshold int) []int {
+	n := len(data)
+	if threshold >= n || threshold < 3 {
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices
+	}
+
+	indices := make([]int, 0, threshold)
+	indices = append(indices, 0)
+
+	// Buckets exclude the first and last sample
+	bucketSize := float64(n-2) / float64(threshold-2)
+
+	a := 0
+	for i := 0; i < threshold-2; i++ {
+
+		// Average point of the next bucket
+		avgStart := int(float64(i+1)*bucketSize) + 1
+		avgEnd := min(int(float64(i+2)*bucketSize)+1, n)
+
+		var avgX, avgY float64
+		for j := avgStart; j < avgEnd; j++ {
+			avgX += float64(j)
+			avgY += data[j]
+		}
+		count := float64(avgEnd - avgStart)
+		avgX /= count
+		avgY /= count
+
+		// Select the point in the current bucket that forms the largest triangle with
+		// the previously selected point and the average point of the next bucket.
+		rangeStart := int(float64(i)*bucketSize) + 1
+		rangeEnd := int(float64(i+1)*bucketSize) + 1
+
+		ax, ay := float64(a), data[a]
+		maxArea := -1.0
+		next := rangeStart
+		for j := rangeStart; j < rangeEnd; j++ {
+			area := math.Abs((ax-avgX)*(data[j]-ay) - (ax-float64(j))*(avgY-ay))
+			if area > maxArea {
+				maxArea = area
+				next = j
+			}
+		}
+
+		indices = append(indices, next)
+		a = next
+	}
+
+	return append(indices, n-1)
+}
+
+// decimateRangeData returns the range data for the samples of a block that starts at startTime, decimated
+// according to the decimation, and the values of the points in the range data. The points are the samples at
+// full resolution if no decimation is needed. The values are not set in the range data as they depend on the
+// requested sample encoding.
+func decimateRangeData(data []float64, startTime uint64, rate float64, decimation Decimation) (*api.GetTimeseriesRangeResponse_RangeData, []float64) {
+
+	bucketSize := decimation.bucketSize(rate)
+	if bucketSize <= 1 || len(data) <= bucketSize {
+		result := &api.GetTimeseriesRangeResponse_RangeData{
+			Rate: float32(rate),
+		}
+		if rate > 0 {
+			result.SamplePeriod = 1e6 / rate
+		}
+		return result, data
+	}
+
+	result := &api.GetTimeseriesRangeResponse_RangeData{
+		Rate:         float32(rate / float64(bucketSize)),
+		SamplePeriod: float64(bucketSize) * 1e6 / rate,
+	}
+
+	var points []float64
+	switch decimation.Method {
+	case api.GetTimeseriesRangeRequest_LTTB:
+		threshold := (len(data) + bucketSize - 1) / bucketSize
+		indices := lttbDecimate(data, threshold)
+
+		points = make([]float64, len(indices))
+		result.Timestamps = make([]uint64, len(indices))
+		for i, idx := range indices {
+			points[i] = data[idx]
+			result.Timestamps[i] = startTime + uint64(float64(idx)*1e6/rate)
+		}
+	default:
+		points = minMaxDecimate(data, bucketSize)
+		result.IsMinMax = true
+	}
+
+	return result, points
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecimation(t *testing.T) {
+	// 1 hour at 2000 pixels --> 1.8 second per point
+	d := NewDecimation(api.GetTimeseriesRangeRequest_MIN_MAX, 0, 3600000000, 2000, 0)
+	assert.Equal(t, 1800000.0, d.SamplePeriod)
+	assert.Equal(t, 1800, d.bucketSize(1000))
+
+	// The larger of the requested sample period and the pixel width period is used
+	d = NewDecimation(api.GetTimeseriesRangeRequest_MIN_MAX, 0, 3600000000, 2000, 5000000)
+	assert.Equal(t, 5000000.0, d.SamplePeriod)
+
+	// Full resolution if the target period is smaller than the sample period
+	d = NewDecimation(api.GetTimeseriesRangeRequest_MIN_MAX, 0, 1000000, 2000, 0)
+	assert.Equal(t, 1, d.bucketSize(1000))
+
+	assert.Equal(t, 1, Decimation{}.bucketSize(1000))
+}
+
+func TestMinMaxDecimate(t *testing.T) {
+	data := []float64{1, 5, -2, 3, 0, 7, 4, 4, 9}
+	assert.Equal(t, []float64{-2, 5, 0, 7, 4, 9}, minMaxDecimate(data, 3))
+	assert.Equal(t, []float64{1, 5, -2, 3, 0, 7, 4, 4, 9, 9}, minMaxDecimate(data, 2), "Expect incomplete last bucket to be included.")
+}
+
+func TestLttbDecimate(t *testing.T) {
+	data := []float64{0, 0, 0, 10, 0, 0, 0, 0, -10, 0, 0, 0}
+
+	indices := lttbDecimate(data, 4)
+	require.Len(t, indices, 4)
+	assert.Equal(t, 0, indices[0])
+	assert.Equal(t, len(data)-1, indices[3])
+
+	// Peaks are preserved
+	assert.Contains(t, indices, 3)
+	assert.Contains(t, indices, 8)
+
+	// No decimation when the threshold exceeds the number of samples
+	assert.Len(t, lttbDecimate(data, 20), len(data))
+}
+
+func TestDecimateRangeData(t *testing.T) {
+	data := make([]float64, 1000)
+	for i := range data {
+		data[i] = float64(i % 10)
+	}
+
+	// 1000 Hz with 10ms target period --> buckets of 10 samples
+	decimation := Decimation{Method: api.GetTimeseriesRangeRequest_MIN_MAX, SamplePeriod: 10000}
+	result, points := decimateRangeData(data, 1000000, 1000, decimation)
+	assert.True(t, result.IsMinMax)
+	assert.Len(t, points, 200)
+	assert.Equal(t, float32(100), result.Rate)
+	assert.Equal(t, 10000.0, result.SamplePeriod)
+	assert.Equal(t, []float64{0, 9}, points[:2])
+
+	decimation.Method = api.GetTimeseriesRangeRequest_LTTB
+	result, points = decimateRangeData(data, 1000000, 1000, decimation)
+	assert.False(t, result.IsMinMax)
+	assert.Len(t, points, 100)
+	require.Len(t, result.Timestamps, 100)
+	assert.Equal(t, uint64(1000000), result.Timestamps[0])
+	assert.Equal(t, uint64(1999000), result.Timestamps[99])
+
+	// Full resolution
+	result, points = decimateRangeData(data, 1000000, 1000, Decimation{})
+	assert.Len(t, points, 1000)
+	assert.Equal(t, 1000.0, result.SamplePeriod)
+}
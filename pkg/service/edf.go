@@ -0,0 +1,252 @@
+package service
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	edfDigitalMin = math.MinInt16
+	edfDigitalMax = math.MaxInt16
+)
+
+// edfSignal is a channel that is written to the data records of an EDF file.
+type edfSignal struct {
+	label            string
+	unit             string
+	rate             float64
+	physicalMin      float64
+	physicalMax      float64
+	samplesPerRecord int
+}
+
+// writeEdf writes the channels between startTime and endTime (µs) as a 16-bit EDF file. Each channel
+// is stored with its own sampling rate and is scaled to the physical range of its exported samples.
+// Periods without data are written as zero, as EDF does not support discontinuous recordings.
+//
+// The start time in the EDF header has a resolution of one second. The recording starts at the whole
+// second before startTime, and the samples keep their sub-second offset from that start.
+//
+// The channels are read twice: once to determine the physical range of each signal, which is part of
+// the header, and once to write the data records. Only a single data record is held in memory. It
+// returns the number of exported samples.
+func writeEdf(w *bufio.Writer, recording string, startTime uint64, endTime uint64, channels []exportChannel) (int64, error) {
+
+	// Records last one second, unless a channel is sampled slower than once per second.
+	recordDuration := 1.0
+	for _, ch := range channels {
+		if ch.channel.Rate <= 0 {
+			return 0, fmt.Errorf("channel %s has an invalid sampling rate", ch.channel.Name)
+		}
+		recordDuration = math.Max(recordDuration, math.Ceil(1/ch.channel.Rate))
+	}
+
+	recordingStart := startTime - startTime%1e6
+	numRecords := int(math.Ceil(float64(endTime-recordingStart) / 1e6 / recordDuration))
+	numRecords = max(numRecords, 1)
+
+	signals := make([]edfSignal, len(channels))
+	for i, ch := range channels {
+		signal, err := newEdfSignal(ch, recordDuration)
+		if err != nil {
+			return 0, err
+		}
+		signals[i] = signal
+	}
+
+	start := time.UnixMicro(int64(recordingStart)).UTC()
+
+	var header strings.Builder
+	header.WriteString(edfField("0", 8))
+	header.WriteString(edfField("X X X X", 80))
+	header.WriteString(edfField("Startdate "+strings.ToUpper(start.Format("02-Jan-2006"))+" X X "+recording, 80))
+	header.WriteString(edfField(start.Format("02.01.06"), 8))
+	header.WriteString(edfField(start.Format("15.04.05"), 8))
+	header.WriteString(edfField(strconv.Itoa(256*(len(signals)+1)), 8))
+	header.WriteString(edfField("", 44))
+	header.WriteString(edfField(strconv.Itoa(numRecords), 8))
+	header.WriteString(edfField(edfNumber(recordDuration), 8))
+	header.WriteString(edfField(strconv.Itoa(len(signals)), 4))
+
+	// Signal headers are stored per field, for all signals
+	signalFields := []struct {
+		width int
+		value func(s edfSignal) string
+	}{
+		{16, func(s edfSignal) string { return s.label }},
+		{80, func(s edfSignal) string { return "" }},
+		{8, func(s edfSignal) string { return s.unit }},
+		{8, func(s edfSignal) string { return edfNumber(s.physicalMin) }},
+		{8, func(s edfSignal) string { return edfNumber(s.physicalMax) }},
+		{8, func(s edfSignal) string { return strconv.Itoa(edfDigitalMin) }},
+		{8, func(s edfSignal) string { return strconv.Itoa(edfDigitalMax) }},
+		{80, func(s edfSignal) string { return "" }},
+		{8, func(s edfSignal) string { return strconv.Itoa(s.samplesPerRecord) }},
+		{32, func(s edfSignal) string { return "" }},
+	}
+	for _, field := range signalFields {
+		for _, s := range signals {
+			header.WriteString(edfField(field.value(s), field.width))
+		}
+	}
+
+	if _, err := w.WriteString(header.String()); err != nil {
+		return 0, err
+	}
+
+	recordSize := 0
+	maxSamples := 0
+	for _, s := range signals {
+		recordSize += 2 * s.samplesPerRecord
+		maxSamples = max(maxSamples, s.samplesPerRecord)
+	}
+
+	cursors := make([]*channelCursor, len(channels))
+	for i, ch := range channels {
+		cursors[i] = &channelCursor{ch: ch}
+	}
+
+	var samples int64
+	record := make([]byte, recordSize)
+	values := make([]float64, maxSamples)
+	for r := 0; r < numRecords; r++ {
+		offset := 0
+		for i, s := range signals {
+			n, err := s.readRecord(cursors[i], recordingStart, r, values[:s.samplesPerRecord])
+			if err != nil {
+				return 0, err
+			}
+			samples += n
+
+			for _, v := range values[:s.samplesPerRecord] {
+				binary.LittleEndian.PutUint16(record[offset:], uint16(s.digital(v)))
+				offset += 2
+			}
+		}
+		if _, err := w.Write(record); err != nil {
+			return 0, err
+		}
+	}
+
+	return samples, nil
+}
+
+// newEdfSignal returns the signal of the channel for data records of recordDuration seconds, with the
+// physical range of the samples of the channel.
+func newEdfSignal(ch exportChannel, recordDuration float64) (edfSignal, error) {
+	physicalMin, physicalMax := math.Inf(1), math.Inf(-1)
+	c := &channelCursor{ch: ch}
+	for {
+		_, v, ok, err := c.peek()
+		if err != nil {
+			return edfSignal{}, err
+		}
+		if !ok {
+			break
+		}
+		physicalMin = math.Min(physicalMin, float64(v))
+		physicalMax = math.Max(physicalMax, float64(v))
+		c.advance()
+	}
+
+	if math.IsInf(physicalMin, 1) {
+		physicalMin, physicalMax = -1, 1
+	}
+	if physicalMin == physicalMax {
+		physicalMin, physicalMax = physicalMin-1, physicalMax+1
+	}
+
+	// Scale to the range as it is written in the header.
+	physicalMin, _ = strconv.ParseFloat(edfNumber(physicalMin), 64)
+	physicalMax, _ = strconv.ParseFloat(edfNumber(physicalMax), 64)
+
+	return edfSignal{
+		label:            ch.channel.Name,
+		unit:             ch.channel.Unit,
+		rate:             ch.channel.Rate,
+		physicalMin:      physicalMin,
+		physicalMax:      physicalMax,
+		samplesPerRecord: max(int(math.Round(ch.channel.Rate*recordDuration)), 1),
+	}, nil
+}
+
+// readRecord reads the samples of data record r from the cursor into samples, where recordingStart (µs)
+// is the start of the first data record. Missing samples are set to NaN. Samples before the record that
+// were not read yet are skipped. It returns the number of samples that were read into the record.
+func (s edfSignal) readRecord(c *channelCursor, recordingStart uint64, r int, samples []float64) (int64, error) {
+	for i := range samples {
+		samples[i] = math.NaN()
+	}
+
+	first := r * s.samplesPerRecord
+	var n int64
+	for {
+		ts, v, ok, err := c.peek()
+		if err != nil || !ok {
+			return n, err
+		}
+
+		idx := int(math.Round(float64(int64(ts)-int64(recordingStart)) * s.rate / 1e6))
+		if idx >= first+len(samples) {
+			return n, nil
+		}
+		c.advance()
+
+		if idx >= first {
+			samples[idx-first] = float64(v)
+			n++
+		}
+	}
+}
+
+// digital returns the digital value of the physical value v. Missing values are written as zero.
+func (s edfSignal) digital(v float64) int16 {
+	if math.IsNaN(v) {
+		v = math.Max(s.physicalMin, math.Min(0, s.physicalMax))
+	}
+
+	d := (v-s.physicalMin)/(s.physicalMax-s.physicalMin)*(edfDigitalMax-edfDigitalMin) + edfDigitalMin
+	return int16(math.Max(edfDigitalMin, math.Min(edfDigitalMax, math.Round(d))))
+}
+
+// edfField returns value as a printable ASCII string, left-aligned and padded with spaces to width.
+func edfField(value string, width int) string {
+	var b strings.Builder
+	for _, r := range value {
+		if b.Len() == width {
+			break
+		}
+		if r < 32 || r > 126 {
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String() + strings.Repeat(" ", width-b.Len())
+}
+
+// edfNumber formats v with as much precision as fits in an 8 character header field.
+func edfNumber(v float64) string {
+	for prec := 6; prec >= 0; prec-- {
+		s := strconv.FormatFloat(v, 'f', prec, 64)
+		if strings.Contains(s, ".") {
+			s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+		}
+		if len(s) <= 8 {
+			return s
+		}
+	}
+
+	// Values that are too large are written in exponent notation.
+	for prec := 4; prec > 1; prec-- {
+		if s := strconv.FormatFloat(v, 'g', prec, 64); len(s) <= 8 {
+			return s
+		}
+	}
+	return strconv.FormatFloat(v, 'g', 1, 64)
+}
@@ -48,6 +48,11 @@ func (s *ManifestService) GetFiles(manifestId int32, limit int32, offset int32)
 	return s.mfStore.Get(manifestId, limit, offset)
 }
 
+// FindFiles returns a page of the files in a manifest that match the filter, and the total number of matching files.
+func (s *ManifestService) FindFiles(manifestId int32, filter store.ManifestFileFilter) ([]store.ManifestFile, int64, error) {
+	return s.mfStore.Find(manifestId, filter)
+}
+
 func (s *ManifestService) ResetStatusForManifest(manifestId int32) error {
 	return s.mfStore.ResetStatusForManifest(manifestId)
 }
@@ -86,3 +91,20 @@ func (s *ManifestService) SetFileStatus(uploadId string, status manifestFile.Sta
 func (s *ManifestService) BatchSetFileStatus(uploadIds []string, status manifestFile.Status) error {
 	return s.mfStore.BatchSetStatus(status, uploadIds)
 }
+
+func (s *ManifestService) UpdateFileTargets(manifestId int32, targets []store.ManifestFileTarget) error {
+	return s.mfStore.UpdateTargets(manifestId, targets)
+}
+
+func (s *ManifestService) SetFileMetadata(manifestId int32, metadata map[string]map[string]string) ([]string, error) {
+	return s.mfStore.SetMetadata(manifestId, metadata)
+}
+
+func (s *ManifestService) GetStatusCounts(manifestId int32) (map[manifestFile.Status]int64, error) {
+	return s.mfStore.GetStatusCounts(manifestId)
+}
+
+// UpdateState moves the manifest to the next lifecycle state after an event.
+func (s *ManifestService) UpdateState(manifestId int32, event models.ManifestEvent) (models.ManifestState, error) {
+	return s.mStore.UpdateState(manifestId, event)
+}
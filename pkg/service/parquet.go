@@ -0,0 +1,316 @@
+package service
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+)
+
+// Parquet constants used by the writer, see https://github.com/apache/parquet-format
+const (
+	parquetMagic = "PAR1"
+
+	parquetTypeInt64 = 2
+	parquetTypeFloat = 4
+
+	parquetRepetitionRequired = 0
+	parquetConvertedTimestamp = 10 // TIMESTAMP_MICROS
+	parquetEncodingPlain      = 0
+	parquetEncodingRle        = 3
+	parquetCodecUncompressed  = 0
+	parquetPageTypeData       = 0
+
+	// parquetRowGroupRows is the maximum number of rows per row group. Each column of a row group is
+	// written as a single data page.
+	parquetRowGroupRows = 1 << 16
+)
+
+// parquetColumnChunk is a column of a row group that was written to the file.
+type parquetColumnChunk struct {
+	name      string
+	typ       int32
+	converted int32 // Converted type of the column; 0 if none
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+// parquetWriter writes the columns of row groups as PLAIN encoded data pages and keeps track of the
+// written column chunks for the file metadata.
+type parquetWriter struct {
+	w         *bufio.Writer
+	offset    int64
+	rowGroups [][]parquetColumnChunk
+	numRows   []int64
+}
+
+// writeRowGroup writes a row group with a timestamp column and a float column per channel.
+func (p *parquetWriter) writeRowGroup(columns []string, timestamps []uint64, values [][]float32) error {
+	numRows := len(timestamps)
+	data := make([]byte, 8*numRows)
+
+	var chunks []parquetColumnChunk
+	for row, ts := range timestamps {
+		binary.LittleEndian.PutUint64(data[8*row:], ts)
+	}
+	chunk, err := p.writePage("timestamp", parquetTypeInt64, numRows, data)
+	if err != nil {
+		return err
+	}
+	chunk.converted = parquetConvertedTimestamp
+	chunks = append(chunks, chunk)
+
+	for c, column := range columns {
+		data = data[:4*numRows]
+		for row, v := range values[c][:numRows] {
+			binary.LittleEndian.PutUint32(data[4*row:], math.Float32bits(v))
+		}
+		chunk, err := p.writePage(column, parquetTypeFloat, numRows, data)
+		if err != nil {
+			return err
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	p.rowGroups = append(p.rowGroups, chunks)
+	p.numRows = append(p.numRows, int64(numRows))
+	return nil
+}
+
+// writePage writes the values of a column chunk as a single data page.
+func (p *parquetWriter) writePage(name string, typ int32, numValues int, data []byte) (parquetColumnChunk, error) {
+	chunk := parquetColumnChunk{name: name, typ: typ, offset: p.offset, numValues: int64(numValues)}
+
+	var header thriftWriter
+	header.beginStruct()
+	header.i32(1, parquetPageTypeData)
+	header.i32(2, int32(len(data)))
+	header.i32(3, int32(len(data)))
+	header.structField(5)
+	header.i32(1, int32(numValues))
+	header.i32(2, parquetEncodingPlain)
+	header.i32(3, parquetEncodingRle)
+	header.i32(4, parquetEncodingRle)
+	header.endStruct()
+	header.endStruct()
+
+	if _, err := p.w.Write(header.buf); err != nil {
+		return chunk, err
+	}
+	if _, err := p.w.Write(data); err != nil {
+		return chunk, err
+	}
+
+	chunk.size = int64(len(header.buf) + len(data))
+	p.offset += chunk.size
+	return chunk, nil
+}
+
+// writeParquet writes the channels as an uncompressed Parquet file. The file has a timestamp column (µs)
+// and a float column per channel. Missing samples are written as NaN. The rows are written in row groups
+// of at most parquetRowGroupRows rows, so only a single row group is held in memory. The channel metadata
+// is stored as JSON in the "pennsieve.channels" key of the file metadata. It returns the number of
+// exported samples.
+func writeParquet(w *bufio.Writer, columns []string, channels []exportChannel, metadata exportMetadata) (int64, error) {
+	if _, err := w.WriteString(parquetMagic); err != nil {
+		return 0, err
+	}
+	p := parquetWriter{w: w, offset: int64(len(parquetMagic))}
+
+	timestamps := make([]uint64, 0, parquetRowGroupRows)
+	values := make([][]float32, len(columns))
+	for c := range values {
+		values[c] = make([]float32, parquetRowGroupRows)
+	}
+
+	samples, err := exportRows(channels, func(ts uint64, row []float32) error {
+		for c, v := range row {
+			values[c][len(timestamps)] = v
+		}
+		timestamps = append(timestamps, ts)
+
+		if len(timestamps) < parquetRowGroupRows {
+			return nil
+		}
+		err := p.writeRowGroup(columns, timestamps, values)
+		timestamps = timestamps[:0]
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// An empty file still has a single, empty, row group.
+	if len(timestamps) > 0 || len(p.rowGroups) == 0 {
+		if err := p.writeRowGroup(columns, timestamps, values); err != nil {
+			return 0, err
+		}
+	}
+
+	channelMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return 0, err
+	}
+
+	var totalRows int64
+	for _, n := range p.numRows {
+		totalRows += n
+	}
+
+	// FileMetaData
+	var footer thriftWriter
+	footer.beginStruct()
+	footer.i32(1, 1)
+
+	schema := p.rowGroups[0]
+	footer.listField(2, thriftStruct, len(schema)+1)
+	footer.beginStruct()
+	footer.binary(4, "schema")
+	footer.i32(5, int32(len(schema)))
+	footer.endStruct()
+	for _, chunk := range schema {
+		footer.beginStruct()
+		footer.i32(1, chunk.typ)
+		footer.i32(3, parquetRepetitionRequired)
+		footer.binary(4, chunk.name)
+		if chunk.converted != 0 {
+			footer.i32(6, chunk.converted)
+		}
+		footer.endStruct()
+	}
+
+	footer.i64(3, totalRows)
+
+	footer.listField(4, thriftStruct, len(p.rowGroups))
+	for g, chunks := range p.rowGroups {
+		var totalSize int64
+		for _, chunk := range chunks {
+			totalSize += chunk.size
+		}
+
+		footer.beginStruct()
+		footer.listField(1, thriftStruct, len(chunks))
+		for _, chunk := range chunks {
+			footer.beginStruct()
+			footer.i64(2, chunk.offset)
+			footer.structField(3)
+			footer.i32(1, chunk.typ)
+			footer.listField(2, thriftI32, 2)
+			footer.varint(zigzag(parquetEncodingPlain))
+			footer.varint(zigzag(parquetEncodingRle))
+			footer.listField(3, thriftBinary, 1)
+			footer.string(chunk.name)
+			footer.i32(4, parquetCodecUncompressed)
+			footer.i64(5, chunk.numValues)
+			footer.i64(6, chunk.size)
+			footer.i64(7, chunk.size)
+			footer.i64(9, chunk.offset)
+			footer.endStruct()
+			footer.endStruct()
+		}
+		footer.i64(2, totalSize)
+		footer.i64(3, p.numRows[g])
+		footer.endStruct()
+	}
+
+	footer.listField(5, thriftStruct, 1)
+	footer.beginStruct()
+	footer.binary(1, "pennsieve.channels")
+	footer.binary(2, string(channelMetadata))
+	footer.endStruct()
+
+	footer.binary(6, "pennsieve-agent")
+	footer.endStruct()
+
+	if _, err := w.Write(footer.buf); err != nil {
+		return 0, err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(footer.buf))); err != nil {
+		return 0, err
+	}
+	if _, err := w.WriteString(parquetMagic); err != nil {
+		return 0, err
+	}
+	return samples, nil
+}
+
+// Thrift compact protocol types
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// thriftWriter encodes structs with the Thrift compact protocol, which is used for Parquet metadata.
+type thriftWriter struct {
+	buf       []byte
+	lastField []int16 // Last written field id of each open struct
+}
+
+func (t *thriftWriter) beginStruct() {
+	t.lastField = append(t.lastField, 0)
+}
+
+func (t *thriftWriter) endStruct() {
+	t.buf = append(t.buf, 0)
+	t.lastField = t.lastField[:len(t.lastField)-1]
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	last := &t.lastField[len(t.lastField)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		t.buf = append(t.buf, byte(delta)<<4|typ)
+	} else {
+		t.buf = append(t.buf, typ)
+		t.varint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (t *thriftWriter) varint(v uint64) {
+	t.buf = binary.AppendUvarint(t.buf, v)
+}
+
+func (t *thriftWriter) string(s string) {
+	t.varint(uint64(len(s)))
+	t.buf = append(t.buf, s...)
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(zigzag(int64(v)))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(zigzag(v))
+}
+
+func (t *thriftWriter) binary(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.string(s)
+}
+
+// structField starts a nested struct field, which is closed with endStruct.
+func (t *thriftWriter) structField(id int16) {
+	t.field(id, thriftStruct)
+	t.beginStruct()
+}
+
+// listField starts a list field of n elements. The elements are written directly after the header.
+func (t *thriftWriter) listField(id int16, elemType byte, n int) {
+	t.field(id, thriftList)
+	if n < 15 {
+		t.buf = append(t.buf, byte(n)<<4|elemType)
+	} else {
+		t.buf = append(t.buf, 0xf0|elemType)
+		t.varint(uint64(n))
+	}
+}
+
+func zigzag(v int64) uint64 {
+	return uint64((v << 1) ^ (v >> 63))
+}
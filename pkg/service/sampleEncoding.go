@@ -0,0 +1,103 @@
+package service
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+)
+
+// RangeEncoding describes the encoding of the data that is streamed to the client for each channel.
+type RangeEncoding struct {
+	Default  api.GetTimeseriesRangeRequest_SampleEncoding
+	Channels map[string]api.GetTimeseriesRangeRequest_SampleEncoding // Encoding per channel node id
+}
+
+// ForChannel returns the encoding of the data of a channel.
+func (e RangeEncoding) ForChannel(channelNodeId string) api.GetTimeseriesRangeRequest_SampleEncoding {
+	if encoding, ok := e.Channels[channelNodeId]; ok {
+		return encoding
+	}
+	return e.Default
+}
+
+// decodeSamples converts the content of a block file with the provided encoding to sample values.
+// Blocks on Pennsieve contain float64 samples; other encodings are rejected.
+func decodeSamples(content []byte, encoding models.TsSampleEncoding) ([]float64, error) {
+	width := encoding.SampleWidth()
+	if width == 0 {
+		return nil, fmt.Errorf("unknown sample encoding %q", encoding)
+	}
+	if len(content)%width != 0 {
+		return nil, fmt.Errorf("data of %d bytes contains a partial %s sample", len(content), encoding)
+	}
+
+	samples := make([]float64, len(content)/width)
+	for i := range samples {
+		samples[i] = math.Float64frombits(binary.BigEndian.Uint64(content[i*width : (i+1)*width]))
+	}
+
+	return samples, nil
+}
+
+// encodeRangeData sets the point values of the range data in the requested encoding.
+//
+// For integer encodings, points are quantized to the full integer range between their minimum and
+// maximum value. NaN values cannot be represented and are sent as the offset.
+func encodeRangeData(rangeData *api.GetTimeseriesRangeResponse_RangeData, points []float64,
+	encoding api.GetTimeseriesRangeRequest_SampleEncoding) {
+
+	rangeData.Encoding = encoding
+
+	switch encoding {
+	case api.GetTimeseriesRangeRequest_FLOAT64:
+		rangeData.DataFloat64 = points
+		return
+	case api.GetTimeseriesRangeRequest_INT16, api.GetTimeseriesRangeRequest_INT32:
+	default:
+		rangeData.Data = toFloat32s(points)
+		return
+	}
+
+	maxInt := float64(math.MaxInt32)
+	if encoding == api.GetTimeseriesRangeRequest_INT16 {
+		maxInt = math.MaxInt16
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range points {
+		if !math.IsNaN(v) {
+			lo = min(lo, v)
+			hi = max(hi, v)
+		}
+	}
+
+	offset, scale := 0.0, 1.0
+	if lo <= hi {
+		offset = (lo + hi) / 2
+		if hi > lo {
+			scale = (hi - lo) / (2 * maxInt)
+		}
+	}
+
+	rangeData.Offset = offset
+	rangeData.Scale = scale
+	rangeData.DataInt = make([]int32, len(points))
+	for i, v := range points {
+		if math.IsNaN(v) {
+			continue
+		}
+		rangeData.DataInt[i] = int32(math.Max(-maxInt, math.Min(maxInt, math.Round((v-offset)/scale))))
+	}
+}
+
+// toFloat32s converts sample values to single precision.
+func toFloat32s(values []float64) []float32 {
+	result := make([]float32, len(values))
+	for i, v := range values {
+		result[i] = float32(v)
+	}
+	return result
+}
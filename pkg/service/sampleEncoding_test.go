@@ -0,0 +1,68 @@
+package service
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeSamples(t *testing.T) {
+	float64s := binary.BigEndian.AppendUint64(nil, math.Float64bits(1.000000001))
+	samples, err := decodeSamples(float64s, models.TsFloat64)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.000000001}, samples, "Expect float64 samples to keep full precision.")
+
+	// Blocks without an encoding contain float64 samples
+	samples, err = decodeSamples(float64s, "")
+	require.NoError(t, err)
+	assert.Len(t, samples, 1)
+
+	_, err = decodeSamples(float64s[:6], models.TsFloat64)
+	assert.Error(t, err)
+	_, err = decodeSamples(float64s, "int16")
+	assert.Error(t, err, "Expect blocks with another encoding to be rejected.")
+}
+
+func TestEncodeRangeData(t *testing.T) {
+	points := []float64{-1.5, 0.000001, 2.5}
+
+	rangeData := &api.GetTimeseriesRangeResponse_RangeData{}
+	encodeRangeData(rangeData, points, api.GetTimeseriesRangeRequest_FLOAT64)
+	assert.Equal(t, points, rangeData.DataFloat64)
+	assert.Empty(t, rangeData.Data)
+
+	rangeData = &api.GetTimeseriesRangeResponse_RangeData{}
+	encodeRangeData(rangeData, points, api.GetTimeseriesRangeRequest_FLOAT32)
+	assert.Equal(t, []float32{-1.5, 0.000001, 2.5}, rangeData.Data)
+
+	// Float samples are quantized to the full integer range
+	rangeData = &api.GetTimeseriesRangeResponse_RangeData{}
+	encodeRangeData(rangeData, points, api.GetTimeseriesRangeRequest_INT16)
+	assert.Equal(t, api.GetTimeseriesRangeRequest_INT16, rangeData.Encoding)
+	assert.Equal(t, int32(-math.MaxInt16), rangeData.DataInt[0])
+	assert.Equal(t, int32(math.MaxInt16), rangeData.DataInt[2])
+	for i, v := range rangeData.DataInt {
+		assert.InDelta(t, points[i], rangeData.Offset+float64(v)*rangeData.Scale, 4.0/math.MaxInt16)
+	}
+
+	// Constant data and NaN values
+	rangeData = &api.GetTimeseriesRangeResponse_RangeData{}
+	encodeRangeData(rangeData, []float64{7, math.NaN(), 7}, api.GetTimeseriesRangeRequest_INT16)
+	assert.Equal(t, []int32{0, 0, 0}, rangeData.DataInt)
+	assert.Equal(t, 7.0, rangeData.Offset)
+}
+
+func TestRangeEncodingForChannel(t *testing.T) {
+	encoding := RangeEncoding{
+		Default:  api.GetTimeseriesRangeRequest_INT16,
+		Channels: map[string]api.GetTimeseriesRangeRequest_SampleEncoding{"ch2": api.GetTimeseriesRangeRequest_FLOAT64},
+	}
+
+	assert.Equal(t, api.GetTimeseriesRangeRequest_INT16, encoding.ForChannel("ch1"))
+	assert.Equal(t, api.GetTimeseriesRangeRequest_FLOAT64, encoding.ForChannel("ch2"))
+}
@@ -3,14 +3,15 @@ package service
 import (
     "compress/gzip"
     "context"
-    "encoding/binary"
+    "errors"
     "fmt"
     "io"
-    "math"
     "os"
     "path/filepath"
     "slices"
     "sort"
+    "sync"
+    "time"
 
     api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
     "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
@@ -18,6 +19,7 @@ import (
     "github.com/pennsieve/pennsieve-agent/v2/pkg/store"
     "github.com/pennsieve/pennsieve-go/pkg/pennsieve"
     log "github.com/sirupsen/logrus"
+    "github.com/spf13/viper"
 )
 
 type TimeseriesService interface {
@@ -28,8 +30,10 @@ type TimeseriesService interface {
         ChannelNodeIds []string,
         StartTime uint64,
         EndTime uint64,
+        ReadAhead bool,
+        Offline bool,
         rangeChannel chan<- models.TsBlock,
-    ) error
+    ) (models.TsCoverage, error)
     GetChannelsForPackage(
         Ctx context.Context,
         DatasetNodeId string,
@@ -41,16 +45,56 @@ type TimeseriesService interface {
         blocks <-chan models.TsBlock,
         startTime uint64,
         endTime uint64,
+        decimation Decimation,
+        encoding RangeEncoding,
         stream api.Agent_GetTimeseriesRangeForChannelsServer) error
     StreamChannelInfoToClient(
         ctx context.Context,
         channel models.TsChannel,
+        encoding api.GetTimeseriesRangeRequest_SampleEncoding,
         stream api.Agent_GetTimeseriesRangeForChannelsServer) error
     ResetCache(
         ctx context.Context,
         packagenodeId string,
         resetAll bool,
     ) error
+    GetGapsForChannels(
+        ctx context.Context,
+        datasetNodeId string,
+        packageNodeId string,
+        channelNodeIds []string,
+        startTime uint64,
+        endTime uint64,
+    ) ([]models.TsGap, error)
+    PinPackage(
+        ctx context.Context,
+        datasetNodeId string,
+        packageNodeId string,
+    ) (int64, int64, error)
+    UnpinPackage(
+        ctx context.Context,
+        packageNodeId string,
+    ) error
+    GetAnnotationsForPackage(
+        ctx context.Context,
+        datasetNodeId string,
+        packageNodeId string,
+        layerIds []int64,
+        channelNodeIds []string,
+        startTime uint64,
+        endTime uint64,
+        refresh bool,
+    ) ([]models.TsAnnotationLayer, []models.TsAnnotation, bool, error)
+    ExportRange(
+        ctx context.Context,
+        datasetNodeId string,
+        packageNodeId string,
+        channels []models.TsChannel,
+        startTime uint64,
+        endTime uint64,
+        format api.ExportTimeseriesRequest_Format,
+        target string,
+    ) ([]string, int64, error)
 }
 
 type TimeseriesServiceImpl struct {
@@ -58,19 +102,36 @@ type TimeseriesServiceImpl struct {
     subscriber    shared.Subscriber
     client        *pennsieve.Client
     cacheLocation string
+    cache         *TimeseriesCache
+
+    downloadWorkers int           // Number of blocks that are downloaded in parallel
+    downloads       sync.Map      // Blocks that are being downloaded, mapped from block node id to a *blockDownload
+    prefetches      chan struct{} // Time windows that are being read ahead, limited to maxPrefetches
 }
 
-func NewTimeseriesService(ts store.TimeseriesStore, c *pennsieve.Client, s shared.Subscriber) TimeseriesService {
+// prefetchTimeout is the maximum time spent on reading ahead the next time window of a range request.
+const prefetchTimeout = 5 * time.Minute
+
+// maxPrefetches is the maximum number of time windows that are read ahead at the same time. Read-ahead
+// requests beyond the limit are skipped.
+const maxPrefetches = 2
+
+func NewTimeseriesService(ts store.TimeseriesStore, c *pennsieve.Client, s shared.Subscriber, cache *TimeseriesCache) TimeseriesService {
     homedir, _ := os.UserHomeDir()
 
     // Ensure cache folder is created
     os.MkdirAll(filepath.Join(homedir, ".pennsieve", "timeseries"), os.ModePerm)
+    removePartialDownloads(filepath.Join(homedir, ".pennsieve", "timeseries"))
 
     return &TimeseriesServiceImpl{
         tsStore:       ts,
         client:        c,
         subscriber:    s,
         cacheLocation: filepath.Join(homedir, ".pennsieve", "timeseries"),
+        cache:         cache,
+
+        downloadWorkers: max(viper.GetInt("agent.timeseries_download_workers"), 1),
+        prefetches:      make(chan struct{}, maxPrefetches),
     }
 }
 
@@ -123,7 +184,31 @@ func (t *TimeseriesServiceImpl) ResetCache(ctx context.Context, packageNodeId st
     return nil
 }
 
-// GetRangeBlocksForChannels retrieves
+// blockRequest is a block in a range request that is either cached, or needs to be downloaded into the cache.
+type blockRequest struct {
+    block  models.TsBlock
+    url    string
+    cached bool
+    done   chan error // Receives the result of the download, or nil if the block was cached
+}
+
+// blockDownload is a block that is being downloaded into the local cache.
+// Concurrent requests for the same block wait for the same download.
+type blockDownload struct {
+    done chan struct{}
+    err  error
+}
+
+// GetRangeBlocksForChannels retrieves the blocks for the requested channels and time range and sends
+// them on the rangeChannel once they are available in the local cache.
+//
+// Uncached blocks are downloaded in parallel by a bounded pool of workers, but blocks are always sent in
+// order by channel and start time. If readAhead is set, the blocks for the next time window of the same
+// length are downloaded into the cache in the background after all requested blocks are sent, unless
+// maxPrefetches time windows are already being read ahead.
+//
+// If offline is set, or Pennsieve cannot be reached, only the blocks in the local cache are sent. The
+// returned coverage reports whether the cached blocks cover the full requested range.
 func (t *TimeseriesServiceImpl) GetRangeBlocksForChannels(
     ctx context.Context,
     DatasetNodeId string,
@@ -131,20 +216,101 @@ func (t *TimeseriesServiceImpl) GetRangeBlocksForChannels(
     ChannelNodeIds []string,
     StartTime uint64,
     EndTime uint64,
+    readAhead bool,
+    offline bool,
     rangeChannel chan<- models.TsBlock,
-) error {
+) (models.TsCoverage, error) {
+
+    if !offline {
+        requests, err := t.getBlockRequests(ctx, DatasetNodeId, PackagenodeId, ChannelNodeIds, StartTime, EndTime)
+        if err == nil {
+            err = t.sendBlocks(ctx, requests, rangeChannel)
+            if err != nil {
+                return models.TsCoverage{}, err
+            }
+
+            if readAhead && EndTime > StartTime {
+                t.startPrefetch(DatasetNodeId, PackagenodeId, ChannelNodeIds, EndTime, EndTime+(EndTime-StartTime))
+            }
+
+            return models.TsCoverage{}, nil
+        }
+
+        if !errors.Is(err, errRemoteUnavailable) {
+            return models.TsCoverage{}, err
+        }
+        log.Warn("Serving range from the local cache: ", err)
+    }
+
+    requests, partial, err := t.getCachedBlockRequests(ctx, PackagenodeId, ChannelNodeIds, StartTime, EndTime)
+    if err != nil {
+        return models.TsCoverage{}, err
+    }
+
+    err = t.sendBlocks(ctx, requests, rangeChannel)
+    return models.TsCoverage{Offline: true, Partial: partial}, err
+}
+
+// sendBlocks downloads the uncached blocks in parallel and sends all blocks on the rangeChannel in the
+// order of the requests as soon as they are available in the local cache.
+func (t *TimeseriesServiceImpl) sendBlocks(ctx context.Context, requests []*blockRequest, rangeChannel chan<- models.TsBlock) error {
+
+    ctx, cancel := context.WithCancel(ctx)
+    defer cancel()
+
+    t.downloadBlocks(ctx, requests)
+
+    for _, req := range requests {
+        select {
+        case err := <-req.done:
+            if err != nil {
+                log.Error("Error downloading block: ", err)
+                return err
+            }
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+
+        // Sending block to channel.
+        // The channel should receive blocks in the correct order by channel.
+        // Channel 1 - block 1 : Channel 1 - block 2 : Channel 2 - block 1 : Channel 2 - block2
+        select {
+        case rangeChannel <- req.block:
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+    }
+
+    return nil
+}
+
+// getBlockRequests returns the blocks for the requested channels and time range in the order in which
+// they should be sent to the client, and marks the blocks that are already cached as recently used.
+func (t *TimeseriesServiceImpl) getBlockRequests(
+    ctx context.Context,
+    DatasetNodeId string,
+    PackagenodeId string,
+    ChannelNodeIds []string,
+    StartTime uint64,
+    EndTime uint64,
+) ([]*blockRequest, error) {
 
     // Check which blocks are available on server
     log.Debug(fmt.Sprintf("d: %s, p: %s", DatasetNodeId, PackagenodeId))
     log.Debug(fmt.Sprintf("Channel Node Ids: %v", ChannelNodeIds))
 
+    if t.client == nil {
+        return nil, errRemoteUnavailable
+    }
+
     // Get ranges for all channels
     result, err := t.client.Timeseries.GetRangeBlocks(ctx, DatasetNodeId, PackagenodeId, StartTime, EndTime, "")
     if err != nil {
         log.Error(err)
-        return err
+        return nil, remoteError(err)
     }
 
+    var requests []*blockRequest
     for _, ch := range result.Channels {
 
         // Only process the channels that were requested --> discard others
@@ -156,11 +322,20 @@ func (t *TimeseriesServiceImpl) GetRangeBlocksForChannels(
         // Check which Blocks are already cached on the local machine
         cachedBlocks, err := t.tsStore.GetRangeBlocksForChannels(ctx, []string{ch.ChannelID}, StartTime, EndTime)
         if err != nil {
-            return err
+            return nil, err
         }
 
         log.Debug("Cached Blocks: ", cachedBlocks)
 
+        // Mark cached blocks as recently used so they are evicted last.
+        var usedBlocks []string
+        for _, cb := range cachedBlocks {
+            usedBlocks = append(usedBlocks, cb.BlockNodeId)
+        }
+        if err := t.tsStore.TouchBlocks(ctx, usedBlocks); err != nil {
+            log.Error(err)
+        }
+
         // Sort ranges by StartTime to ensure correct ordering
         sort.Slice(ch.Ranges, func(i, j int) bool {
             return ch.Ranges[i].StartTime < ch.Ranges[j].StartTime
@@ -168,58 +343,188 @@ func (t *TimeseriesServiceImpl) GetRangeBlocksForChannels(
 
         for _, r := range ch.Ranges {
 
-            isCached := false
-            for _, cb := range cachedBlocks {
-                if cb.BlockNodeId == r.ID {
-                    isCached = true
-                    break
-                }
+            // Blocks on Pennsieve contain float64 samples; the range response does not describe an encoding.
+            block := models.TsBlock{
+                BlockNodeId:   r.ID,
+                ChannelNodeId: ch.ChannelID,
+                Rate:          float64(r.SamplingRate),
+                Location:      filepath.Join(t.cacheLocation, r.ID),
+                StartTime:     r.StartTime,
+                EndTime:       r.EndTime,
+                Encoding:      models.TsFloat64,
+                Scale:         1,
             }
 
-            // If cached --> load data and send on stream
-            // Else download data --> store in cache --> send on stream
-            targetLocation := filepath.Join(t.cacheLocation, r.ID)
-            if isCached {
-                log.Info("Getting Cached Value")
-
-            } else {
-
-                log.Info("Downloading")
-                downloadImpl := shared.NewDownloader(t.subscriber, t.client)
-                //targetLocation = filepath.Join(t.cacheLocation, r.ID)
-                _, err := downloadImpl.DownloadFileFromPresignedUrl(ctx, r.PreSignedURL, targetLocation, "1")
-                if err != nil {
-                    log.Error("Error downloading file from presigned url: ", err)
-                    return err
-                }
-                // Store in db
-                err = t.tsStore.StoreBlockForChannel(ctx, r.ID, ch.ChannelID, targetLocation, uint64(r.StartTime), uint64(r.EndTime))
-                if err != nil {
-                    log.Error(err)
+            // Cached blocks that are incomplete or corrupted are downloaded again.
+            cached := false
+            if idx := slices.IndexFunc(cachedBlocks, func(cb models.TsBlock) bool { return cb.BlockNodeId == r.ID }); idx >= 0 {
+                cached = true
+                if err := verifyCachedBlock(cachedBlocks[idx]); err != nil {
+                    log.Warnf("Cached block %s is corrupted, downloading it again: %v", r.ID, err)
+                    cached = false
+                } else {
+                    block.Encoding = cachedBlocks[idx].Encoding
+                    block.Scale = cachedBlocks[idx].Scale
                 }
+            }
 
+            req := &blockRequest{
+                block:  block,
+                url:    r.PreSignedURL,
+                cached: cached,
+                done:   make(chan error, 1),
             }
 
-            cRange := models.TsBlock{
-                BlockNodeId:   r.ID,
-                ChannelNodeId: ch.ChannelID,
-                Rate:          float64(r.SamplingRate),
-                Location:      targetLocation,
-                StartTime:     r.StartTime,
-                EndTime:       r.EndTime,
+            if req.cached {
+                req.done <- nil
+            }
+
+            requests = append(requests, req)
+        }
+    }
+
+    return requests, nil
+}
+
+// downloadBlocks downloads the uncached blocks using a bounded pool of workers. Blocks are picked
+// up in the order of the requests so the blocks that are sent first are also downloaded first.
+// The result of each download is sent on the done channel of the request.
+func (t *TimeseriesServiceImpl) downloadBlocks(ctx context.Context, requests []*blockRequest) {
+
+    jobs := make(chan *blockRequest)
+    for i := 0; i < t.downloadWorkers; i++ {
+        go func() {
+            for req := range jobs {
+                req.done <- t.downloadBlock(ctx, req)
+            }
+        }()
+    }
+
+    go func() {
+        defer close(jobs)
+        for _, req := range requests {
+            if req.cached {
+                continue
+            }
+
+            select {
+            case jobs <- req:
+            case <-ctx.Done():
+                return
             }
+        }
+    }()
+}
 
-            // Sending block to channel.
-            // The channel should receive blocks in the correct order by channel.
-            // Channel 1 - block 1 : Channel 1 - block 2 : Channel 2 - block 1 : Channel 2 - block2
-            rangeChannel <- cRange
+// downloadBlock downloads a block into the local cache and records the block in the database.
+// If the block is already being downloaded, it waits for that download to complete instead.
+func (t *TimeseriesServiceImpl) downloadBlock(ctx context.Context, req *blockRequest) error {
+
+    download := &blockDownload{done: make(chan struct{})}
+    if existing, loaded := t.downloads.LoadOrStore(req.block.BlockNodeId, download); loaded {
+        inProgress := existing.(*blockDownload)
+        select {
+        case <-inProgress.done:
+            return inProgress.err
+        case <-ctx.Done():
+            return ctx.Err()
         }
+    }
+    defer func() {
+        t.downloads.Delete(req.block.BlockNodeId)
+        close(download.done)
+    }()
+
+    // Download into a temporary file that is only moved into the cache once it is complete and verified,
+    // so an interrupted download never leaves a truncated block in the cache.
+    log.Info("Downloading block: ", req.block.BlockNodeId)
+    tempLocation := req.block.Location + partialDownloadSuffix
+    downloadImpl := shared.NewDownloader(t.subscriber, t.client)
+    checksum, err := downloadImpl.DownloadFileFromPresignedUrl(ctx, req.url, tempLocation, "1")
+    if err == nil {
+        err = validateBlockFile(tempLocation, checksum, req.block.Encoding)
+    }
+    if err == nil {
+        err = os.Rename(tempLocation, req.block.Location)
+    }
+    if err != nil {
+        log.Error("Error downloading file from presigned url: ", err)
+        os.Remove(tempLocation)
+        download.err = err
+        return err
+    }
 
+    var size int64
+    if info, err := os.Stat(req.block.Location); err == nil {
+        size = info.Size()
     }
 
+    // Store in db
+    err = t.tsStore.StoreBlockForChannel(ctx, req.block.BlockNodeId, req.block.ChannelNodeId, req.block.Location,
+        uint64(req.block.StartTime), uint64(req.block.EndTime), size, checksum, req.block.Encoding, req.block.Scale)
+    if err != nil {
+        log.Error(err)
+    }
+    t.cache.RequestEviction()
+
     return nil
 }
 
+// startPrefetch reads ahead a time window in the background, unless maxPrefetches time windows are
+// already being read ahead. It returns false if the read-ahead is skipped.
+func (t *TimeseriesServiceImpl) startPrefetch(
+    DatasetNodeId string,
+    PackagenodeId string,
+    ChannelNodeIds []string,
+    StartTime uint64,
+    EndTime uint64,
+) bool {
+
+    select {
+    case t.prefetches <- struct{}{}:
+    default:
+        log.Debug(fmt.Sprintf("Skipping read ahead for %d - %d: too many read-ahead requests", StartTime, EndTime))
+        return false
+    }
+
+    go func() {
+        defer func() { <-t.prefetches }()
+        t.prefetch(DatasetNodeId, PackagenodeId, ChannelNodeIds, StartTime, EndTime)
+    }()
+
+    return true
+}
+
+// prefetch downloads the blocks for a time window into the local cache without sending them to a client.
+func (t *TimeseriesServiceImpl) prefetch(
+    DatasetNodeId string,
+    PackagenodeId string,
+    ChannelNodeIds []string,
+    StartTime uint64,
+    EndTime uint64,
+) {
+
+    ctx, cancel := context.WithTimeout(context.Background(), prefetchTimeout)
+    defer cancel()
+
+    requests, err := t.getBlockRequests(ctx, DatasetNodeId, PackagenodeId, ChannelNodeIds, StartTime, EndTime)
+    if err != nil {
+        log.Warn("Unable to read ahead: ", err)
+        return
+    }
+
+    t.downloadBlocks(ctx, requests)
+    for _, req := range requests {
+        select {
+        case <-req.done:
+        case <-ctx.Done():
+            return
+        }
+    }
+
+    log.Debug(fmt.Sprintf("Read ahead %d block(s) for %d - %d", len(requests), StartTime, EndTime))
+}
+
 func (t *TimeseriesServiceImpl) GetChannelsForPackage(
     ctx context.Context,
     datasetNodeId string,
@@ -240,13 +545,37 @@ func (t *TimeseriesServiceImpl) GetChannelsForPackage(
 
         log.Debug("GetChannelsForPackage refresh called")
 
+        if t.client == nil {
+            if len(cachedChannels) > 0 {
+                return cachedChannels, nil
+            }
+            return nil, errRemoteUnavailable
+        }
+
         // Use Pennsieve-go to fetch from server
         channels, err := t.client.Timeseries.GetChannels(ctx, datasetNodeId, packageNodeId)
         if err != nil {
             log.Errorf("Error getting channels for dataset node id %v: %v", datasetNodeId, err)
+
+            // Fall back to the cached channels when Pennsieve cannot be reached
+            if len(cachedChannels) > 0 && errors.Is(remoteError(err), errRemoteUnavailable) {
+                log.Warn("Using cached channels for package: ", packageNodeId)
+                return cachedChannels, nil
+            }
             return nil, err
         }
 
+        // Channel groups and settings are not part of the channel info; keep the cached metadata when
+        // it cannot be fetched.
+        metadata, err := t.fetchChannelMetadata(ctx, packageNodeId)
+        if err != nil {
+            log.Warnf("Error getting channel metadata for package %s: %v", packageNodeId, err)
+            metadata = make(map[string]models.TsChannel)
+            for _, ch := range cachedChannels {
+                metadata[ch.ChannelNodeId] = ch
+            }
+        }
+
         // Map into agent structs
         var result []models.TsChannel
         for _, channel := range channels {
@@ -258,6 +587,9 @@ func (t *TimeseriesServiceImpl) GetChannelsForPackage(
                 End:           int64(channel.EndTime),
                 Unit:          channel.Unit,
                 Rate:          channel.Rate,
+                Group:         metadata[channel.ChannelID].Group,
+                Type:          metadata[channel.ChannelID].Type,
+                Properties:    metadata[channel.ChannelID].Properties,
             })
         }
 
@@ -275,8 +607,8 @@ func (t *TimeseriesServiceImpl) GetChannelsForPackage(
 }
 
 // calculateCropIndices calculates the byte indices for cropping timeseries data blocks
-// based on the requested time range. Returns the start and end byte indices.
-func calculateCropIndices(blockStart, blockEnd, requestStart, requestEnd uint64, rate float64) (startIdx, endIdx int64) {
+// based on the requested time range and the number of bytes per sample. Returns the start and end byte indices.
+func calculateCropIndices(blockStart, blockEnd, requestStart, requestEnd uint64, rate float64, sampleWidth int) (startIdx, endIdx int64) {
 	// Case 1: No cropping needed - use entire block
 	if blockStart >= requestStart && blockEnd <= requestEnd {
 		return 0, -1 // -1 means use entire slice
@@ -290,7 +622,7 @@ func calculateCropIndices(blockStart, blockEnd, requestStart, requestEnd uint64,
 		timeDiffMicros := float64(requestStart - blockStart)
 		timeDiffSeconds := timeDiffMicros / 1000000.0
 		samplesToSkip := int64(timeDiffSeconds * rate)
-		startIdx = samplesToSkip * 8 // Each sample is 8 bytes (float64)
+		startIdx = samplesToSkip * int64(sampleWidth)
 	}
 
 	// Case 3: Crop from end
@@ -298,94 +630,60 @@ func calculateCropIndices(blockStart, blockEnd, requestStart, requestEnd uint64,
 		timeDiffMicros := float64(requestEnd - blockStart)
 		timeDiffSeconds := timeDiffMicros / 1000000.0
 		samplesToInclude := int64(timeDiffSeconds * rate)
-		endIdx = samplesToInclude * 8
+		endIdx = samplesToInclude * int64(sampleWidth)
 	}
 
 	return startIdx, endIdx
 }
 
 // StreamBlocksToClient streams blocks of data from local cache to the client application over gRPC
-// It assumes that the blocks are locally available.
+// It assumes that the blocks are locally available. Data is decimated per block if the decimation
+// sample period is larger than the sample period of the block. A GAP message is sent before a block
+// if there is a gap between the block and the previous block of the same channel.
 func (t *TimeseriesServiceImpl) StreamBlocksToClient(
     ctx context.Context,
     blocks <-chan models.TsBlock,
     startTime uint64,
     endTime uint64,
+    decimation Decimation,
+    encoding RangeEncoding,
     stream api.Agent_GetTimeseriesRangeForChannelsServer) error {
 
+    gaps := newGapDetector()
     for block := range blocks {
         log.Debug(fmt.Sprintf("StreamBlocksToClient called - %s", block.BlockNodeId))
 
-        fileContents, err := readGzFile(block.Location)
-        if err != nil {
-            fileContents, err = os.ReadFile(block.Location)
+        // Let the client know that there is no data between this block and the previous block of the channel
+        if gap, found := gaps.next(block); found {
+            err := stream.Send(&api.GetTimeseriesRangeResponse{
+                Type: api.GetTimeseriesRangeResponse_GAP,
+                MessageData: &api.GetTimeseriesRangeResponse_Gap{Gap: &api.GetTimeseriesRangeResponse_GapData{
+                    ChannelId: gap.ChannelNodeId,
+                    Start:     uint64(gap.StartTime),
+                    End:       uint64(gap.EndTime),
+                }},
+            })
             if err != nil {
-                log.Error("Failed to read block file: ", err)
+                log.Error(err)
                 return err
             }
         }
 
-        // Calculate crop indices using the extracted function
-        intBlockStart := uint64(block.StartTime)
-        intBlockEnd := uint64(block.EndTime)
-        
-        startIdx, endIdx := calculateCropIndices(intBlockStart, intBlockEnd, startTime, endTime, block.Rate)
-        
-        // Apply cropping based on calculated indices
-        var croppedSlice []byte
-        croppedStart := intBlockStart
-        croppedEnd := intBlockEnd
-        
-        if startIdx == 0 && endIdx == -1 {
-            // No cropping needed
-            log.Debug("No cropping needed - using entire block")
-            croppedSlice = fileContents
-        } else {
-            // Validate indices are within bounds
-            fileLen := int64(len(fileContents))
-            if startIdx > fileLen {
-                log.Error(fmt.Sprintf("Start index %d exceeds file length %d", startIdx, fileLen))
-                return fmt.Errorf("invalid crop indices: start index exceeds file length")
-            }
-            if endIdx > fileLen {
-                log.Warn(fmt.Sprintf("End index %d exceeds file length %d, clamping to file length", endIdx, fileLen))
-                endIdx = fileLen
-            }
-            
-            // Apply cropping
-            if startIdx > 0 {
-                croppedStart = startTime
-            }
-            if endIdx > 0 {
-                croppedEnd = endTime
-                if startIdx >= endIdx {
-                    log.Error(fmt.Sprintf("Invalid crop range: start %d >= end %d", startIdx, endIdx))
-                    return fmt.Errorf("invalid crop indices: start >= end")
-                }
-                croppedSlice = fileContents[startIdx:endIdx]
-                log.Debug(fmt.Sprintf("Cropping from %d to %d (bytes: %d to %d)", croppedStart, croppedEnd, startIdx, endIdx))
-            } else {
-                // Only crop from beginning
-                croppedSlice = fileContents[startIdx:]
-                log.Debug(fmt.Sprintf("Cropping from %d (byte offset: %d)", croppedStart, startIdx))
-            }
+        data, croppedStart, croppedEnd, err := readBlockRange(block, startTime, endTime)
+        if err != nil {
+            return err
         }
 
-        data := BytesToFloat32s(croppedSlice)
-        if data == nil {
-            log.Error("Error converting cropped slice")
-        }
+        rangeData, points := decimateRangeData(data, croppedStart, block.Rate, decimation)
+        encodeRangeData(rangeData, points, encoding.ForChannel(block.ChannelNodeId))
+        rangeData.Start = croppedStart
+        rangeData.End = croppedEnd
+        rangeData.ChannelId = block.ChannelNodeId
 
         err = stream.Send(&api.GetTimeseriesRangeResponse{
-            Type: api.GetTimeseriesRangeResponse_RANGE_DATA,
-            MessageData: &api.GetTimeseriesRangeResponse_Data{Data: &api.GetTimeseriesRangeResponse_RangeData{
-                Start:     croppedStart,
-                End:       croppedEnd,
-                Rate:      float32(block.Rate),
-                ChannelId: block.ChannelNodeId,
-                Data:      data,
-            },
-            }})
+            Type:        api.GetTimeseriesRangeResponse_RANGE_DATA,
+            MessageData: &api.GetTimeseriesRangeResponse_Data{Data: rangeData},
+        })
         if err != nil {
             log.Error(err)
             return err
@@ -395,9 +693,79 @@ func (t *TimeseriesServiceImpl) StreamBlocksToClient(
     return nil
 }
 
+// readBlockRange reads the samples of a locally cached block, cropped to the requested range, and
+// returns the samples with the start and end time of the cropped data.
+func readBlockRange(block models.TsBlock, startTime uint64, endTime uint64) ([]float64, uint64, uint64, error) {
+    fileContents, err := readBlockFile(block.Location)
+    if err != nil {
+        log.Error("Failed to read block file: ", err)
+        return nil, 0, 0, err
+    }
+
+    sampleWidth := block.Encoding.SampleWidth()
+    if sampleWidth == 0 {
+        return nil, 0, 0, fmt.Errorf("block %s has unknown sample encoding %q", block.BlockNodeId, block.Encoding)
+    }
+
+    // Calculate crop indices using the extracted function
+    intBlockStart := uint64(block.StartTime)
+    intBlockEnd := uint64(block.EndTime)
+    
+    startIdx, endIdx := calculateCropIndices(intBlockStart, intBlockEnd, startTime, endTime, block.Rate, sampleWidth)
+    
+    // Apply cropping based on calculated indices
+    var croppedSlice []byte
+    croppedStart := intBlockStart
+    croppedEnd := intBlockEnd
+    
+    if startIdx == 0 && endIdx == -1 {
+        // No cropping needed
+        log.Debug("No cropping needed - using entire block")
+        croppedSlice = fileContents
+    } else {
+        // Validate indices are within bounds
+        fileLen := int64(len(fileContents))
+        if startIdx > fileLen {
+            log.Error(fmt.Sprintf("Start index %d exceeds file length %d", startIdx, fileLen))
+            return nil, 0, 0, fmt.Errorf("invalid crop indices: start index exceeds file length")
+        }
+        if endIdx > fileLen {
+            log.Warn(fmt.Sprintf("End index %d exceeds file length %d, clamping to file length", endIdx, fileLen))
+            endIdx = fileLen
+        }
+        
+        // Apply cropping
+        if startIdx > 0 {
+            croppedStart = startTime
+        }
+        if endIdx > 0 {
+            croppedEnd = endTime
+            if startIdx >= endIdx {
+                log.Error(fmt.Sprintf("Invalid crop range: start %d >= end %d", startIdx, endIdx))
+                return nil, 0, 0, fmt.Errorf("invalid crop indices: start >= end")
+            }
+            croppedSlice = fileContents[startIdx:endIdx]
+            log.Debug(fmt.Sprintf("Cropping from %d to %d (bytes: %d to %d)", croppedStart, croppedEnd, startIdx, endIdx))
+        } else {
+            // Only crop from beginning
+            croppedSlice = fileContents[startIdx:]
+            log.Debug(fmt.Sprintf("Cropping from %d (byte offset: %d)", croppedStart, startIdx))
+        }
+    }
+
+    data, err := decodeSamples(croppedSlice, block.Encoding)
+    if err != nil {
+        log.Error("Error converting cropped slice: ", err)
+        return nil, 0, 0, err
+    }
+
+    return data, croppedStart, croppedEnd, nil
+}
+
 func (t *TimeseriesServiceImpl) StreamChannelInfoToClient(
     ctx context.Context,
     ch models.TsChannel,
+    encoding api.GetTimeseriesRangeRequest_SampleEncoding,
     stream api.Agent_GetTimeseriesRangeForChannelsServer) error {
 
     err := stream.Send(&api.GetTimeseriesRangeResponse{
@@ -407,6 +775,7 @@ func (t *TimeseriesServiceImpl) StreamChannelInfoToClient(
             Name:      ch.Name,
             Unit:      ch.Unit,
             Rate:      float32(ch.Rate),
+            Encoding:  encoding,
         }}})
     if err != nil {
         return err
@@ -434,19 +803,3 @@ func readGzFile(filename string) ([]byte, error) {
     }
     return s, nil
 }
-
-// BytesToFloat32s converts a byte array to a float32 array.
-// It assumes the values in the byte array are 64-bit bigEndian floats.
-func BytesToFloat32s(data []byte) []float32 {
-    if len(data)%8 != 0 {
-        // A float64 is 8 bytes, so the input byte array must be a multiple of 8
-        return nil
-    }
-
-    float32s := make([]float32, len(data)/8)
-    for i := range len(float32s) {
-        bits := binary.BigEndian.Uint64(data[i*8 : (i+1)*8])
-        float32s[i] = float32(math.Float64frombits(bits))
-    }
-    return float32s
-}
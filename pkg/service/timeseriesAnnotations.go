@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// annotationPageSize is the number of annotation layers or annotations requested from Pennsieve at a time.
+const annotationPageSize = 500
+
+// pagedResponse is a page of results returned by the Pennsieve API.
+type pagedResponse[T any] struct {
+	Results    []T   `json:"results"`
+	TotalCount int64 `json:"totalCount"`
+}
+
+type annotationLayerDTO struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Color       string `json:"color"`
+}
+
+type annotationDTO struct {
+	Id          int64    `json:"id"`
+	LayerId     int64    `json:"layerId"`
+	ChannelIds  []string `json:"channelIds"`
+	Name        string   `json:"name"`
+	Label       string   `json:"label"`
+	Description string   `json:"description"`
+	Start       int64    `json:"start"`
+	End         int64    `json:"end"`
+}
+
+type channelPropertyDTO struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type channelDTO struct {
+	Content struct {
+		Id         string               `json:"id"`
+		Group      string               `json:"group"`
+		Type       string               `json:"type"`
+		Properties []channelPropertyDTO `json:"properties"`
+	} `json:"content"`
+	Properties []channelPropertyDTO `json:"properties"`
+}
+
+// GetAnnotationsForPackage returns the annotation layers of a package and the annotations in those layers
+// that overlap with the time range. Only the requested layers and annotations on the requested channels are
+// returned, unless no layers or channels are requested.
+//
+// Annotations are cached per package, and fetched from Pennsieve if they are not cached or refresh is set.
+// If Pennsieve cannot be reached, cached annotations are returned and offline is true.
+func (t *TimeseriesServiceImpl) GetAnnotationsForPackage(
+	ctx context.Context,
+	datasetNodeId string,
+	packageNodeId string,
+	layerIds []int64,
+	channelNodeIds []string,
+	startTime uint64,
+	endTime uint64,
+	refresh bool,
+) ([]models.TsAnnotationLayer, []models.TsAnnotation, bool, error) {
+
+	fetchedAt, err := t.tsStore.GetAnnotationsFetchedAt(ctx, packageNodeId)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	offline := false
+	if fetchedAt == 0 || refresh {
+		if err := t.fetchAnnotations(ctx, packageNodeId); err != nil {
+			if fetchedAt == 0 {
+				log.Errorf("Error getting annotations for package %s in dataset %s: %v", packageNodeId, datasetNodeId, err)
+				return nil, nil, false, err
+			}
+
+			log.Warnf("Using cached annotations for package %s: %v", packageNodeId, err)
+			offline = true
+		}
+	}
+
+	cachedLayers, err := t.tsStore.GetAnnotationLayersForPackage(ctx, packageNodeId)
+	if err != nil {
+		return nil, nil, offline, err
+	}
+
+	var layers []models.TsAnnotationLayer
+	for _, layer := range cachedLayers {
+		if len(layerIds) == 0 || slices.Contains(layerIds, layer.LayerId) {
+			layers = append(layers, layer)
+		}
+	}
+
+	cachedAnnotations, err := t.tsStore.GetAnnotationsForPackage(ctx, packageNodeId, layerIds, startTime, endTime)
+	if err != nil {
+		return nil, nil, offline, err
+	}
+
+	var annotations []models.TsAnnotation
+	for _, annotation := range cachedAnnotations {
+		if annotationOnChannels(annotation, channelNodeIds) {
+			annotations = append(annotations, annotation)
+		}
+	}
+
+	return layers, annotations, offline, nil
+}
+
+// annotationOnChannels returns true if the annotation applies to any of the channels. Annotations without
+// channels apply to all channels.
+func annotationOnChannels(annotation models.TsAnnotation, channelNodeIds []string) bool {
+	if len(channelNodeIds) == 0 || len(annotation.ChannelNodeIds) == 0 {
+		return true
+	}
+
+	for _, id := range annotation.ChannelNodeIds {
+		if slices.Contains(channelNodeIds, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fetchAnnotations fetches all annotation layers and annotations of a package from Pennsieve and replaces
+// the cached annotations of the package.
+func (t *TimeseriesServiceImpl) fetchAnnotations(ctx context.Context, packageNodeId string) error {
+
+	layerPages, err := fetchAllPages[annotationLayerDTO](ctx, t, fmt.Sprintf("/timeseries/%s/layers", packageNodeId), nil)
+	if err != nil {
+		return err
+	}
+
+	var layers []models.TsAnnotationLayer
+	var annotations []models.TsAnnotation
+	for _, layer := range layerPages {
+		layers = append(layers, models.TsAnnotationLayer{
+			LayerId:       layer.Id,
+			PackageNodeId: packageNodeId,
+			Name:          layer.Name,
+			Description:   layer.Description,
+			Color:         layer.Color,
+		})
+
+		query := url.Values{}
+		query.Set("start", "0")
+		query.Set("end", strconv.FormatInt(math.MaxInt64, 10))
+
+		results, err := fetchAllPages[annotationDTO](ctx, t,
+			fmt.Sprintf("/timeseries/%s/layers/%d/annotations", packageNodeId, layer.Id), query)
+		if err != nil {
+			return err
+		}
+
+		for _, annotation := range results {
+			annotations = append(annotations, models.TsAnnotation{
+				AnnotationId:   annotation.Id,
+				LayerId:        layer.Id,
+				PackageNodeId:  packageNodeId,
+				ChannelNodeIds: annotation.ChannelIds,
+				Name:           annotation.Name,
+				Label:          annotation.Label,
+				Description:    annotation.Description,
+				StartTime:      annotation.Start,
+				EndTime:        annotation.End,
+			})
+		}
+	}
+
+	if err := t.tsStore.StoreAnnotationsForPackage(ctx, packageNodeId, layers, annotations); err != nil {
+		return err
+	}
+
+	log.Infof("Cached %d annotation(s) in %d layer(s) for package %s", len(annotations), len(layers), packageNodeId)
+
+	return nil
+}
+
+// fetchChannelMetadata fetches the group, type and properties of the channels of a package from Pennsieve,
+// such as the reference and filter settings of the channels. The returned channels are mapped by channel
+// node id and only contain metadata.
+func (t *TimeseriesServiceImpl) fetchChannelMetadata(ctx context.Context, packageNodeId string) (map[string]models.TsChannel, error) {
+
+	var channels []channelDTO
+	if err := t.getJSON(ctx, fmt.Sprintf("/timeseries/%s/channels", packageNodeId), nil, &channels); err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]models.TsChannel)
+	for _, ch := range channels {
+		properties := make(map[string]string)
+		for _, p := range append(ch.Content.Properties, ch.Properties...) {
+			properties[p.Key] = p.Value
+		}
+
+		metadata[ch.Content.Id] = models.TsChannel{
+			ChannelNodeId: ch.Content.Id,
+			Group:         ch.Content.Group,
+			Type:          ch.Content.Type,
+			Properties:    properties,
+		}
+	}
+
+	return metadata, nil
+}
+
+// fetchAllPages requests all pages of a paged Pennsieve API endpoint.
+func fetchAllPages[T any](ctx context.Context, t *TimeseriesServiceImpl, path string, query url.Values) ([]T, error) {
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	var results []T
+	for offset := 0; ; offset += annotationPageSize {
+		query.Set("limit", strconv.Itoa(annotationPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+
+		var page pagedResponse[T]
+		if err := t.getJSON(ctx, path, query, &page); err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Results...)
+		if len(page.Results) < annotationPageSize {
+			return results, nil
+		}
+	}
+}
+
+// getJSON requests a path of the Pennsieve API and decodes the JSON response into v.
+func (t *TimeseriesServiceImpl) getJSON(ctx context.Context, path string, query url.Values, v interface{}) error {
+
+	if t.client == nil {
+		return errRemoteUnavailable
+	}
+
+	requestUrl := t.client.GetAPIParams().ApiHost + path
+	if len(query) > 0 {
+		requestUrl += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", requestUrl, nil)
+	if err != nil {
+		return fmt.Errorf("creating request for %s: %w", path, err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.client.APISession.Token))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errRemoteUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("parsing response of %s: %w", path, err)
+	}
+
+	return nil
+}
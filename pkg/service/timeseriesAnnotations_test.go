@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/pennsieve/pennsieve-go/pkg/pennsieve"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// annotationStore keeps the annotations of a single package in memory.
+type annotationStore struct {
+	store.TimeseriesStore
+	fetchedAt   map[string]int64
+	layers      []models.TsAnnotationLayer
+	annotations []models.TsAnnotation
+}
+
+func (s *annotationStore) StoreAnnotationsForPackage(ctx context.Context, packageId string,
+	layers []models.TsAnnotationLayer, annotations []models.TsAnnotation) error {
+	s.fetchedAt[packageId]++
+	s.layers = layers
+	s.annotations = annotations
+	return nil
+}
+
+func (s *annotationStore) GetAnnotationsFetchedAt(ctx context.Context, packageId string) (int64, error) {
+	return s.fetchedAt[packageId], nil
+}
+
+func (s *annotationStore) GetAnnotationLayersForPackage(ctx context.Context, packageId string) ([]models.TsAnnotationLayer, error) {
+	return s.layers, nil
+}
+
+func (s *annotationStore) GetAnnotationsForPackage(ctx context.Context, packageId string, layerIds []int64,
+	startTime uint64, endTime uint64) ([]models.TsAnnotation, error) {
+	var result []models.TsAnnotation
+	for _, a := range s.annotations {
+		if a.StartTime <= int64(endTime) && a.EndTime >= int64(startTime) {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func TestGetAnnotationsForPackage(t *testing.T) {
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/timeseries/N:package:1/layers":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"results": []map[string]interface{}{{"id": 1, "name": "Default", "color": "#0000ff"}},
+			})
+		case "/timeseries/N:package:1/layers/1/annotations":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"results": []map[string]interface{}{
+					{"id": 10, "layerId": 1, "label": "Seizure", "channelIds": []string{"ch1"}, "start": 1000, "end": 5000},
+					{"id": 11, "layerId": 1, "label": "Eyes open", "start": 8000, "end": 8000},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := pennsieve.NewClient(pennsieve.APIParams{ApiHost: server.URL})
+	client.APISession.Token = "token"
+
+	ts := &annotationStore{fetchedAt: make(map[string]int64)}
+	service := &TimeseriesServiceImpl{tsStore: ts, client: client}
+	ctx := context.Background()
+
+	layers, annotations, offline, err := service.GetAnnotationsForPackage(ctx, "N:dataset:1", "N:package:1",
+		nil, nil, 0, 10000, false)
+	require.NoError(t, err)
+	assert.False(t, offline)
+	require.Len(t, layers, 1)
+	assert.Equal(t, "#0000ff", layers[0].Color)
+	require.Len(t, annotations, 2)
+	assert.Equal(t, []string{"ch1"}, annotations[0].ChannelNodeIds)
+	assert.Equal(t, int64(1), annotations[0].LayerId)
+
+	// Cached annotations are served without contacting Pennsieve
+	requests = 0
+	_, annotations, _, err = service.GetAnnotationsForPackage(ctx, "N:dataset:1", "N:package:1",
+		nil, []string{"ch2"}, 0, 10000, false)
+	require.NoError(t, err)
+	assert.Zero(t, requests)
+	require.Len(t, annotations, 1)
+	assert.Equal(t, "Eyes open", annotations[0].Label)
+
+	layers, _, _, err = service.GetAnnotationsForPackage(ctx, "N:dataset:1", "N:package:1",
+		[]int64{2}, nil, 0, 10000, false)
+	require.NoError(t, err)
+	assert.Empty(t, layers)
+
+	// Refreshing falls back to the cache when Pennsieve cannot be reached
+	server.Close()
+	_, annotations, offline, err = service.GetAnnotationsForPackage(ctx, "N:dataset:1", "N:package:1",
+		nil, nil, 0, 2000, true)
+	require.NoError(t, err)
+	assert.True(t, offline)
+	assert.Len(t, annotations, 1)
+
+	// Nothing is cached for other packages
+	_, _, _, err = service.GetAnnotationsForPackage(ctx, "N:dataset:1", "N:package:2", nil, nil, 0, 2000, false)
+	assert.ErrorIs(t, err, errRemoteUnavailable)
+}
+
+func TestAnnotationOnChannels(t *testing.T) {
+	annotation := models.TsAnnotation{ChannelNodeIds: []string{"ch1", "ch2"}}
+
+	assert.True(t, annotationOnChannels(annotation, nil))
+	assert.True(t, annotationOnChannels(annotation, []string{"ch2", "ch3"}))
+	assert.False(t, annotationOnChannels(annotation, []string{"ch3"}))
+	assert.True(t, annotationOnChannels(models.TsAnnotation{}, []string{"ch3"}))
+}
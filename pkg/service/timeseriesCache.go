@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// cacheEvictionBatchSize is the number of blocks that are evicted per database round-trip.
+	cacheEvictionBatchSize = 100
+
+	// cacheLowWatermark is the fraction of the maximum cache size the evictor shrinks the cache
+	// to, so the evictor does not run after every download once the cache is full.
+	cacheLowWatermark = 0.9
+
+	// cacheMinAge protects blocks that were just downloaded, or read, from eviction
+	// while they are being streamed to the client.
+	cacheMinAge = time.Minute
+)
+
+// TimeseriesCache tracks the usage of the local timeseries cache and evicts the least recently
+// used blocks when the cache exceeds its maximum size.
+type TimeseriesCache struct {
+	tsStore      store.TimeseriesStore
+	maxCacheSize int64         // Maximum size of the cache in bytes; 0 is unlimited
+	evictTrigger chan struct{} // Signals the evictor that blocks were added to the cache
+}
+
+func NewTimeseriesCache(ts store.TimeseriesStore, maxCacheSize int64) *TimeseriesCache {
+	return &TimeseriesCache{
+		tsStore:      ts,
+		maxCacheSize: maxCacheSize,
+		evictTrigger: make(chan struct{}, 1),
+	}
+}
+
+// MaxSize returns the maximum size in bytes of the local timeseries cache, or 0 if the size is unlimited.
+func (t *TimeseriesCache) MaxSize() int64 {
+	return t.maxCacheSize
+}
+
+// Stats returns the usage of the local timeseries cache per package.
+func (t *TimeseriesCache) Stats(ctx context.Context) ([]models.TsCacheStats, error) {
+	t.backfillBlockSizes(ctx)
+	return t.tsStore.GetCacheStats(ctx)
+}
+
+// Run blocks until ctx is cancelled. It evicts least recently used blocks from the local cache
+// every interval, and whenever new blocks were downloaded, if the cache exceeds the maximum size.
+func (t *TimeseriesCache) Run(ctx context.Context, interval time.Duration) {
+	log.Infof("timeseries cache evictor: starting (interval=%s, max size=%d bytes)", interval, t.maxCacheSize)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := t.Evict(ctx); err != nil {
+			log.Errorf("timeseries cache evictor: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("timeseries cache evictor: stopping")
+			return
+		case <-ticker.C:
+		case <-t.evictTrigger:
+		}
+	}
+}
+
+// Evict removes least recently used blocks from disk and from the database until the cache is
+// smaller than the low watermark of the maximum cache size. It returns the number of bytes freed.
+func (t *TimeseriesCache) Evict(ctx context.Context) (int64, error) {
+	if t.maxCacheSize <= 0 {
+		return 0, nil
+	}
+
+	t.backfillBlockSizes(ctx)
+
+	size, err := t.tsStore.GetCacheSize(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if size <= t.maxCacheSize {
+		return 0, nil
+	}
+
+	target := int64(float64(t.maxCacheSize) * cacheLowWatermark)
+	minAccess := time.Now().Add(-cacheMinAge).Unix()
+
+	var freed int64
+	var evicted int
+	for size-freed > target && ctx.Err() == nil {
+		blocks, err := t.tsStore.GetLeastRecentlyUsedBlocks(ctx, cacheEvictionBatchSize)
+		if err != nil {
+			return freed, err
+		}
+
+		var removed []string
+		recent := false
+		for _, block := range blocks {
+			if block.LastAccess > minAccess {
+				recent = true
+				break
+			}
+
+			// Remove the block file before the row so a failure never leaves an untracked file in the cache.
+			if err := os.Remove(block.Location); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.Error("Failed to remove block: ", block.Location, err)
+				continue
+			}
+
+			removed = append(removed, block.BlockNodeId)
+			freed += block.Size
+			if size-freed <= target {
+				break
+			}
+		}
+
+		if len(removed) == 0 {
+			break
+		}
+
+		if err := t.tsStore.RemoveBlocks(ctx, removed); err != nil {
+			return freed, err
+		}
+		evicted += len(removed)
+
+		if recent {
+			break
+		}
+	}
+
+	if evicted > 0 {
+		log.Infof("timeseries cache evictor: evicted %d block(s), freed %d bytes", evicted, freed)
+	}
+
+	return freed, nil
+}
+
+// RequestEviction signals the evictor to check the cache size without blocking the caller.
+// It does nothing if the cache is not tracked.
+func (t *TimeseriesCache) RequestEviction() {
+	if t == nil {
+		return
+	}
+
+	select {
+	case t.evictTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// backfillBlockSizes records the size of cached blocks that were stored before block sizes were tracked.
+// Blocks whose file no longer exists are removed from the database, so they are not checked again and
+// are downloaded again when they are requested.
+func (t *TimeseriesCache) backfillBlockSizes(ctx context.Context) {
+	blocks, err := t.tsStore.GetUnsizedBlocks(ctx)
+	if err != nil {
+		log.Error("Failed to get blocks without size: ", err)
+		return
+	}
+
+	var missing []string
+	for _, block := range blocks {
+		info, err := os.Stat(block.Location)
+		if errors.Is(err, fs.ErrNotExist) {
+			missing = append(missing, block.BlockNodeId)
+			continue
+		} else if err != nil {
+			log.Error("Failed to get size of block: ", block.Location, err)
+			continue
+		}
+
+		if err := t.tsStore.SetBlockSize(ctx, block.BlockNodeId, info.Size()); err != nil {
+			log.Error("Failed to set block size: ", err)
+		}
+	}
+
+	if len(missing) > 0 {
+		if err := t.tsStore.RemoveBlocks(ctx, missing); err != nil {
+			log.Error("Failed to remove missing blocks: ", err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheStore is an in-memory implementation of the cache related methods of the timeseries store.
+type cacheStore struct {
+	store.TimeseriesStore
+	blocks []models.TsBlock
+}
+
+func (s *cacheStore) GetUnsizedBlocks(ctx context.Context) ([]models.TsBlock, error) {
+	var blocks []models.TsBlock
+	for _, b := range s.blocks {
+		if b.Size == 0 {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks, nil
+}
+
+func (s *cacheStore) SetBlockSize(ctx context.Context, blockNodeId string, size int64) error {
+	for i := range s.blocks {
+		if s.blocks[i].BlockNodeId == blockNodeId {
+			s.blocks[i].Size = size
+		}
+	}
+	return nil
+}
+
+func (s *cacheStore) GetCacheSize(ctx context.Context) (int64, error) {
+	var size int64
+	for _, b := range s.blocks {
+		size += b.Size
+	}
+	return size, nil
+}
+
+func (s *cacheStore) GetLeastRecentlyUsedBlocks(ctx context.Context, limit int) ([]models.TsBlock, error) {
+	blocks := slices.Clone(s.blocks)
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].LastAccess < blocks[j].LastAccess
+	})
+	if len(blocks) > limit {
+		blocks = blocks[:limit]
+	}
+	return blocks, nil
+}
+
+func (s *cacheStore) RemoveBlocks(ctx context.Context, blockNodeIds []string) error {
+	s.blocks = slices.DeleteFunc(s.blocks, func(b models.TsBlock) bool {
+		return slices.Contains(blockNodeIds, b.BlockNodeId)
+	})
+	return nil
+}
+
+func TestEvictCache(t *testing.T) {
+	cacheLocation := t.TempDir()
+	now := time.Now()
+
+	var blocks []models.TsBlock
+	for i, lastAccess := range []time.Time{
+		now.Add(-3 * time.Hour),
+		now.Add(-1 * time.Hour),
+		now.Add(-2 * time.Hour),
+		now,
+	} {
+		location := filepath.Join(cacheLocation, string(rune('a'+i)))
+		require.NoError(t, os.WriteFile(location, make([]byte, 100), 0644))
+		blocks = append(blocks, models.TsBlock{
+			BlockNodeId: string(rune('a' + i)),
+			Location:    location,
+			Size:        100,
+			LastAccess:  lastAccess.Unix(),
+		})
+	}
+
+	ts := &cacheStore{blocks: blocks}
+
+	// Cache is within limits
+	cache := NewTimeseriesCache(ts, 400)
+	freed, err := cache.Evict(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, freed)
+	assert.Len(t, ts.blocks, 4)
+
+	// Cache exceeds limit --> shrink to 90% of 250 bytes by evicting the two least recently used blocks
+	cache = NewTimeseriesCache(ts, 250)
+	freed, err = cache.Evict(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), freed)
+	require.Len(t, ts.blocks, 2)
+	assert.Equal(t, "b", ts.blocks[0].BlockNodeId)
+	assert.Equal(t, "d", ts.blocks[1].BlockNodeId)
+	assert.NoFileExists(t, filepath.Join(cacheLocation, "a"))
+	assert.NoFileExists(t, filepath.Join(cacheLocation, "c"))
+	assert.FileExists(t, filepath.Join(cacheLocation, "b"))
+
+	// Recently used blocks are never evicted
+	cache = NewTimeseriesCache(ts, 50)
+	freed, err = cache.Evict(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), freed)
+	require.Len(t, ts.blocks, 1)
+	assert.Equal(t, "d", ts.blocks[0].BlockNodeId)
+}
+
+func TestBackfillBlockSizes(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "a")
+	require.NoError(t, os.WriteFile(location, make([]byte, 100), 0644))
+
+	ts := &cacheStore{blocks: []models.TsBlock{
+		{BlockNodeId: "a", Location: location},
+		{BlockNodeId: "b", Location: filepath.Join(t.TempDir(), "b")},
+	}}
+
+	NewTimeseriesCache(ts, 0).backfillBlockSizes(context.Background())
+	require.Len(t, ts.blocks, 1, "Expect blocks without a file to be removed.")
+	assert.Equal(t, "a", ts.blocks[0].BlockNodeId)
+	assert.Equal(t, int64(100), ts.blocks[0].Size)
+
+	var untracked *TimeseriesCache
+	assert.NotPanics(t, untracked.RequestEviction)
+}
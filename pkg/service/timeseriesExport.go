@@ -0,0 +1,437 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// exportSegment is a contiguous run of samples of a channel. The samples are only read when the segment
+// is exported, so that a single segment per channel is held in memory at a time.
+type exportSegment struct {
+	start uint64                            // Start time (µs), used to order the segments of a channel
+	read  func() (uint64, []float32, error) // Returns the start time (µs) and the samples of the segment
+}
+
+// exportChannel holds the metadata and the segments of a single exported channel, ordered by start time.
+type exportChannel struct {
+	channel  models.TsChannel
+	segments []exportSegment
+}
+
+// exportMetadata describes the exported channels. It is stored next to the exported file for
+// formats that cannot hold channel metadata themselves.
+type exportMetadata struct {
+	PackageId string                  `json:"packageId"`
+	StartTime uint64                  `json:"startTime"`
+	EndTime   uint64                  `json:"endTime"`
+	Channels  []exportChannelMetadata `json:"channels"`
+}
+
+type exportChannelMetadata struct {
+	Id     string  `json:"id"`
+	Name   string  `json:"name"`
+	Column string  `json:"column"`
+	Unit   string  `json:"unit"`
+	Rate   float64 `json:"rate"`
+}
+
+// ExportRange writes the samples of the channels between startTime and endTime (µs) to the target
+// file in the requested format. Blocks are fetched through the local cache. It returns the files
+// that were written and the number of exported samples.
+func (t *TimeseriesServiceImpl) ExportRange(
+	ctx context.Context,
+	datasetNodeId string,
+	packageNodeId string,
+	channels []models.TsChannel,
+	startTime uint64,
+	endTime uint64,
+	format api.ExportTimeseriesRequest_Format,
+	target string) ([]string, int64, error) {
+
+	if endTime <= startTime {
+		return nil, 0, fmt.Errorf("end time must be after start time")
+	}
+	if len(channels) == 0 {
+		return nil, 0, fmt.Errorf("no channels to export")
+	}
+
+	exported := make([]exportChannel, len(channels))
+	channelIndex := make(map[string]int)
+	var channelIds []string
+	for i, ch := range channels {
+		exported[i] = exportChannel{channel: ch}
+		channelIndex[ch.ChannelNodeId] = i
+		channelIds = append(channelIds, ch.ChannelNodeId)
+	}
+
+	rangeChannel := make(chan models.TsBlock, 15)
+	getErr := make(chan error, 1)
+	go func() {
+		defer close(rangeChannel)
+		coverage, err := t.GetRangeBlocksForChannels(ctx, datasetNodeId, packageNodeId, channelIds, startTime, endTime, false, false, rangeChannel)
+		if coverage.Partial {
+			log.Warn("Exporting partial range from the local cache for package: ", packageNodeId)
+		}
+		getErr <- err
+	}()
+
+	for block := range rangeChannel {
+		i, ok := channelIndex[block.ChannelNodeId]
+		if !ok {
+			continue
+		}
+		exported[i].segments = append(exported[i].segments, blockSegment(block, startTime, endTime))
+	}
+
+	if err := <-getErr; err != nil {
+		return nil, 0, err
+	}
+
+	for i := range exported {
+		sort.Slice(exported[i].segments, func(a, b int) bool {
+			return exported[i].segments[a].start < exported[i].segments[b].start
+		})
+	}
+
+	files, samples, err := writeExport(format, target, packageNodeId, startTime, endTime, exported)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	log.Infof("Exported %d samples of %d channel(s) to %s", samples, len(exported), target)
+
+	return files, samples, nil
+}
+
+// writeExport writes the exported channels to the target file in the requested format. It returns the
+// files that were written and the number of exported samples.
+func writeExport(format api.ExportTimeseriesRequest_Format, target string, packageNodeId string,
+	startTime uint64, endTime uint64, channels []exportChannel) ([]string, int64, error) {
+
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return nil, 0, err
+	}
+
+	columns := columnNames(channels)
+	metadata := exportMetadata{
+		PackageId: packageNodeId,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	for i, ch := range channels {
+		metadata.Channels = append(metadata.Channels, exportChannelMetadata{
+			Id:     ch.channel.ChannelNodeId,
+			Name:   ch.channel.Name,
+			Column: columns[i],
+			Unit:   ch.channel.Unit,
+			Rate:   ch.channel.Rate,
+		})
+	}
+
+	var samples int64
+	sidecar := false
+	err := writeFile(target, func(w *bufio.Writer) error {
+		var err error
+		switch format {
+		case api.ExportTimeseriesRequest_EDF:
+			samples, err = writeEdf(w, packageNodeId, startTime, endTime, channels)
+		case api.ExportTimeseriesRequest_NPY:
+			samples, err = writeNpy(w, columns, channels)
+			sidecar = true
+		case api.ExportTimeseriesRequest_PARQUET:
+			samples, err = writeParquet(w, columns, channels, metadata)
+		default:
+			samples, err = writeCsv(w, columns, channels)
+			sidecar = true
+		}
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	files := []string{target}
+
+	// CSV and NumPy files cannot hold the channel units and rates, which are stored in a JSON sidecar.
+	if sidecar {
+		metadataFile := strings.TrimSuffix(target, filepath.Ext(target)) + ".json"
+		if err := writeMetadataFile(metadataFile, metadata); err != nil {
+			return nil, 0, err
+		}
+		files = append(files, metadataFile)
+	}
+
+	return files, samples, nil
+}
+
+// blockSegment returns the segment of a cached block between startTime and endTime (µs).
+func blockSegment(block models.TsBlock, startTime uint64, endTime uint64) exportSegment {
+	return exportSegment{
+		start: uint64(block.StartTime),
+		read: func() (uint64, []float32, error) {
+			data, croppedStart, _, err := readBlockRange(block, startTime, endTime)
+			if errors.Is(err, fs.ErrNotExist) {
+				return 0, nil, fmt.Errorf("block %s was evicted from the cache during the export: %w", block.BlockNodeId, err)
+			}
+			if err != nil {
+				return 0, nil, err
+			}
+			return croppedStart, toFloat32s(data), nil
+		},
+	}
+}
+
+// writeFile creates the file at location and writes its content with write.
+func writeFile(location string, write func(w *bufio.Writer) error) error {
+	f, err := os.Create(location)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := write(w); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func writeMetadataFile(location string, metadata exportMetadata) error {
+	content, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(location, content, 0644)
+}
+
+// columnNames returns a unique column name for each channel, based on the channel name.
+func columnNames(channels []exportChannel) []string {
+	used := make(map[string]bool)
+	columns := make([]string, len(channels))
+	for i, ch := range channels {
+		name := ch.channel.Name
+		if name == "" {
+			name = ch.channel.ChannelNodeId
+		}
+
+		column := name
+		for n := 2; used[column] || column == "timestamp"; n++ {
+			column = fmt.Sprintf("%s_%d", name, n)
+		}
+		used[column] = true
+		columns[i] = column
+	}
+
+	return columns
+}
+
+// sampleTime returns the time (µs) of the i-th sample of a segment with the provided sampling rate.
+func sampleTime(start uint64, i int, rate float64) uint64 {
+	return start + uint64(math.Round(float64(i)*1e6/rate))
+}
+
+// channelCursor iterates over the samples of an exported channel in time order. Only the samples of the
+// current segment are held in memory.
+type channelCursor struct {
+	ch      exportChannel
+	next    int // Index of the next segment to read
+	start   uint64
+	data    []float32
+	i       int // Index of the current sample in data
+	samples int64
+}
+
+// peek returns the time (µs) and the value of the current sample. It returns false if all samples were read.
+func (c *channelCursor) peek() (uint64, float32, bool, error) {
+	for c.i >= len(c.data) {
+		if c.next >= len(c.ch.segments) {
+			return 0, 0, false, nil
+		}
+
+		start, data, err := c.ch.segments[c.next].read()
+		if err != nil {
+			return 0, 0, false, err
+		}
+		c.next++
+		c.start, c.data, c.i = start, data, 0
+	}
+
+	return sampleTime(c.start, c.i, c.ch.channel.Rate), c.data[c.i], true, nil
+}
+
+// advance moves the cursor to the next sample.
+func (c *channelCursor) advance() {
+	c.i++
+	c.samples++
+}
+
+// exportRows calls fn for each row of the exported channels in time order. A row holds the samples of all
+// channels at a timestamp (µs). Channels without a sample at the timestamp hold NaN. The values are only
+// valid during the call. It returns the number of exported samples.
+func exportRows(channels []exportChannel, fn func(ts uint64, values []float32) error) (int64, error) {
+	cursors := make([]*channelCursor, len(channels))
+	for i, ch := range channels {
+		cursors[i] = &channelCursor{ch: ch}
+	}
+
+	values := make([]float32, len(channels))
+	for {
+		ts, found := uint64(math.MaxUint64), false
+		for _, c := range cursors {
+			t, _, ok, err := c.peek()
+			if err != nil {
+				return 0, err
+			}
+			if ok && t <= ts {
+				ts, found = t, true
+			}
+		}
+		if !found {
+			break
+		}
+
+		for i, c := range cursors {
+			values[i] = float32(math.NaN())
+			for {
+				t, v, ok, err := c.peek()
+				if err != nil {
+					return 0, err
+				}
+				if !ok || t != ts {
+					break
+				}
+				values[i] = v
+				c.advance()
+			}
+		}
+
+		if err := fn(ts, values); err != nil {
+			return 0, err
+		}
+	}
+
+	var samples int64
+	for _, c := range cursors {
+		samples += c.samples
+	}
+	return samples, nil
+}
+
+// writeCsv writes the channels as CSV with a timestamp column and a column per channel.
+// Missing samples are written as empty cells.
+func writeCsv(w *bufio.Writer, columns []string, channels []exportChannel) (int64, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"timestamp"}, columns...)); err != nil {
+		return 0, err
+	}
+
+	record := make([]string, len(columns)+1)
+	samples, err := exportRows(channels, func(ts uint64, values []float32) error {
+		record[0] = strconv.FormatUint(ts, 10)
+		for c, v := range values {
+			if math.IsNaN(float64(v)) {
+				record[c+1] = ""
+			} else {
+				record[c+1] = strconv.FormatFloat(float64(v), 'g', -1, 32)
+			}
+		}
+		return cw.Write(record)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	cw.Flush()
+	return samples, cw.Error()
+}
+
+// writeNpy writes the channels as a NumPy structured array with a uint64 timestamp field and a
+// float32 field per channel. Missing samples are written as NaN. The header holds the number of
+// rows, so the channels are read twice: once to count the rows and once to write them.
+func writeNpy(w *bufio.Writer, columns []string, channels []exportChannel) (int64, error) {
+	var rows int
+	if _, err := exportRows(channels, func(uint64, []float32) error {
+		rows++
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	var descr strings.Builder
+	descr.WriteString("[('timestamp', '<u8')")
+	for _, column := range columns {
+		descr.WriteString(fmt.Sprintf(", (%s, '<f4')", pythonString(column)))
+	}
+	descr.WriteString("]")
+
+	header := fmt.Sprintf("{'descr': %s, 'fortran_order': False, 'shape': (%d,), }", descr.String(), rows)
+
+	// The header is padded with spaces and terminated by a newline so the data is 64-byte aligned.
+	// Version 2 supports larger headers, version 3 supports UTF-8 encoded channel names.
+	version := byte(1)
+	prefixLen := 10
+	if len(header)+1+prefixLen > math.MaxUint16 {
+		version = 2
+		prefixLen = 12
+	}
+	for _, r := range header {
+		if r > unicode.MaxASCII {
+			version = 3
+			prefixLen = 12
+			break
+		}
+	}
+	padding := 64 - (prefixLen+len(header)+1)%64
+	if padding == 64 {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	w.WriteString("\x93NUMPY")
+	w.Write([]byte{version, 0})
+	if version == 1 {
+		binary.Write(w, binary.LittleEndian, uint16(len(header)))
+	} else {
+		binary.Write(w, binary.LittleEndian, uint32(len(header)))
+	}
+	w.WriteString(header)
+
+	record := make([]byte, 8+4*len(columns))
+	return exportRows(channels, func(ts uint64, values []float32) error {
+		binary.LittleEndian.PutUint64(record, ts)
+		for c, v := range values {
+			binary.LittleEndian.PutUint32(record[8+4*c:], math.Float32bits(v))
+		}
+		_, err := w.Write(record)
+		return err
+	})
+}
+
+// pythonString returns s as a single-quoted Python string literal.
+func pythonString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `\'`)
+	return "'" + s + "'"
+}
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportStart = uint64(1000000)
+
+// testSegment returns a segment with the provided samples that starts at start (µs).
+func testSegment(start uint64, data ...float32) exportSegment {
+	return exportSegment{
+		start: start,
+		read: func() (uint64, []float32, error) {
+			return start, data, nil
+		},
+	}
+}
+
+// testExportChannels returns a 4 Hz and a 2 Hz channel with 2 seconds of data.
+func testExportChannels() []exportChannel {
+	return []exportChannel{
+		{
+			channel: models.TsChannel{ChannelNodeId: "N:channel:1", Name: "Fp1", Unit: "uV", Rate: 4},
+			segments: []exportSegment{
+				testSegment(exportStart, 0, 1, 2, 3),
+				testSegment(exportStart+1000000, 4, 5, 6, 7),
+			},
+		},
+		{
+			channel: models.TsChannel{ChannelNodeId: "N:channel:2", Name: "Fp1", Unit: "mV", Rate: 2},
+			segments: []exportSegment{
+				testSegment(exportStart, -1, -2, -3, -4),
+			},
+		},
+	}
+}
+
+func TestExportRows(t *testing.T) {
+	channels := testExportChannels()
+	assert.Equal(t, []string{"Fp1", "Fp1_2"}, columnNames(channels))
+
+	var timestamps []uint64
+	var values [][]float32
+	samples, err := exportRows(channels, func(ts uint64, row []float32) error {
+		timestamps = append(timestamps, ts)
+		values = append(values, append([]float32(nil), row...))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), samples)
+
+	require.Len(t, timestamps, 8)
+	assert.Equal(t, exportStart, timestamps[0])
+	assert.Equal(t, exportStart+250000, timestamps[1])
+	assert.Equal(t, exportStart+1750000, timestamps[7])
+
+	for i, row := range values {
+		assert.Equal(t, float32(i), row[0])
+	}
+	assert.Equal(t, float32(-2), values[2][1])
+	assert.True(t, math.IsNaN(float64(values[1][1])))
+}
+
+func TestExportRowsReadError(t *testing.T) {
+	channels := testExportChannels()
+	channels[1].segments = append(channels[1].segments, exportSegment{
+		start: exportStart + 2000000,
+		read: func() (uint64, []float32, error) {
+			return 0, nil, os.ErrNotExist
+		},
+	})
+
+	_, err := exportRows(channels, func(uint64, []float32) error { return nil })
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWriteExportCsv(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "export.csv")
+	files, samples, err := writeExport(api.ExportTimeseriesRequest_CSV, target, "N:package:1", exportStart, exportStart+2000000, testExportChannels())
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), samples)
+
+	metadataFile := filepath.Join(filepath.Dir(target), "export.json")
+	assert.Equal(t, []string{target, metadataFile}, files)
+
+	content, err := os.ReadFile(target)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 9)
+	assert.Equal(t, "timestamp,Fp1,Fp1_2", lines[0])
+	assert.Equal(t, "1000000,0,-1", lines[1])
+	assert.Equal(t, "1250000,1,", lines[2])
+
+	var metadata exportMetadata
+	content, err = os.ReadFile(metadataFile)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(content, &metadata))
+	require.Len(t, metadata.Channels, 2)
+	assert.Equal(t, "Fp1_2", metadata.Channels[1].Column)
+	assert.Equal(t, "mV", metadata.Channels[1].Unit)
+	assert.Equal(t, 2.0, metadata.Channels[1].Rate)
+}
+
+func TestWriteNpy(t *testing.T) {
+	channels := testExportChannels()
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	samples, err := writeNpy(w, columnNames(channels), channels)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), samples)
+	require.NoError(t, w.Flush())
+
+	b := buf.Bytes()
+	assert.Equal(t, "\x93NUMPY", string(b[:6]))
+	assert.Equal(t, byte(1), b[6])
+
+	headerLen := int(binary.LittleEndian.Uint16(b[8:10]))
+	assert.Zero(t, (10+headerLen)%64)
+
+	header := string(b[10 : 10+headerLen])
+	assert.Contains(t, header, "'descr': [('timestamp', '<u8'), ('Fp1', '<f4'), ('Fp1_2', '<f4')]")
+	assert.Contains(t, header, "'shape': (8,)")
+	assert.True(t, strings.HasSuffix(header, "\n"))
+
+	data := b[10+headerLen:]
+	require.Len(t, data, 8*16)
+	assert.Equal(t, exportStart+250000, binary.LittleEndian.Uint64(data[16:]))
+	assert.Equal(t, float32(1), math.Float32frombits(binary.LittleEndian.Uint32(data[24:])))
+	assert.True(t, math.IsNaN(float64(math.Float32frombits(binary.LittleEndian.Uint32(data[28:])))))
+}
+
+func TestWriteEdf(t *testing.T) {
+	channels := testExportChannels()
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	samples, err := writeEdf(w, "N:package:1", exportStart, exportStart+2000000, channels)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), samples)
+	require.NoError(t, w.Flush())
+
+	b := buf.Bytes()
+	field := func(offset int, width int) string {
+		return strings.TrimSpace(string(b[offset : offset+width]))
+	}
+
+	// Fixed header
+	assert.Equal(t, "0", field(0, 8))
+	assert.Equal(t, "01.01.70", field(168, 8))
+	assert.Equal(t, "00.00.01", field(176, 8))
+	assert.Equal(t, "768", field(184, 8))
+	assert.Equal(t, "2", field(236, 8))
+	assert.Equal(t, "1", field(244, 8))
+	assert.Equal(t, "2", field(252, 4))
+
+	// Signal headers are stored per field
+	assert.Equal(t, "Fp1", field(256, 16))
+	assert.Equal(t, "uV", field(256+2*96, 8))
+	assert.Equal(t, "mV", field(256+2*96+8, 8))
+	assert.Equal(t, "0", field(256+2*104, 8))
+	assert.Equal(t, "7", field(256+2*112, 8))
+	assert.Equal(t, "4", field(256+2*216, 8))
+	assert.Equal(t, "2", field(256+2*216+8, 8))
+
+	// Two records of 4 + 2 samples of 2 bytes
+	require.Len(t, b, 768+2*(4+2)*2)
+
+	sample := func(i int) int16 {
+		return int16(binary.LittleEndian.Uint16(b[768+2*i:]))
+	}
+	assert.Equal(t, int16(math.MinInt16), sample(0))
+	assert.Equal(t, int16(math.MaxInt16), sample(6+3))
+	assert.Equal(t, int16(math.MaxInt16), sample(4))
+	assert.Equal(t, int16(math.MinInt16), sample(6+4+1))
+}
+
+func TestWriteEdfSubsecondStart(t *testing.T) {
+	channels := []exportChannel{{
+		channel:  models.TsChannel{ChannelNodeId: "N:channel:1", Name: "Fp1", Unit: "uV", Rate: 4},
+		segments: []exportSegment{testSegment(exportStart+500000, -1, 1, 2, 3)},
+	}}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	samples, err := writeEdf(w, "N:package:1", exportStart+500000, exportStart+1500000, channels)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	assert.Equal(t, int64(4), samples)
+
+	b := buf.Bytes()
+	assert.Equal(t, "00.00.01", strings.TrimSpace(string(b[176:184])))
+	assert.Equal(t, "2", strings.TrimSpace(string(b[236:244])))
+
+	// The recording starts at the whole second, so the first sample is the third sample of the first record.
+	require.Len(t, b, 512+2*4*2)
+	sample := func(i int) int16 {
+		return int16(binary.LittleEndian.Uint16(b[512+2*i:]))
+	}
+	assert.NotEqual(t, int16(math.MinInt16), sample(0))
+	assert.Equal(t, int16(math.MinInt16), sample(2))
+	assert.Equal(t, int16(math.MaxInt16), sample(4+1))
+}
+
+func TestEdfNumber(t *testing.T) {
+	assert.Equal(t, "-1.5", edfNumber(-1.5))
+	assert.Equal(t, "123.4568", edfNumber(123.456789))
+	assert.Equal(t, "-12345.7", edfNumber(-12345.6789))
+	assert.Equal(t, "12345678", edfNumber(12345678))
+	assert.LessOrEqual(t, len(edfNumber(-123456789)), 8)
+}
+
+func TestWriteParquet(t *testing.T) {
+	channels := testExportChannels()
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	samples, err := writeParquet(w, columnNames(channels), channels, exportMetadata{PackageId: "N:package:1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), samples)
+	require.NoError(t, w.Flush())
+
+	b := buf.Bytes()
+	assert.Equal(t, "PAR1", string(b[:4]))
+	assert.Equal(t, "PAR1", string(b[len(b)-4:]))
+
+	footerLen := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	footer := b[len(b)-8-footerLen : len(b)-8]
+
+	// FileMetaData starts with field 1 (version, i32) set to 1
+	assert.Equal(t, []byte{0x15, 0x02}, footer[:2])
+	assert.Contains(t, string(footer), "pennsieve.channels")
+	assert.Contains(t, string(footer), "Fp1_2")
+
+	// The first page holds the 8 timestamps; the page data follows its header.
+	var ts [8]byte
+	binary.LittleEndian.PutUint64(ts[:], exportStart)
+	assert.True(t, bytes.Contains(b[4:], ts[:]))
+}
+
+func TestWriteParquetRowGroups(t *testing.T) {
+	data := make([]float32, parquetRowGroupRows+10)
+	channels := []exportChannel{{
+		channel:  models.TsChannel{ChannelNodeId: "N:channel:1", Name: "Fp1", Rate: 1000},
+		segments: []exportSegment{testSegment(exportStart, data...)},
+	}}
+
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	samples, err := writeParquet(w, columnNames(channels), channels, exportMetadata{})
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	assert.Equal(t, int64(len(data)), samples)
+
+	// The column names occur once in the schema and once per row group.
+	b := buf.Bytes()
+	footerLen := int(binary.LittleEndian.Uint32(b[len(b)-8:]))
+	footer := string(b[len(b)-8-footerLen : len(b)-8])
+	assert.Equal(t, 3, strings.Count(footer, "timestamp"))
+}
+
+func TestThriftWriter(t *testing.T) {
+	var w thriftWriter
+	w.beginStruct()
+	w.i32(1, -1)
+	w.i64(20, 300)
+	w.structField(21)
+	w.binary(1, "ab")
+	w.endStruct()
+	w.listField(22, thriftI32, 2)
+	w.varint(zigzag(1))
+	w.varint(zigzag(2))
+	w.endStruct()
+
+	assert.Equal(t, []byte{
+		0x15, 0x01, // field 1, i32 -1
+		0x06, 0x28, 0xd8, 0x04, // field 20 (long form), i64 300
+		0x1c,                 // field 21 (delta 1), struct
+		0x18, 0x02, 'a', 'b', // field 1, binary "ab"
+		0x00,                   // end of nested struct
+		0x19, 0x25, 0x02, 0x04, // field 22 (delta 1), list of 2 i32
+		0x00,
+	}, w.buf)
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// gapThreshold is the number of sample periods between the end of a block and the start of the next
+// block of a channel above which the period between the blocks is reported as a gap. It allows for
+// block end times that are either the time of the last sample, or one sample period after it.
+const gapThreshold = 1.5
+
+// gapDetector detects gaps between consecutive blocks of each channel.
+type gapDetector struct {
+	lastEnd map[string]int64 // End time of the last block, by channel node id
+}
+
+func newGapDetector() *gapDetector {
+	return &gapDetector{lastEnd: make(map[string]int64)}
+}
+
+// next registers the block, which must not start before the previous block of the same channel, and
+// returns the gap between the previous block of the channel and the block if there is one.
+func (g *gapDetector) next(block models.TsBlock) (models.TsGap, bool) {
+	lastEnd, seen := g.lastEnd[block.ChannelNodeId]
+	g.lastEnd[block.ChannelNodeId] = max(lastEnd, block.EndTime)
+	if !seen {
+		return models.TsGap{}, false
+	}
+
+	var samplePeriod float64
+	if block.Rate > 0 {
+		samplePeriod = 1e6 / block.Rate
+	}
+
+	if block.StartTime <= lastEnd || float64(block.StartTime-lastEnd) <= gapThreshold*samplePeriod {
+		return models.TsGap{}, false
+	}
+
+	return models.TsGap{
+		ChannelNodeId: block.ChannelNodeId,
+		StartTime:     lastEnd,
+		EndTime:       block.StartTime,
+	}, true
+}
+
+// GetGapsForChannels returns the gaps between the blocks of the requested channels in the time range,
+// ordered by channel and start time. Only the block boundaries are retrieved; no blocks are downloaded.
+// The blocks are retrieved from Pennsieve, as cached blocks do not show the gaps of the uncached parts of
+// the range, so errRemoteUnavailable is returned when Pennsieve cannot be reached.
+func (t *TimeseriesServiceImpl) GetGapsForChannels(
+	ctx context.Context,
+	datasetNodeId string,
+	packageNodeId string,
+	channelNodeIds []string,
+	startTime uint64,
+	endTime uint64,
+) ([]models.TsGap, error) {
+
+	if t.client == nil {
+		return nil, errRemoteUnavailable
+	}
+
+	result, err := t.client.Timeseries.GetRangeBlocks(ctx, datasetNodeId, packageNodeId, startTime, endTime, "")
+	if err != nil {
+		log.Error(err)
+		return nil, remoteError(err)
+	}
+
+	detector := newGapDetector()
+
+	var gaps []models.TsGap
+	for _, ch := range result.Channels {
+		if !slices.Contains(channelNodeIds, ch.ChannelID) {
+			continue
+		}
+
+		sort.Slice(ch.Ranges, func(i, j int) bool {
+			return ch.Ranges[i].StartTime < ch.Ranges[j].StartTime
+		})
+
+		for _, r := range ch.Ranges {
+			gap, found := detector.next(models.TsBlock{
+				BlockNodeId:   r.ID,
+				ChannelNodeId: ch.ChannelID,
+				Rate:          float64(r.SamplingRate),
+				StartTime:     r.StartTime,
+				EndTime:       r.EndTime,
+			})
+			if found {
+				gaps = append(gaps, gap)
+			}
+		}
+	}
+
+	return gaps, nil
+}
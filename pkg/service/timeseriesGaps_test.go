@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGapDetector(t *testing.T) {
+	detector := newGapDetector()
+	block := func(channel string, start int64, end int64) models.TsBlock {
+		return models.TsBlock{ChannelNodeId: channel, Rate: 1000, StartTime: start, EndTime: end}
+	}
+
+	// First block of a channel never starts with a gap
+	_, found := detector.next(block("ch1", 0, 999000))
+	assert.False(t, found)
+
+	// Block that starts one sample period after the previous block
+	_, found = detector.next(block("ch1", 1000000, 1999000))
+	assert.False(t, found)
+
+	// Other channels are tracked separately
+	_, found = detector.next(block("ch2", 5000000, 5999000))
+	assert.False(t, found)
+
+	// Block that starts two seconds after the previous block
+	gap, found := detector.next(block("ch1", 4000000, 4999000))
+	assert.True(t, found)
+	assert.Equal(t, models.TsGap{ChannelNodeId: "ch1", StartTime: 1999000, EndTime: 4000000}, gap)
+
+	// Overlapping blocks are not gaps
+	_, found = detector.next(block("ch1", 4500000, 5500000))
+	assert.False(t, found)
+
+	// A gap of less than the threshold is not reported
+	_, found = detector.next(block("ch2", 6000400, 6999000))
+	assert.False(t, found)
+	_, found = detector.next(block("ch2", 7001000, 7999000))
+	assert.True(t, found)
+}
+
+func TestGetGapsForChannelsOffline(t *testing.T) {
+	service := &TimeseriesServiceImpl{}
+
+	_, err := service.GetGapsForChannels(context.Background(), "N:dataset:1", "N:package:1", []string{"N:channel:1"}, 0, 1000)
+	assert.ErrorIs(t, err, errRemoteUnavailable)
+}
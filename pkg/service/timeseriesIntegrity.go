@@ -0,0 +1,113 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// partialDownloadSuffix is appended to the location of a block while it is being downloaded. The block is
+// renamed to its final location once the download is complete and verified.
+const partialDownloadSuffix = ".part"
+
+// gzipMagic are the first bytes of a gzip compressed file.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// readBlockFile returns the uncompressed content of a block file. Blocks are stored either gzip compressed
+// or uncompressed; a compressed block that cannot be fully decompressed is reported as an error.
+func readBlockFile(location string) ([]byte, error) {
+	content, err := os.ReadFile(location)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(content, gzipMagic) {
+		return readGzFile(location)
+	}
+
+	return content, nil
+}
+
+// validateBlockFile checks that a downloaded block file matches the checksum of the downloaded content
+// and contains a whole number of samples of the encoding.
+func validateBlockFile(location string, checksum uint32, encoding models.TsSampleEncoding) error {
+	fileChecksum, err := blockChecksum(location)
+	if err != nil {
+		return err
+	}
+	if fileChecksum != checksum {
+		return fmt.Errorf("checksum mismatch for %s", location)
+	}
+
+	content, err := readBlockFile(location)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", location, err)
+	}
+	if width := encoding.SampleWidth(); width == 0 || len(content)%width != 0 {
+		return fmt.Errorf("block %s contains a partial sample", location)
+	}
+
+	return nil
+}
+
+// verifyCachedBlock checks that a cached block file still has the size and checksum it had when the
+// block was downloaded. Blocks that were cached before checksums were recorded are checked by size only.
+func verifyCachedBlock(block models.TsBlock) error {
+	info, err := os.Stat(block.Location)
+	if err != nil {
+		return err
+	}
+
+	if block.Size > 0 && info.Size() != block.Size {
+		return fmt.Errorf("block %s has size %d, expected %d", block.BlockNodeId, info.Size(), block.Size)
+	}
+
+	if block.Checksum != 0 {
+		checksum, err := blockChecksum(block.Location)
+		if err != nil {
+			return err
+		}
+		if checksum != block.Checksum {
+			return fmt.Errorf("checksum mismatch for block %s", block.BlockNodeId)
+		}
+	}
+
+	return nil
+}
+
+// blockChecksum returns the CRC32 checksum of the content of a block file.
+func blockChecksum(location string) (uint32, error) {
+	f, err := os.Open(location)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	h := crc32.NewIEEE()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0, err
+	}
+
+	return h.Sum32(), nil
+}
+
+// removePartialDownloads removes blocks that were left behind by downloads that were interrupted
+// when the agent stopped.
+func removePartialDownloads(cacheLocation string) {
+	partial, err := filepath.Glob(filepath.Join(cacheLocation, "*"+partialDownloadSuffix))
+	if err != nil {
+		return
+	}
+
+	for _, location := range partial {
+		if err := os.Remove(location); err != nil {
+			log.Warn("Failed to remove partial download: ", location, err)
+		}
+	}
+}
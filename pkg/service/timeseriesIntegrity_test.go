@@ -0,0 +1,122 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipBlock(t *testing.T, samples int) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(make([]byte, 8*samples))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestValidateBlockFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content []byte) (string, uint32) {
+		location := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(location, content, 0644))
+		return location, crc32.ChecksumIEEE(content)
+	}
+
+	block := gzipBlock(t, 100)
+
+	location, checksum := write("complete", block)
+	assert.NoError(t, validateBlockFile(location, checksum, models.TsFloat64))
+
+	// Checksum of the downloaded content does not match the file
+	assert.Error(t, validateBlockFile(location, checksum+1, models.TsFloat64))
+
+	// Truncated compressed block
+	location, checksum = write("truncated", block[:len(block)-10])
+	assert.Error(t, validateBlockFile(location, checksum, models.TsFloat64))
+
+	// Uncompressed blocks must contain whole samples
+	location, checksum = write("raw", make([]byte, 16))
+	assert.NoError(t, validateBlockFile(location, checksum, models.TsFloat64))
+	location, checksum = write("partial", make([]byte, 12))
+	assert.Error(t, validateBlockFile(location, checksum, models.TsFloat64))
+
+	// Blocks with an unknown encoding cannot be read
+	location, checksum = write("int16", make([]byte, 16))
+	assert.Error(t, validateBlockFile(location, checksum, "int16"))
+}
+
+func TestVerifyCachedBlock(t *testing.T) {
+	content := gzipBlock(t, 10)
+	location := filepath.Join(t.TempDir(), "block")
+	require.NoError(t, os.WriteFile(location, content, 0644))
+
+	block := models.TsBlock{
+		BlockNodeId: "block",
+		Location:    location,
+		Size:        int64(len(content)),
+		Checksum:    crc32.ChecksumIEEE(content),
+	}
+	assert.NoError(t, verifyCachedBlock(block))
+
+	// Blocks without a recorded checksum or size are accepted
+	assert.NoError(t, verifyCachedBlock(models.TsBlock{BlockNodeId: "block", Location: location}))
+
+	// Block file was modified after it was cached
+	content[len(content)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(location, content, 0644))
+	assert.Error(t, verifyCachedBlock(block))
+
+	// Block file was truncated
+	require.NoError(t, os.WriteFile(location, content[:10], 0644))
+	assert.Error(t, verifyCachedBlock(block))
+
+	// Block file was removed
+	require.NoError(t, os.Remove(location))
+	assert.Error(t, verifyCachedBlock(block))
+}
+
+func TestDownloadBlockIncomplete(t *testing.T) {
+
+	// Server announces more content than it sends, as when the connection drops during a download.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1024")
+		w.Write(make([]byte, 8))
+	}))
+	defer server.Close()
+
+	ts := &blockStore{}
+	service := &TimeseriesServiceImpl{
+		tsStore:       ts,
+		subscriber:    testSubscriber{},
+		cache:         NewTimeseriesCache(ts, 0),
+		cacheLocation: t.TempDir(),
+	}
+
+	req := &blockRequest{
+		block: models.TsBlock{
+			BlockNodeId: "block",
+			Location:    filepath.Join(service.cacheLocation, "block"),
+		},
+		url: server.URL,
+	}
+
+	err := service.downloadBlock(context.Background(), req)
+	assert.Error(t, err)
+
+	// Neither the block nor the partial download is left in the cache, and the block is not recorded.
+	entries, err := os.ReadDir(service.cacheLocation)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.Empty(t, ts.stored)
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// errRemoteUnavailable is returned when block information cannot be retrieved from Pennsieve,
+// for example because there is no network connection or no valid session.
+var errRemoteUnavailable = errors.New("unable to reach Pennsieve")
+
+// authStatusPattern matches the errors of requests that Pennsieve rejected because the session is not valid.
+var authStatusPattern = regexp.MustCompile(`status code: 40[13]\b`)
+
+// remoteError wraps err in errRemoteUnavailable if Pennsieve could not be reached, or if there is no valid
+// session because the session was rejected or could not be refreshed, so the request can be served from the
+// local cache. Other errors, such as requests for packages that do not exist, are returned as is.
+func remoteError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	// Errors of the identity provider, such as an expired refresh token, implement ErrorCode.
+	var netErr net.Error
+	var providerErr interface{ ErrorCode() string }
+	switch {
+	case errors.As(err, &netErr):
+	case authStatusPattern.MatchString(err.Error()):
+	case errors.As(err, &providerErr) && providerErr.ErrorCode() == "NotAuthorizedException":
+	default:
+		return err
+	}
+
+	return fmt.Errorf("%w: %v", errRemoteUnavailable, err)
+}
+
+// getCachedBlockRequests returns the locally cached blocks for the requested channels and time range, in
+// order by channel and start time, without contacting Pennsieve. It also reports whether the cached blocks
+// leave parts of the range uncovered. As recording gaps cannot be told apart from blocks that were never
+// cached, a range with recording gaps is reported as partially covered.
+func (t *TimeseriesServiceImpl) getCachedBlockRequests(
+	ctx context.Context,
+	packageNodeId string,
+	channelNodeIds []string,
+	startTime uint64,
+	endTime uint64,
+) ([]*blockRequest, bool, error) {
+
+	channels, err := t.tsStore.GetChannelsForPackage(ctx, packageNodeId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	channelsById := make(map[string]models.TsChannel)
+	for _, ch := range channels {
+		channelsById[ch.ChannelNodeId] = ch
+	}
+
+	partial := false
+	var requests []*blockRequest
+	for _, channelNodeId := range channelNodeIds {
+		ch, ok := channelsById[channelNodeId]
+		if !ok {
+			partial = true
+			continue
+		}
+
+		cachedBlocks, err := t.tsStore.GetRangeBlocksForChannels(ctx, []string{channelNodeId}, startTime, endTime)
+		if err != nil {
+			return nil, false, err
+		}
+
+		sort.Slice(cachedBlocks, func(i, j int) bool {
+			return cachedBlocks[i].StartTime < cachedBlocks[j].StartTime
+		})
+
+		// The sampling rate of cached blocks is the rate of the channel.
+		var blocks []models.TsBlock
+		var usedBlocks []string
+		for _, block := range cachedBlocks {
+			block.Rate = ch.Rate
+			if err := verifyCachedBlock(block); err != nil {
+				log.Warnf("Skipping corrupted cached block %s: %v", block.BlockNodeId, err)
+				continue
+			}
+
+			blocks = append(blocks, block)
+			usedBlocks = append(usedBlocks, block.BlockNodeId)
+		}
+
+		if err := t.tsStore.TouchBlocks(ctx, usedBlocks); err != nil {
+			log.Error(err)
+		}
+
+		rangeStart := max(int64(startTime), ch.Start)
+		rangeEnd := min(int64(endTime), ch.End)
+		if !coversRange(blocks, rangeStart, rangeEnd, ch.Rate) {
+			partial = true
+		}
+
+		for _, block := range blocks {
+			req := &blockRequest{
+				block:  block,
+				cached: true,
+				done:   make(chan error, 1),
+			}
+			req.done <- nil
+			requests = append(requests, req)
+		}
+	}
+
+	return requests, partial, nil
+}
+
+// coversRange returns true if the blocks, ordered by start time, cover the range from startTime to endTime
+// without gaps larger than the gap threshold.
+func coversRange(blocks []models.TsBlock, startTime int64, endTime int64, rate float64) bool {
+	if startTime >= endTime {
+		return true
+	}
+
+	var tolerance float64
+	if rate > 0 {
+		tolerance = gapThreshold * 1e6 / rate
+	}
+
+	covered := startTime
+	for _, block := range blocks {
+		if float64(block.StartTime-covered) > tolerance {
+			return false
+		}
+		covered = max(covered, block.EndTime)
+	}
+
+	return float64(endTime-covered) <= tolerance
+}
+
+// PinPackage downloads all blocks of all channels of the package into the local cache and protects them
+// from eviction, so the package can be used offline. It returns the number of blocks of the package and
+// the number of blocks that were downloaded.
+func (t *TimeseriesServiceImpl) PinPackage(ctx context.Context, datasetNodeId string, packageNodeId string) (int64, int64, error) {
+
+	channels, err := t.GetChannelsForPackage(ctx, datasetNodeId, packageNodeId, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(channels) == 0 {
+		return 0, 0, fmt.Errorf("package %s has no channels", packageNodeId)
+	}
+
+	var channelNodeIds []string
+	startTime, endTime := channels[0].Start, channels[0].End
+	for _, ch := range channels {
+		channelNodeIds = append(channelNodeIds, ch.ChannelNodeId)
+		startTime = min(startTime, ch.Start)
+		endTime = max(endTime, ch.End)
+	}
+
+	pinned, err := t.tsStore.IsPackagePinned(ctx, packageNodeId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Pin before downloading so the evictor does not remove blocks of the package while it is downloaded.
+	// The pin is removed again if the download fails, unless the package was pinned before.
+	if err := t.tsStore.PinPackage(ctx, packageNodeId); err != nil {
+		return 0, 0, err
+	}
+
+	blocks, downloaded, err := t.downloadPackage(ctx, datasetNodeId, packageNodeId, channelNodeIds, uint64(startTime), uint64(endTime)+1)
+	if err != nil {
+		if !pinned {
+			if err := t.tsStore.UnpinPackage(context.WithoutCancel(ctx), packageNodeId); err != nil {
+				log.Error("Failed to unpin package after failed download: ", err)
+			}
+		}
+		return blocks, downloaded, err
+	}
+
+	log.Infof("Pinned package %s: downloaded %d of %d block(s)", packageNodeId, downloaded, blocks)
+
+	return blocks, downloaded, nil
+}
+
+// downloadPackage downloads the uncached blocks of the channels between startTime and endTime (µs) into the
+// local cache. It returns the number of blocks in the range and the number of blocks that were downloaded.
+func (t *TimeseriesServiceImpl) downloadPackage(ctx context.Context, datasetNodeId string, packageNodeId string,
+	channelNodeIds []string, startTime uint64, endTime uint64) (int64, int64, error) {
+
+	requests, err := t.getBlockRequests(ctx, datasetNodeId, packageNodeId, channelNodeIds, startTime, endTime)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	t.downloadBlocks(ctx, requests)
+
+	var downloaded int64
+	for _, req := range requests {
+		select {
+		case err := <-req.done:
+			if err != nil {
+				return int64(len(requests)), downloaded, err
+			}
+		case <-ctx.Done():
+			return int64(len(requests)), downloaded, ctx.Err()
+		}
+
+		if !req.cached {
+			downloaded++
+		}
+	}
+
+	return int64(len(requests)), downloaded, nil
+}
+
+// UnpinPackage allows the cached blocks of the package to be evicted again.
+func (t *TimeseriesServiceImpl) UnpinPackage(ctx context.Context, packageNodeId string) error {
+
+	if err := t.tsStore.UnpinPackage(ctx, packageNodeId); err != nil {
+		return err
+	}
+	t.cache.RequestEviction()
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// offlineStore serves channels and cached blocks from memory.
+type offlineStore struct {
+	store.TimeseriesStore
+	channels []models.TsChannel
+	blocks   []models.TsBlock
+}
+
+func (s *offlineStore) GetChannelsForPackage(ctx context.Context, packageNodeId string) ([]models.TsChannel, error) {
+	return s.channels, nil
+}
+
+func (s *offlineStore) GetRangeBlocksForChannels(ctx context.Context, channelNodeIds []string, startTime uint64, endTime uint64) ([]models.TsBlock, error) {
+	var result []models.TsBlock
+	for _, b := range s.blocks {
+		if b.ChannelNodeId == channelNodeIds[0] && b.EndTime > int64(startTime) && b.StartTime < int64(endTime) {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+func (s *offlineStore) TouchBlocks(ctx context.Context, blockNodeIds []string) error {
+	return nil
+}
+
+func TestCoversRange(t *testing.T) {
+	blocks := []models.TsBlock{
+		{StartTime: 0, EndTime: 999000},
+		{StartTime: 1000000, EndTime: 1999000},
+	}
+
+	assert.True(t, coversRange(blocks, 0, 1999000, 1000))
+	assert.True(t, coversRange(blocks, 500000, 1500000, 1000))
+	assert.False(t, coversRange(blocks, 0, 3000000, 1000))
+	assert.False(t, coversRange(blocks[1:], 0, 1999000, 1000))
+	assert.False(t, coversRange(nil, 0, 1000000, 1000))
+	assert.True(t, coversRange(nil, 1000000, 1000000, 1000))
+}
+
+func TestGetRangeBlocksOffline(t *testing.T) {
+	dir := t.TempDir()
+	cachedBlock := func(id string, channel string, start int64, end int64) models.TsBlock {
+		location := filepath.Join(dir, id)
+		require.NoError(t, os.WriteFile(location, make([]byte, 8), 0644))
+		return models.TsBlock{BlockNodeId: id, ChannelNodeId: channel, Location: location, StartTime: start, EndTime: end}
+	}
+
+	ts := &offlineStore{
+		channels: []models.TsChannel{
+			{ChannelNodeId: "ch1", Start: 0, End: 2999000, Rate: 1000},
+			{ChannelNodeId: "ch2", Start: 0, End: 2999000, Rate: 500},
+		},
+		blocks: []models.TsBlock{
+			cachedBlock("b2", "ch1", 1000000, 1999000),
+			cachedBlock("b1", "ch1", 0, 999000),
+			cachedBlock("b3", "ch2", 0, 2999000),
+		},
+	}
+
+	// Without a client, Pennsieve cannot be reached and the local cache is used.
+	service := &TimeseriesServiceImpl{tsStore: ts, cache: NewTimeseriesCache(ts, 0)}
+
+	receive := func(channels []string, start uint64, end uint64) ([]models.TsBlock, models.TsCoverage) {
+		rangeChannel := make(chan models.TsBlock, 10)
+		coverage, err := service.GetRangeBlocksForChannels(context.Background(), "N:dataset:1", "N:package:1",
+			channels, start, end, false, false, rangeChannel)
+		require.NoError(t, err)
+		close(rangeChannel)
+
+		var blocks []models.TsBlock
+		for b := range rangeChannel {
+			blocks = append(blocks, b)
+		}
+		return blocks, coverage
+	}
+
+	blocks, coverage := receive([]string{"ch1", "ch2"}, 0, 2000000)
+	assert.Equal(t, models.TsCoverage{Offline: true, Partial: false}, coverage)
+	require.Len(t, blocks, 3)
+	assert.Equal(t, "b1", blocks[0].BlockNodeId)
+	assert.Equal(t, "b2", blocks[1].BlockNodeId)
+	assert.Equal(t, 1000.0, blocks[0].Rate)
+	assert.Equal(t, 500.0, blocks[2].Rate)
+
+	// The last second of the first channel is not cached
+	blocks, coverage = receive([]string{"ch1"}, 0, 3000000)
+	assert.Equal(t, models.TsCoverage{Offline: true, Partial: true}, coverage)
+	assert.Len(t, blocks, 2)
+
+	// Corrupted blocks are skipped
+	require.NoError(t, os.WriteFile(ts.blocks[0].Location, make([]byte, 4), 0644))
+	ts.blocks[0].Size = 8
+	blocks, coverage = receive([]string{"ch1"}, 0, 2000000)
+	assert.True(t, coverage.Partial)
+	assert.Len(t, blocks, 1)
+}
+
+func TestRemoteError(t *testing.T) {
+	// Transport errors are served from the local cache
+	transportErr := &url.Error{Op: "Get", URL: "https://api.pennsieve.io", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	assert.ErrorIs(t, remoteError(transportErr), errRemoteUnavailable)
+
+	// Rejected sessions and sessions that cannot be refreshed are served from the local cache
+	assert.ErrorIs(t, remoteError(errors.New("unknown error, status code: 401")), errRemoteUnavailable)
+	assert.ErrorIs(t, remoteError(errors.New("unknown error, status code: 403")), errRemoteUnavailable)
+	assert.ErrorIs(t, remoteError(providerError("NotAuthorizedException")), errRemoteUnavailable)
+
+	// Other rejected requests and cancelled requests are returned to the caller
+	rejectedErr := errors.New("unknown error, status code: 404")
+	assert.Equal(t, rejectedErr, remoteError(rejectedErr))
+	cancelledErr := &url.Error{Op: "Get", URL: "https://api.pennsieve.io", Err: context.Canceled}
+	assert.NotErrorIs(t, remoteError(cancelledErr), errRemoteUnavailable)
+}
+
+// providerError is an error of the identity provider with an error code.
+type providerError string
+
+func (e providerError) Error() string {
+	return "api error " + string(e)
+}
+
+func (e providerError) ErrorCode() string {
+	return string(e)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSubscriber struct{}
+
+func (testSubscriber) GetSubscribers() sync.Map {
+	return sync.Map{}
+}
+
+// blockStore records the blocks that are stored in the cache.
+type blockStore struct {
+	store.TimeseriesStore
+	mu     sync.Mutex
+	stored []string
+}
+
+func (s *blockStore) StoreBlockForChannel(ctx context.Context, blockNodeId string, channelNodeId string, location string,
+	startTime uint64, endTime uint64, size int64, checksum uint32, encoding models.TsSampleEncoding, scale float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stored = append(s.stored, blockNodeId)
+	return nil
+}
+
+func TestSendBlocks(t *testing.T) {
+
+	// Earlier blocks take longer to download, so downloads complete out of order.
+	var active, maxActive int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		for {
+			m := atomic.LoadInt32(&maxActive)
+			if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+				break
+			}
+		}
+
+		index, _ := strconv.Atoi(r.URL.Query().Get("i"))
+		time.Sleep(time.Duration(10-index) * 5 * time.Millisecond)
+		w.Write(make([]byte, 8))
+	}))
+	defer server.Close()
+
+	ts := &blockStore{}
+	service := &TimeseriesServiceImpl{
+		tsStore:         ts,
+		subscriber:      testSubscriber{},
+		cache:           NewTimeseriesCache(ts, 0),
+		cacheLocation:   t.TempDir(),
+		downloadWorkers: 4,
+	}
+
+	var requests []*blockRequest
+	var expected []string
+	for i := 0; i < 10; i++ {
+		id := "block-" + strconv.Itoa(i)
+		req := &blockRequest{
+			block: models.TsBlock{
+				BlockNodeId:   id,
+				ChannelNodeId: "N:channel:" + strconv.Itoa(i/5),
+				Location:      filepath.Join(service.cacheLocation, id),
+				StartTime:     int64(i % 5),
+			},
+			url:    server.URL + "?i=" + strconv.Itoa(i),
+			cached: i == 3,
+			done:   make(chan error, 1),
+		}
+		if req.cached {
+			req.done <- nil
+		}
+		requests = append(requests, req)
+		expected = append(expected, id)
+	}
+
+	rangeChannel := make(chan models.TsBlock, len(requests))
+	err := service.sendBlocks(context.Background(), requests, rangeChannel)
+	require.NoError(t, err)
+	close(rangeChannel)
+
+	// Blocks are sent in the order of the requests
+	var received []string
+	for block := range rangeChannel {
+		received = append(received, block.BlockNodeId)
+	}
+	assert.Equal(t, expected, received)
+
+	// Uncached blocks are downloaded in parallel by a bounded number of workers
+	assert.Len(t, ts.stored, 9)
+	assert.NotContains(t, ts.stored, "block-3")
+	assert.Greater(t, atomic.LoadInt32(&maxActive), int32(1))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxActive), int32(4))
+}
+
+func TestSendBlocksCancelled(t *testing.T) {
+	service := &TimeseriesServiceImpl{cache: NewTimeseriesCache(&blockStore{}, 0)}
+
+	req := &blockRequest{block: models.TsBlock{BlockNodeId: "block-1"}, cached: true, done: make(chan error, 1)}
+	req.done <- nil
+
+	// The client stopped reading blocks, so sending returns when the request is cancelled.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := service.sendBlocks(ctx, []*blockRequest{req}, make(chan models.TsBlock))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestStartPrefetchLimit(t *testing.T) {
+	service := &TimeseriesServiceImpl{prefetches: make(chan struct{}, 1)}
+	service.prefetches <- struct{}{}
+
+	// Read-ahead requests beyond the limit are skipped instead of starting another download.
+	assert.False(t, service.startPrefetch("N:dataset:1", "N:package:1", []string{"N:channel:1"}, 10, 20))
+	assert.Len(t, service.prefetches, 1)
+}
@@ -115,6 +115,7 @@ func TestCalculateCropIndices(t *testing.T) {
 				tt.requestStart,
 				tt.requestEnd,
 				tt.rate,
+				8,
 			)
 			
 			if gotStartIdx != tt.wantStartIdx {
@@ -178,6 +179,7 @@ func TestCalculateCropIndices_EdgeCases(t *testing.T) {
 				tt.requestStart,
 				tt.requestEnd,
 				tt.rate,
+				8,
 			)
 			
 			if gotStartIdx != tt.wantStartIdx {
@@ -0,0 +1,317 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// WorkflowManifestFile is the name of the file that describes a workflow in the registry.
+const WorkflowManifestFile = "workflow.json"
+
+// ErrWorkflowNotRegistered is returned if the registry does not contain a workflow with the requested name.
+var ErrWorkflowNotRegistered = errors.New("workflow not registered")
+
+// parameterTypes are the types of workflow parameters.
+var parameterTypes = []string{"string", "integer", "number", "boolean"}
+
+// WorkflowDefinition describes a named workflow in the registry.
+type WorkflowDefinition struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Version     string           `json:"version"`
+	EntryPoint  string           `json:"entrypoint"` // Path of the workflow, relative to the folder of the workflow
+	Executor    string           `json:"executor"`   // Executor that runs the workflow if none is requested
+	Image       string           `json:"image"`      // Container image of the workflow
+	Parameters  *ParameterSchema `json:"parameters"` // JSON schema of the parameters of the workflow
+
+	Folder string `json:"-"`
+}
+
+// ParameterSchema is the subset of JSON schema that describes the parameters of a workflow: an object
+// with properties of type string, integer, number or boolean.
+type ParameterSchema struct {
+	Type                 string                      `json:"type,omitempty"`
+	Description          string                      `json:"description,omitempty"`
+	Properties           map[string]*ParameterSchema `json:"properties,omitempty"`
+	Required             []string                    `json:"required,omitempty"`
+	AdditionalProperties *bool                       `json:"additionalProperties,omitempty"`
+	Default              any                         `json:"default,omitempty"`
+	Enum                 []any                       `json:"enum,omitempty"`
+	Minimum              *float64                    `json:"minimum,omitempty"`
+	Maximum              *float64                    `json:"maximum,omitempty"`
+	MinLength            *int                        `json:"minLength,omitempty"`
+	MaxLength            *int                        `json:"maxLength,omitempty"`
+	Pattern              string                      `json:"pattern,omitempty"`
+}
+
+// WorkflowRegistry is a folder of named workflows. Each workflow is a sub-folder with a workflow.json
+// manifest, and the workflow files the manifest refers to. The folder is read on each call, so workflows
+// that are added to the folder are available without restarting the agent.
+type WorkflowRegistry struct {
+	folder string
+}
+
+func NewWorkflowRegistry(folder string) *WorkflowRegistry {
+	return &WorkflowRegistry{folder: folder}
+}
+
+// Folder returns the folder of the registry.
+func (r *WorkflowRegistry) Folder() string {
+	return r.folder
+}
+
+// List returns the workflows in the registry, sorted by name. Workflows with an invalid manifest are skipped.
+func (r *WorkflowRegistry) List() ([]WorkflowDefinition, error) {
+	entries, err := os.ReadDir(r.folder)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to read workflow registry %s: %w", r.folder, err)
+	}
+
+	var definitions []WorkflowDefinition
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		definition, err := loadWorkflowDefinition(filepath.Join(r.folder, entry.Name()))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			log.Warnf("Skipping workflow in %s: %v", entry.Name(), err)
+			continue
+		}
+		definitions = append(definitions, *definition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	return definitions, nil
+}
+
+// Get returns the workflow with the name. Workflows are found by the name in their manifest, which
+// defaults to the name of their folder.
+func (r *WorkflowRegistry) Get(name string) (*WorkflowDefinition, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return nil, fmt.Errorf("%w: %q", ErrWorkflowNotRegistered, name)
+	}
+
+	definition, err := loadWorkflowDefinition(filepath.Join(r.folder, name))
+	if err == nil && definition.Name == name {
+		return definition, nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	definitions, err := r.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range definitions {
+		if d.Name == name {
+			return &d, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrWorkflowNotRegistered, name)
+}
+
+// loadWorkflowDefinition reads and checks the manifest of the workflow in the folder.
+func loadWorkflowDefinition(folder string) (*WorkflowDefinition, error) {
+	content, err := os.ReadFile(filepath.Join(folder, WorkflowManifestFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var definition WorkflowDefinition
+	if err := json.Unmarshal(content, &definition); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", WorkflowManifestFile, err)
+	}
+	definition.Folder = folder
+	if definition.Name == "" {
+		definition.Name = filepath.Base(folder)
+	}
+
+	if definition.EntryPoint == "" {
+		return nil, fmt.Errorf("%s does not specify an entrypoint", WorkflowManifestFile)
+	}
+	if !filepath.IsLocal(definition.EntryPoint) {
+		return nil, fmt.Errorf("entrypoint %q is not inside the folder of the workflow", definition.EntryPoint)
+	}
+	if _, err := os.Stat(definition.EntryPointPath()); err != nil {
+		return nil, fmt.Errorf("entrypoint %q does not exist", definition.EntryPoint)
+	}
+
+	if err := definition.Parameters.check(); err != nil {
+		return nil, fmt.Errorf("invalid parameters: %w", err)
+	}
+	return &definition, nil
+}
+
+// EntryPointPath returns the absolute path of the workflow.
+func (d *WorkflowDefinition) EntryPointPath() string {
+	return filepath.Join(d.Folder, d.EntryPoint)
+}
+
+// ParameterNames returns the names of the parameters of the workflow, sorted by name.
+func (d *WorkflowDefinition) ParameterNames() []string {
+	if d.Parameters == nil {
+		return nil
+	}
+	names := make([]string, 0, len(d.Parameters.Properties))
+	for name := range d.Parameters.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsRequired returns true if the parameter is required.
+func (d *WorkflowDefinition) IsRequired(name string) bool {
+	return d.Parameters != nil && slices.Contains(d.Parameters.Required, name)
+}
+
+// ValidateParams converts the values of the parameters to the types in the schema of the workflow, adds
+// defaults of parameters that are not provided, and checks the result against the schema. All problems
+// with the parameters are returned in a single error.
+func (d *WorkflowDefinition) ValidateParams(values map[string]string) (map[string]any, error) {
+	schema := d.Parameters
+	if schema == nil {
+		schema = &ParameterSchema{}
+	}
+
+	params := map[string]any{}
+	var errs []error
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if !ok {
+			if schema.AdditionalProperties != nil && *schema.AdditionalProperties {
+				params[name] = values[name]
+			} else {
+				errs = append(errs, fmt.Errorf("unknown parameter %q", name))
+			}
+			continue
+		}
+
+		value, err := property.validate(values[name])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("parameter %q: %w", name, err))
+			continue
+		}
+		params[name] = value
+	}
+
+	for _, name := range d.ParameterNames() {
+		if _, ok := params[name]; ok {
+			continue
+		}
+		if _, invalid := values[name]; invalid {
+			continue
+		}
+		if property := schema.Properties[name]; property.Default != nil {
+			params[name] = property.Default
+		} else if slices.Contains(schema.Required, name) {
+			errs = append(errs, fmt.Errorf("missing required parameter %q", name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return params, nil
+}
+
+// check returns an error if the schema uses features that are not supported for workflow parameters.
+func (p *ParameterSchema) check() error {
+	if p == nil {
+		return nil
+	}
+	if p.Type != "" && p.Type != "object" {
+		return fmt.Errorf("parameters must be an object, not %q", p.Type)
+	}
+	for name, property := range p.Properties {
+		if property == nil || !slices.Contains(parameterTypes, property.Type) {
+			return fmt.Errorf("parameter %q must have type %s", name, strings.Join(parameterTypes, ", "))
+		}
+		if property.Pattern != "" {
+			if _, err := regexp.Compile(property.Pattern); err != nil {
+				return fmt.Errorf("parameter %q has an invalid pattern: %w", name, err)
+			}
+		}
+	}
+	for _, name := range p.Required {
+		if _, ok := p.Properties[name]; !ok {
+			return fmt.Errorf("required parameter %q is not defined", name)
+		}
+	}
+	return nil
+}
+
+// validate converts the value to the type of the property and checks it against the constraints of the property.
+func (p *ParameterSchema) validate(raw string) (any, error) {
+	var value any
+	var number float64
+
+	switch p.Type {
+	case "integer":
+		i, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", raw)
+		}
+		value, number = i, float64(i)
+	case "number":
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		value, number = f, f
+	case "boolean":
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", raw)
+		}
+		value = b
+	default:
+		value = raw
+		length := len([]rune(raw))
+		if p.MinLength != nil && length < *p.MinLength {
+			return nil, fmt.Errorf("must be at least %d characters", *p.MinLength)
+		}
+		if p.MaxLength != nil && length > *p.MaxLength {
+			return nil, fmt.Errorf("must be at most %d characters", *p.MaxLength)
+		}
+		if p.Pattern != "" && !regexp.MustCompile(p.Pattern).MatchString(raw) {
+			return nil, fmt.Errorf("%q does not match pattern %s", raw, p.Pattern)
+		}
+	}
+
+	if p.Type == "integer" || p.Type == "number" {
+		if p.Minimum != nil && number < *p.Minimum {
+			return nil, fmt.Errorf("%s is less than the minimum of %v", raw, *p.Minimum)
+		}
+		if p.Maximum != nil && number > *p.Maximum {
+			return nil, fmt.Errorf("%s is greater than the maximum of %v", raw, *p.Maximum)
+		}
+	}
+
+	if len(p.Enum) > 0 && !slices.ContainsFunc(p.Enum, func(e any) bool { return fmt.Sprint(e) == fmt.Sprint(value) }) {
+		return nil, fmt.Errorf("%q is not one of %v", raw, p.Enum)
+	}
+	return value, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bidsWorkflowManifest = `{
+  "description": "Validates and converts a BIDS dataset",
+  "version": "1.2.0",
+  "entrypoint": "main.nf",
+  "image": "ghcr.io/pennsieve/bids:1.2.0",
+  "parameters": {
+    "type": "object",
+    "properties": {
+      "subject": {"type": "string", "pattern": "^sub-[0-9]+$"},
+      "threads": {"type": "integer", "minimum": 1, "maximum": 16, "default": 4},
+      "threshold": {"type": "number"},
+      "strict": {"type": "boolean"},
+      "format": {"type": "string", "enum": ["nifti", "edf"]}
+    },
+    "required": ["subject"]
+  }
+}`
+
+func writeRegistryWorkflow(t *testing.T, folder string, name string, manifest string, entrypoint string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(folder, name), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, name, WorkflowManifestFile), []byte(manifest), 0644))
+	if entrypoint != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(folder, name, entrypoint), []byte("workflow {}"), 0644))
+	}
+}
+
+func TestWorkflowRegistry(t *testing.T) {
+	folder := t.TempDir()
+	writeRegistryWorkflow(t, folder, "bids", bidsWorkflowManifest, "main.nf")
+	writeRegistryWorkflow(t, folder, "folder-name", `{"name": "renamed", "entrypoint": "run.sh"}`, "run.sh")
+	writeRegistryWorkflow(t, folder, "missing-entrypoint", `{"entrypoint": "main.nf"}`, "")
+	writeRegistryWorkflow(t, folder, "escapes", `{"entrypoint": "../bids/main.nf"}`, "")
+	writeRegistryWorkflow(t, folder, "bad-type", `{"entrypoint": "main.nf", "parameters": {"properties": {"x": {"type": "array"}}}}`, "main.nf")
+	require.NoError(t, os.MkdirAll(filepath.Join(folder, "not-a-workflow"), 0755))
+
+	registry := NewWorkflowRegistry(folder)
+	definitions, err := registry.List()
+	require.NoError(t, err)
+	require.Len(t, definitions, 2, "Expect workflows with invalid manifests to be skipped.")
+	assert.Equal(t, "bids", definitions[0].Name)
+	assert.Equal(t, "renamed", definitions[1].Name)
+
+	definition, err := registry.Get("bids")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0", definition.Version)
+	assert.Equal(t, filepath.Join(folder, "bids", "main.nf"), definition.EntryPointPath())
+	assert.Equal(t, []string{"format", "strict", "subject", "threads", "threshold"}, definition.ParameterNames())
+	assert.True(t, definition.IsRequired("subject"))
+
+	definition, err = registry.Get("renamed")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(folder, "folder-name"), definition.Folder)
+
+	_, err = registry.Get("unknown")
+	assert.ErrorIs(t, err, ErrWorkflowNotRegistered)
+	_, err = registry.Get("../bids")
+	assert.ErrorIs(t, err, ErrWorkflowNotRegistered)
+
+	_, err = registry.Get("missing-entrypoint")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrWorkflowNotRegistered)
+
+	// A registry folder that does not exist is empty
+	definitions, err = NewWorkflowRegistry(filepath.Join(folder, "does-not-exist")).List()
+	assert.NoError(t, err)
+	assert.Empty(t, definitions)
+}
+
+func TestValidateWorkflowParams(t *testing.T) {
+	folder := t.TempDir()
+	writeRegistryWorkflow(t, folder, "bids", bidsWorkflowManifest, "main.nf")
+	definition, err := NewWorkflowRegistry(folder).Get("bids")
+	require.NoError(t, err)
+
+	params, err := definition.ValidateParams(map[string]string{
+		"subject":   "sub-01",
+		"threshold": "0.5",
+		"strict":    "true",
+		"format":    "edf",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"subject":   "sub-01",
+		"threshold": 0.5,
+		"strict":    true,
+		"format":    "edf",
+		"threads":   float64(4),
+	}, params)
+
+	_, err = definition.ValidateParams(map[string]string{
+		"subject": "subject-1",
+		"threads": "32",
+		"strict":  "maybe",
+		"format":  "csv",
+		"unknown": "x",
+	})
+	require.Error(t, err)
+	for _, problem := range []string{`"subject": "subject-1" does not match`, `"threads": 32 is greater than the maximum`,
+		`"strict": "maybe" is not a boolean`, `"format": "csv" is not one of`, `unknown parameter "unknown"`} {
+		assert.ErrorContains(t, err, problem)
+	}
+	assert.NotContains(t, err.Error(), "missing required parameter", "Expect invalid values not to be reported as missing.")
+
+	_, err = definition.ValidateParams(nil)
+	assert.ErrorContains(t, err, `missing required parameter "subject"`)
+}
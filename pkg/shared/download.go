@@ -56,11 +56,10 @@ type ProgressReader struct {
 }
 
 func (pr *ProgressReader) Read(p []byte) (int, error) {
-    crc := crc32.Update(pr.crc32, crc32.IEEETable, p)
     n, err := pr.Reader.Read(p)
-    if err == nil {
+    if n > 0 {
         pr.Pos += int64(n)
-        pr.crc32 = crc
+        pr.crc32 = crc32.Update(pr.crc32, crc32.IEEETable, p[:n])
         pr.s.updateDownloadSubscribers(pr.Size, pr.Pos, pr.Name, api.SubscribeResponse_DownloadStatusResponse_IN_PROGRESS)
     }
     return n, err
@@ -135,11 +134,15 @@ func (s *downloader) CancelDownload(ctx context.Context, req *api.CancelDownload
 //
 //	downloadId is a unique id that is associated with the download (i.e. packageId, or manifestId)
 //	targetLocation is the absolute path and file-name where the downloaded content is stored
+//
+// It returns the CRC32 checksum of the downloaded content. An error is returned if the server does not
+// respond with the content, or if fewer bytes are received than the server announced.
 func (s *downloader) DownloadFileFromPresignedUrl(ctx context.Context, url string, targetLocation string, downloadId string) (uint32, error) {
 
     start := time.Now().UnixMilli()
 
-    ctx, cancelFnc := context.WithCancel(context.Background())
+    ctx, cancelFnc := context.WithCancel(ctx)
+    defer cancelFnc()
     session := downloadSession{
         id:        downloadId,
         cancelFnc: cancelFnc,
@@ -149,20 +152,23 @@ func (s *downloader) DownloadFileFromPresignedUrl(ctx context.Context, url strin
     req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
     if err != nil {
         log.Errorf("Download failed: %v", err)
+        return 0, err
     }
 
     log.Infof("Downloading %s to %s", url, targetLocation)
 
-    resp, err := http.Get(req.URL.String())
+    resp, err := http.DefaultClient.Do(req)
     if err != nil {
         log.Errorf("Download failed: %v", err)
+        return 0, err
     }
 
     if resp.StatusCode != 200 {
+        resp.Body.Close()
         log.Info(resp)
         log.Infof("Error while downloading: %v", resp.StatusCode)
         fmt.Println(" - Download cancelled")
-        return 0, err
+        return 0, fmt.Errorf("download failed with status %d", resp.StatusCode)
     }
     defer func(Body io.ReadCloser) {
         err := Body.Close()
@@ -171,7 +177,11 @@ func (s *downloader) DownloadFileFromPresignedUrl(ctx context.Context, url strin
         }
     }(resp.Body)
 
-    f, _ := os.OpenFile(targetLocation, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+    f, err := os.OpenFile(targetLocation, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+    if err != nil {
+        log.Errorf("Download failed: %v", err)
+        return 0, err
+    }
     defer func(f *os.File) {
         err := f.Close()
         if err != nil {
@@ -187,7 +197,11 @@ func (s *downloader) DownloadFileFromPresignedUrl(ctx context.Context, url strin
         crc32:  0,
     }
 
-    if _, err = io.Copy(f, progressReader); err != nil {
+    written, err := io.Copy(f, progressReader)
+    if err == nil && resp.ContentLength >= 0 && written != resp.ContentLength {
+        err = fmt.Errorf("incomplete download: received %d of %d bytes", written, resp.ContentLength)
+    }
+    if err != nil {
         log.Infof("Error while downloading: %v", err)
         fmt.Println(" - Download cancelled")
 
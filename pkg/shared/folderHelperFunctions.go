@@ -1,8 +1,11 @@
 package shared
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	log "github.com/sirupsen/logrus"
 	"hash/crc32"
+	"io"
 	"os"
 	"path/filepath"
 )
@@ -50,3 +53,20 @@ func GetFileCrc32(path string, maxBytes int) (uint32, error) {
 	return crc32.ChecksumIEEE(b1), nil
 
 }
+
+// GetFileSha256 returns the hex-encoded SHA-256 over the entire content of a file.
+func GetFileSha256(path string) (string, error) {
+
+	f, err := os.Open(filepath.FromSlash(path))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -1,8 +1,25 @@
 package shared
 
-import pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+import (
+	"sync"
+
+	pb "github.com/pennsieve/pennsieve-agent/v2/api/v1"
+)
 
 type Sub struct {
 	Stream   pb.Agent_SubscribeServer // Stream is the server side of the RPC Stream
 	Finished chan<- bool              //finishedd is used to signal closure of a client subscribing goroutine
+	sendMu   *sync.Mutex              // sendMu serializes sends, as a gRPC stream does not support concurrent sends
+}
+
+// NewSub returns a subscriber that receives messages on the stream.
+func NewSub(stream pb.Agent_SubscribeServer, finished chan<- bool) Sub {
+	return Sub{Stream: stream, Finished: finished, sendMu: &sync.Mutex{}}
+}
+
+// Send sends a message on the Stream of the subscriber. It is safe to call Send from multiple goroutines.
+func (s Sub) Send(resp *pb.SubscribeResponse) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.Stream.Send(resp)
 }
@@ -9,7 +9,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
+	"sync"
 
+	api "github.com/pennsieve/pennsieve-agent/v2/api/v1"
 	models2 "github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	models "github.com/pennsieve/pennsieve-go-core/pkg/models/workspaceManifest"
 	log "github.com/sirupsen/logrus"
@@ -48,6 +51,34 @@ func ReadStateFile(stateFileLocation string) (*models2.MapState, error) {
 	return &data, nil
 }
 
+// stateFileLocks holds a mutex for each state file that is updated by the agent.
+var stateFileLocks sync.Map
+
+// LockStateFile locks the state file at the specified location until the returned function is called.
+// Commands that read, update and write the state of a mapped dataset hold the lock, so they do not
+// overwrite each other's changes.
+func LockStateFile(stateFileLocation string) (unlock func()) {
+	value, _ := stateFileLocks.LoadOrStore(filepath.Clean(filepath.FromSlash(stateFileLocation)), &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+
+	return mu.Unlock
+}
+
+// WriteStateFile writes the state of a mapped dataset to the specified location
+func WriteStateFile(stateFileLocation string, state *models2.MapState) error {
+	stateJson, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state file: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.FromSlash(stateFileLocation), stateJson, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %s, error: %v", stateFileLocation, err)
+	}
+
+	return nil
+}
+
 func ReadWorkspaceManifest(manifestLocation string) (*models.WorkspaceManifest, error) {
 
 	// Now read in manifest
@@ -150,3 +181,18 @@ func CreateManifestDTO(fileName string, path string, size int64) models.Manifest
 		CheckSum: models.NullString{},
 	}
 }
+
+// ParseDiffStrength converts a diff strength as used in the agent configuration and
+// on the command line ("fast", "full" or "size+mtime") to a diff strength in the API.
+func ParseDiffStrength(strength string) (api.MapDiffRequest_Strength, error) {
+	switch strings.ToLower(strength) {
+	case "", "fast":
+		return api.MapDiffRequest_FAST, nil
+	case "full":
+		return api.MapDiffRequest_FULL, nil
+	case "size+mtime":
+		return api.MapDiffRequest_SIZE_MTIME, nil
+	}
+
+	return api.MapDiffRequest_FAST, fmt.Errorf("unknown diff strength: %s", strength)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest"
 	log "github.com/sirupsen/logrus"
 )
@@ -18,9 +19,11 @@ type Manifest struct {
 	OrganizationName string          `json:"organization_name"`
 	DatasetId        string          `json:"dataset_id"`
 	DatasetName      string          `json:"dataset_name"`
-	Status           manifest.Status `json:"status"`
+	Status           manifest.Status `json:"status"` // Status that is sent to the upload service; it is not advanced by the agent
 	CreatedAt        time.Time       `json:"created_at"`
 	UpdatedAt        time.Time       `json:"updated_at"`
+
+	State models.ManifestState `json:"state"` // Lifecycle state of the manifest in the agent, which replaces Status to track progress
 }
 
 type ManifestParams struct {
@@ -38,6 +41,8 @@ type ManifestStore interface {
 	Add(s ManifestParams) (*Manifest, error)
 	Remove(manifestId int32) error
 	SetManifestNodeId(manifestId int32, nodeId string) error
+	UpdateState(manifestId int32, event models.ManifestEvent) (models.ManifestState, error)
+	ResetInterruptedStates() (int64, error)
 }
 
 func NewManifestStore(db *sql.DB) *manifestStore {
@@ -69,7 +74,8 @@ func (s *manifestStore) Get(id int32) (*Manifest, error) {
 		&res.DatasetName,
 		&statusStr,
 		&res.CreatedAt,
-		&res.UpdatedAt)
+		&res.UpdatedAt,
+		&res.State)
 
 	var m manifest.Status
 	res.Status = m.ManifestStatusMap(statusStr)
@@ -105,6 +111,7 @@ func (s *manifestStore) GetAll() ([]Manifest, error) {
 			&statusStr,
 			&currentRecord.CreatedAt,
 			&currentRecord.UpdatedAt,
+			&currentRecord.State,
 		)
 		if err != nil {
 			log.Error("ERROR: ", err)
@@ -152,6 +159,7 @@ func (s *manifestStore) Add(params ManifestParams) (*Manifest, error) {
 		Status:           manifest.Initiated,
 		CreatedAt:        currentTime,
 		UpdatedAt:        currentTime,
+		State:            models.ManifestIndexed,
 	}
 
 	return &createdManifest, err
@@ -193,3 +201,64 @@ func (s *manifestStore) SetManifestNodeId(manifestId int32, nodeId string) error
 
 	return nil
 }
+
+// UpdateState moves the manifest to the next state after an event, based on its current state and the number of
+// files in the manifest for each status, and returns the new state.
+func (s *manifestStore) UpdateState(manifestId int32, event models.ManifestEvent) (models.ManifestState, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var current models.ManifestState
+	if err := tx.QueryRow("SELECT state FROM manifests WHERE id = ?", manifestId).Scan(&current); err != nil {
+		return "", fmt.Errorf("unable to get state of manifest %d: %w", manifestId, err)
+	}
+
+	counts, err := statusCounts(tx, manifestId)
+	if err != nil {
+		return "", err
+	}
+
+	next := models.NextManifestState(current, event, counts)
+	if next != current {
+		if _, err := tx.Exec("UPDATE manifests SET state = ?, updated_at = ? WHERE id = ?", next, time.Now(), manifestId); err != nil {
+			return "", fmt.Errorf("unable to update state of manifest %d: %w", manifestId, err)
+		}
+		log.Debugf("Manifest %d moved from %s to %s.", manifestId, current, next)
+	}
+
+	return next, tx.Commit()
+}
+
+// ResetInterruptedStates moves manifests that were syncing or uploading when the agent stopped to the state that
+// matches the status of their files, and returns the number of manifests that were updated.
+func (s *manifestStore) ResetInterruptedStates() (int64, error) {
+	rows, err := s.db.Query("SELECT id FROM manifests WHERE state IN (?, ?)", models.ManifestSyncing, models.ManifestUploading)
+	if err != nil {
+		return 0, err
+	}
+	var ids []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var n int64
+	for _, id := range ids {
+		if _, err := s.UpdateState(id, models.ManifestInterrupted); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
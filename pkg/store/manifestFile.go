@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"sort"
 	"strings"
 	"time"
 
@@ -33,8 +34,44 @@ type ManifestFileParams struct {
 	ManifestId int32  `json:"manifest_id"`
 }
 
+// ErrManifestFileChanged is returned when the status of a file changed while it was being updated.
+var ErrManifestFileChanged = errors.New("manifest file changed")
+
+// ManifestFileTarget is the target path, name and status of a file in a manifest. PreviousStatus is the
+// status the file must still have for the update to be applied.
+type ManifestFileTarget struct {
+	Id             int32
+	TargetPath     string
+	TargetName     string
+	Status         manifestFile.Status
+	PreviousStatus manifestFile.Status
+}
+
+// ManifestFileOrder is the order in which files in a manifest are listed.
+type ManifestFileOrder string
+
+const (
+	OrderById         ManifestFileOrder = "id"
+	OrderBySourcePath ManifestFileOrder = "source_path"
+	OrderByTargetPath ManifestFileOrder = "target_path" // Path of the file in the dataset, including its name
+	OrderByStatus     ManifestFileOrder = "status"
+	OrderByUpdatedAt  ManifestFileOrder = "updated_at"
+)
+
+// ManifestFileFilter selects, sorts and pages the files in a manifest.
+type ManifestFileFilter struct {
+	Statuses   []manifestFile.Status // Only files with one of the statuses; files with any status if empty
+	TargetPath string                // Glob or prefix that matches the path of files in the dataset, including their name
+	SourcePath string                // Glob or prefix that matches the source path of files
+	OrderBy    ManifestFileOrder     // OrderById if empty
+	Descending bool
+	Limit      int32
+	Offset     int32
+}
+
 type ManifestFileStore interface {
 	Get(manifestId int32, limit int32, offset int32) ([]ManifestFile, error)
+	Find(manifestId int32, filter ManifestFileFilter) ([]ManifestFile, int64, error)
 	GetByStatus(manifestId int32, statusArray []manifestFile.Status, limit int, offset int) ([]ManifestFile, error)
 	Add(records []ManifestFileParams) error
 	BatchSetStatus(status manifestFile.Status, uploadIds []string) error
@@ -46,6 +83,10 @@ type ManifestFileStore interface {
 	GetNumberOfRowsForStatus(manifestId int32, statusArr []manifestFile.Status, invert bool) (int64, error)
 	ManifestFilesToChannel(ctx context.Context, manifestId int32, statusArr []manifestFile.Status, walker chan<- ManifestFile)
 	GetManifestIDsWithFilesInStatus(statuses []manifestFile.Status) ([]int32, error)
+	UpdateTargets(manifestId int32, targets []ManifestFileTarget) error
+	SetMetadata(manifestId int32, metadata map[string]map[string]string) ([]string, error)
+	GetMetadata(ids []int32) (map[int32]map[string]string, error)
+	GetStatusCounts(manifestId int32) (map[manifestFile.Status]int64, error)
 }
 
 func NewManifestFileStore(db *sql.DB) *manifestFileStore {
@@ -61,7 +102,7 @@ type manifestFileStore struct {
 // Get returns manifest paginated manifest files.
 func (s *manifestFileStore) Get(manifestId int32, limit int32, offset int32) ([]ManifestFile, error) {
 
-	rows, err := s.db.Query("SELECT * FROM manifest_files WHERE manifest_id = ? ORDER BY id LIMIT ? OFFSET ?",
+	rows, err := s.db.Query("SELECT "+manifestFileColumns+" FROM manifest_files WHERE manifest_id = ? ORDER BY id LIMIT ? OFFSET ?",
 		manifestId, limit, offset)
 	if err != nil {
 		return nil, err
@@ -95,6 +136,131 @@ func (s *manifestFileStore) Get(manifestId int32, limit int32, offset int32) ([]
 
 }
 
+// manifestFileColumns are the columns of a manifest file, in the order in which they are scanned. The generated
+// dataset_path column is left out.
+const manifestFileColumns = "id, manifest_id, upload_id, source_path, target_path, target_name, status, created_at, updated_at"
+
+// orderColumns are the expressions that files are sorted by for each order.
+var orderColumns = map[ManifestFileOrder]string{
+	OrderById:         "id",
+	OrderBySourcePath: "source_path",
+	OrderByTargetPath: "dataset_path",
+	OrderByStatus:     "status",
+	OrderByUpdatedAt:  "updated_at",
+}
+
+// Find returns a page of the files in a manifest that match the filter, and the total number of files that
+// match the filter. A path without glob characters (*, ? or [) matches files whose path starts with it.
+// The paths are matched as a range of the indexed columns, followed by the glob if the path has one.
+func (s *manifestFileStore) Find(manifestId int32, filter ManifestFileFilter) ([]ManifestFile, int64, error) {
+	orderBy := filter.OrderBy
+	if orderBy == "" {
+		orderBy = OrderById
+	}
+	column, ok := orderColumns[orderBy]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported order %q", orderBy)
+	}
+	direction := "ASC"
+	if filter.Descending {
+		direction = "DESC"
+	}
+
+	where := []string{"manifest_id = ?"}
+	args := []any{manifestId}
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, st := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, st.String())
+		}
+		where = append(where, fmt.Sprintf("status IN (%s)", strings.Join(placeholders, ",")))
+	}
+	if pattern := strings.TrimPrefix(filter.TargetPath, "/"); pattern != "" {
+		condition, conditionArgs := pathCondition("dataset_path", pattern)
+		where = append(where, condition)
+		args = append(args, conditionArgs...)
+	}
+	if filter.SourcePath != "" {
+		condition, conditionArgs := pathCondition("source_path", filter.SourcePath)
+		where = append(where, condition)
+		args = append(args, conditionArgs...)
+	}
+	whereStr := strings.Join(where, " AND ")
+
+	var total int64
+	if err := s.db.QueryRow("SELECT count(*) FROM manifest_files WHERE "+whereStr, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("unable to count files in manifest %d: %w", manifestId, err)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = -1 // no limit
+	}
+	queryStr := fmt.Sprintf("SELECT %s FROM manifest_files WHERE %s ORDER BY %s %s, id %s LIMIT ? OFFSET ?",
+		manifestFileColumns, whereStr, column, direction, direction)
+	rows, err := s.db.Query(queryStr, append(args, limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to list files in manifest %d: %w", manifestId, err)
+	}
+	defer rows.Close()
+
+	var files []ManifestFile
+	for rows.Next() {
+		var file ManifestFile
+		var targetPath sql.NullString
+		var st string
+		if err := rows.Scan(&file.Id, &file.ManifestId, &file.UploadId, &file.SourcePath, &targetPath,
+			&file.TargetName, &st, &file.CreatedAt, &file.UpdatedAt); err != nil {
+			return nil, 0, err
+		}
+		file.TargetPath = targetPath.String
+		file.Status = file.Status.ManifestFileStatusMap(st)
+		files = append(files, file)
+	}
+	return files, total, rows.Err()
+}
+
+// pathCondition returns a condition that matches the column against a glob, or against a prefix if the pattern
+// has no glob characters. The literal prefix of the pattern is matched as a range, so the index of the column
+// is used.
+func pathCondition(column string, pattern string) (string, []any) {
+	prefix := pattern
+	if i := strings.IndexAny(pattern, "*?["); i >= 0 {
+		prefix = pattern[:i]
+	}
+
+	var conditions []string
+	var args []any
+	if prefix != "" {
+		conditions = append(conditions, column+" >= ?")
+		args = append(args, prefix)
+		if upper, ok := prefixUpperBound(prefix); ok {
+			conditions = append(conditions, column+" < ?")
+			args = append(args, upper)
+		}
+	}
+	if prefix != pattern {
+		conditions = append(conditions, column+" GLOB ?")
+		args = append(args, pattern)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// prefixUpperBound returns the smallest string that is greater than all strings that start with the prefix,
+// or false if there is no such string.
+func prefixUpperBound(prefix string) (string, bool) {
+	b := []byte(prefix)
+	for len(b) > 0 && b[len(b)-1] == 0xff {
+		b = b[:len(b)-1]
+	}
+	if len(b) == 0 {
+		return "", false
+	}
+	b[len(b)-1]++
+	return string(b), true
+}
+
 // GetByStatus returns files in a manifest filtered by status.
 func (s *manifestFileStore) GetByStatus(manifestId int32, statusArray []manifestFile.Status, limit int, offset int) ([]ManifestFile, error) {
 
@@ -103,8 +269,8 @@ func (s *manifestFileStore) GetByStatus(manifestId int32, statusArray []manifest
 		statusList = append(statusList, fmt.Sprintf("'%s'", reqStatus.String()))
 	}
 	statusQueryString := fmt.Sprintf("(%s)", strings.Join(statusList, ","))
-	queryStr := fmt.Sprintf("SELECT * FROM manifest_files WHERE manifest_id = ? "+
-		"AND status IN %s ORDER BY id LIMIT ? OFFSET ?", statusQueryString)
+	queryStr := fmt.Sprintf("SELECT %s FROM manifest_files WHERE manifest_id = ? "+
+		"AND status IN %s ORDER BY id LIMIT ? OFFSET ?", manifestFileColumns, statusQueryString)
 
 	log.Debug(queryStr)
 	rows, err := s.db.Query(queryStr, manifestId, limit, offset)
@@ -179,6 +345,155 @@ func (s *manifestFileStore) Add(records []ManifestFileParams) error {
 
 }
 
+// UpdateTargets updates the target path, name and status of files in a manifest in a single transaction.
+// Nothing is updated and ErrManifestFileChanged is returned if a file no longer has its previous status,
+// for instance because it was uploaded in the meantime.
+func (s *manifestFileStore) UpdateTargets(manifestId int32, targets []ManifestFileTarget) error {
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare("UPDATE manifest_files SET target_path = ?, target_name = ?, status = ?, updated_at = ? " +
+		"WHERE manifest_id = ? AND id = ? AND status = ?")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	currentTime := time.Now()
+	for _, target := range targets {
+		result, err := stmt.Exec(target.TargetPath, target.TargetName, target.Status.String(), currentTime,
+			manifestId, target.Id, target.PreviousStatus.String())
+		if err != nil {
+			return fmt.Errorf("unable to update target of file %d: %w", target.Id, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			continue
+		}
+
+		var current string
+		err = tx.QueryRow("SELECT status FROM manifest_files WHERE manifest_id = ? AND id = ?", manifestId, target.Id).Scan(&current)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("file %d is not in manifest %d", target.Id, manifestId)
+		case err != nil:
+			return err
+		default:
+			return fmt.Errorf("%w: file %d is %s", ErrManifestFileChanged, target.Id, strings.ToLower(current))
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SetMetadata stores key/value metadata of files in a manifest in a single transaction. The metadata is keyed
+// by the source path of the files, and replaces existing values of the same keys. It returns the source paths
+// that are not in the manifest.
+func (s *manifestFileStore) SetMetadata(manifestId int32, metadata map[string]map[string]string) ([]string, error) {
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	insert, err := tx.Prepare("INSERT INTO manifest_file_metadata(manifest_file_id, key, value) VALUES (?, ?, ?) " +
+		"ON CONFLICT(manifest_file_id, key) DO UPDATE SET value = excluded.value")
+	if err != nil {
+		return nil, err
+	}
+	defer insert.Close()
+
+	sourcePaths := make([]string, 0, len(metadata))
+	for sourcePath := range metadata {
+		sourcePaths = append(sourcePaths, sourcePath)
+	}
+	sort.Strings(sourcePaths)
+
+	var missing []string
+	for _, sourcePath := range sourcePaths {
+		ids, err := manifestFileIds(tx, manifestId, sourcePath)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			missing = append(missing, sourcePath)
+			continue
+		}
+
+		for _, id := range ids {
+			for key, value := range metadata[sourcePath] {
+				if _, err := insert.Exec(id, key, value); err != nil {
+					return nil, fmt.Errorf("unable to set metadata of %s: %w", sourcePath, err)
+				}
+			}
+		}
+	}
+
+	return missing, tx.Commit()
+}
+
+// manifestFileIds returns the ids of the files in a manifest with the source path that are not removed.
+func manifestFileIds(tx *sql.Tx, manifestId int32, sourcePath string) ([]int32, error) {
+	rows, err := tx.Query("SELECT id FROM manifest_files WHERE manifest_id = ? AND source_path = ? AND status != ?",
+		manifestId, sourcePath, manifestFile.Removed.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// GetMetadata returns the key/value metadata of the files with the ids. Files without metadata are not in the result.
+func (s *manifestFileStore) GetMetadata(ids []int32) (map[int32]map[string]string, error) {
+	result := map[int32]map[string]string{}
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+
+	rows, err := s.db.Query("SELECT manifest_file_id, key, value FROM manifest_file_metadata "+
+		"WHERE manifest_file_id IN ("+placeholders+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int32
+		var key, value string
+		if err := rows.Scan(&id, &key, &value); err != nil {
+			return nil, err
+		}
+		if result[id] == nil {
+			result[id] = map[string]string{}
+		}
+		result[id][key] = value
+	}
+	return result, rows.Err()
+}
+
 // BatchSetStatus updates the status of a batch of upload files.
 func (s *manifestFileStore) BatchSetStatus(status manifestFile.Status, uploadIds []string) error {
 
@@ -500,6 +815,37 @@ func (s *manifestFileStore) GetNumberOfRowsForStatus(manifestId int32, statusArr
 	return totalNrRows, nil
 }
 
+// GetStatusCounts returns the number of files in a manifest for each status.
+func (s *manifestFileStore) GetStatusCounts(manifestId int32) (map[manifestFile.Status]int64, error) {
+	return statusCounts(s.db, manifestId)
+}
+
+// queryer is implemented by both sql.DB and sql.Tx.
+type queryer interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// statusCounts returns the number of files in a manifest for each status.
+func statusCounts(q queryer, manifestId int32) (map[manifestFile.Status]int64, error) {
+	rows, err := q.Query("SELECT status, count(*) FROM manifest_files WHERE manifest_id = ? GROUP BY status", manifestId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to count files in manifest %d: %w", manifestId, err)
+	}
+	defer rows.Close()
+
+	counts := map[manifestFile.Status]int64{}
+	for rows.Next() {
+		var statusStr string
+		var n int64
+		if err := rows.Scan(&statusStr, &n); err != nil {
+			return nil, err
+		}
+		var st manifestFile.Status
+		counts[st.ManifestFileStatusMap(statusStr)] += n
+	}
+	return counts, rows.Err()
+}
+
 // ManifestFilesToChannel streams files in a manifest with a specific status to a channel
 func (s *manifestFileStore) ManifestFilesToChannel(ctx context.Context, manifestId int32, statusArr []manifestFile.Status, walker chan<- ManifestFile) {
 	// 1. Synchronize Walker
@@ -509,8 +855,8 @@ func (s *manifestFileStore) ManifestFilesToChannel(ctx context.Context, manifest
 		statusList = append(statusList, fmt.Sprintf("'%s'", reqStatus.String()))
 	}
 	statusQueryString := fmt.Sprintf("(%s)", strings.Join(statusList, ","))
-	queryStr := fmt.Sprintf("SELECT * FROM manifest_files WHERE manifest_id = ? "+
-		"AND status IN %s ORDER BY id", statusQueryString)
+	queryStr := fmt.Sprintf("SELECT %s FROM manifest_files WHERE manifest_id = ? "+
+		"AND status IN %s ORDER BY id", manifestFileColumns, statusQueryString)
 
 	rows, err := s.db.QueryContext(ctx, queryStr, manifestId)
 	if err != nil {
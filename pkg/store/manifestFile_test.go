@@ -3,6 +3,7 @@ package store
 import (
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	"github.com/pennsieve/pennsieve-go-core/pkg/models/manifest/manifestFile"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,13 @@ func TestManifestFileStore(t *testing.T) {
 		{"remove from manifest: no file found under prefix", removeFromManifestFixture, testRemoveFromManifestNoFilesUnderPrefix},
 		{"remove from manifest: one file found under prefix", removeFromManifestFixture, testRemoveFromManifestOneFileUnderPrefix},
 		{"remove from manifest: multiple files found under prefix", removeFromManifestFixture, testRemoveFromManifestMultipleFilesUnderPrefix},
+		{"update targets", removeFromManifestFixture, testUpdateTargets},
+		{"update targets: file not in manifest", removeFromManifestFixture, testUpdateTargetsNotInManifest},
+		{"update targets: status changed", removeFromManifestFixture, testUpdateTargetsStatusChanged},
+		{"set metadata", removeFromManifestFixture, testSetMetadata},
+		{"update state", removeFromManifestFixture, testUpdateState},
+		{"reset interrupted states", removeFromManifestFixture, testResetInterruptedStates},
+		{"find files", removeFromManifestFixture, testFind},
 	}
 
 	for _, tt := range tests {
@@ -163,6 +171,266 @@ func testRemoveFromManifestMultipleFilesUnderPrefix(t *testing.T, fixture *Fixtu
 
 }
 
+func testUpdateTargets(t *testing.T, fixture *Fixture) {
+	local := fixture.ManifestFiles[0]
+	registered := fixture.ManifestFiles[1]
+
+	err := fixture.ManifestFileStore.UpdateTargets(fixture.Manifest.Id, []ManifestFileTarget{
+		{Id: local.Id, TargetPath: "sub-01/ses-01", TargetName: "local.txt", Status: manifestFile.Local, PreviousStatus: manifestFile.Local},
+		{Id: registered.Id, TargetPath: "folder1", TargetName: "renamed.txt", Status: manifestFile.Changed, PreviousStatus: manifestFile.Registered},
+	})
+	require.NoError(t, err)
+
+	files, err := fixture.ManifestFileStore.Get(fixture.Manifest.Id, int32(len(fixture.ManifestFiles)), 0)
+	require.NoError(t, err)
+	for _, file := range files {
+		switch file.Id {
+		case local.Id:
+			assert.Equal(t, "sub-01/ses-01", file.TargetPath)
+			assert.Equal(t, manifestFile.Local, file.Status)
+		case registered.Id:
+			assert.Equal(t, "renamed.txt", file.TargetName)
+			assert.Equal(t, manifestFile.Changed, file.Status)
+		default:
+			assert.Contains(t, []string{"folder1", "folder2"}, file.TargetPath, "Expect other files to keep their target.")
+		}
+	}
+}
+
+func testUpdateTargetsNotInManifest(t *testing.T, fixture *Fixture) {
+	local := fixture.ManifestFiles[0]
+
+	err := fixture.ManifestFileStore.UpdateTargets(fixture.Manifest.Id, []ManifestFileTarget{
+		{Id: local.Id, TargetPath: "moved", TargetName: "local.txt", Status: manifestFile.Local, PreviousStatus: manifestFile.Local},
+		{Id: local.Id + 1000, TargetPath: "moved", TargetName: "other.txt", Status: manifestFile.Local, PreviousStatus: manifestFile.Local},
+	})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrManifestFileChanged)
+
+	// No file is updated if one of the files cannot be updated
+	fixture.AssertManifestFiles(t, func(t *testing.T, files []ManifestFile) {
+		for _, file := range files {
+			assert.NotEqual(t, "moved", file.TargetPath)
+		}
+	})
+}
+
+func testUpdateTargetsStatusChanged(t *testing.T, fixture *Fixture) {
+	local := fixture.ManifestFiles[0]
+	registered := fixture.ManifestFiles[1]
+
+	// The registered file was uploaded after its target was computed
+	err := fixture.ManifestFileStore.UpdateTargets(fixture.Manifest.Id, []ManifestFileTarget{
+		{Id: local.Id, TargetPath: "moved", TargetName: "local.txt", Status: manifestFile.Local, PreviousStatus: manifestFile.Local},
+		{Id: registered.Id, TargetPath: "moved", TargetName: "registered.txt", Status: manifestFile.Changed, PreviousStatus: manifestFile.Local},
+	})
+	assert.ErrorIs(t, err, ErrManifestFileChanged)
+
+	fixture.AssertManifestFiles(t, func(t *testing.T, files []ManifestFile) {
+		for _, file := range files {
+			assert.NotEqual(t, "moved", file.TargetPath)
+			if file.Id == registered.Id {
+				assert.Equal(t, manifestFile.Registered, file.Status, "Expect the status of the file to be kept.")
+			}
+		}
+	})
+}
+
+func testSetMetadata(t *testing.T, fixture *Fixture) {
+	local := fixture.ManifestFiles[0]
+	registered := fixture.ManifestFiles[1]
+
+	missing, err := fixture.ManifestFileStore.SetMetadata(fixture.Manifest.Id, map[string]map[string]string{
+		local.SourcePath:      {"subject_id": "sub-01", "session": "1"},
+		registered.SourcePath: {"subject_id": "sub-02"},
+		"/home/user/missing":  {"subject_id": "sub-03"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/home/user/missing"}, missing)
+
+	// Existing keys are replaced, and other keys are kept
+	_, err = fixture.ManifestFileStore.SetMetadata(fixture.Manifest.Id, map[string]map[string]string{
+		local.SourcePath: {"session": "2"},
+	})
+	require.NoError(t, err)
+
+	metadata, err := fixture.ManifestFileStore.GetMetadata([]int32{local.Id, registered.Id, fixture.ManifestFiles[2].Id})
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]map[string]string{
+		local.Id:      {"subject_id": "sub-01", "session": "2"},
+		registered.Id: {"subject_id": "sub-02"},
+	}, metadata)
+
+	fixture.AssertManifestFiles(t,
+		ManifestFilesContainsSourcePathAndStatusAssertion(local.SourcePath, manifestFile.Local),
+		ManifestFilesContainsSourcePathAndStatusAssertion(registered.SourcePath, manifestFile.Registered))
+}
+
+func testUpdateState(t *testing.T, fixture *Fixture) {
+	counts, err := fixture.ManifestFileStore.GetStatusCounts(fixture.Manifest.Id)
+	require.NoError(t, err)
+	assert.Equal(t, map[manifestFile.Status]int64{
+		manifestFile.Local:      3,
+		manifestFile.Registered: 1,
+		manifestFile.Finalized:  1,
+	}, counts)
+
+	manifest, err := fixture.ManifestStore.Get(fixture.Manifest.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.ManifestIndexed, manifest.State)
+
+	steps := []struct {
+		event    models.ManifestEvent
+		expected models.ManifestState
+	}{
+		{models.ManifestUploadStarted, models.ManifestUploading},
+		{models.ManifestSyncStarted, models.ManifestUploading},
+		{models.ManifestFilesChanged, models.ManifestUploading},
+		{models.ManifestUploadEnded, models.ManifestFinalizing},
+		{models.ManifestCancelRequested, models.ManifestFinalizing},
+		{models.ManifestSyncStarted, models.ManifestSyncing},
+		{models.ManifestCancelRequested, models.ManifestCancelled},
+		{models.ManifestSyncEnded, models.ManifestCancelled},
+		{models.ManifestFilesChanged, models.ManifestCancelled},
+		{models.ManifestReset, models.ManifestFinalizing},
+		{models.ManifestInterrupted, models.ManifestFinalizing},
+	}
+	for _, step := range steps {
+		state, err := fixture.ManifestStore.UpdateState(fixture.Manifest.Id, step.event)
+		require.NoError(t, err)
+		assert.Equal(t, step.expected, state, "Unexpected state after event %d.", step.event)
+	}
+
+	var uploadIds []string
+	for _, file := range fixture.ManifestFiles {
+		uploadIds = append(uploadIds, file.UploadId.String())
+	}
+	require.NoError(t, fixture.ManifestFileStore.BatchSetStatus(manifestFile.Verified, uploadIds[1:]))
+	require.NoError(t, fixture.ManifestFileStore.SetStatus(manifestFile.Failed, uploadIds[0]))
+	state, err := fixture.ManifestStore.UpdateState(fixture.Manifest.Id, models.ManifestFilesChanged)
+	require.NoError(t, err)
+	assert.Equal(t, models.ManifestPartiallyFailed, state)
+
+	require.NoError(t, fixture.ManifestFileStore.SetStatus(manifestFile.Verified, uploadIds[0]))
+	_, err = fixture.ManifestStore.UpdateState(fixture.Manifest.Id, models.ManifestFilesChanged)
+	require.NoError(t, err)
+
+	manifest, err = fixture.ManifestStore.Get(fixture.Manifest.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.ManifestVerified, manifest.State)
+
+	_, err = fixture.ManifestStore.UpdateState(-1, models.ManifestFilesChanged)
+	assert.Error(t, err, "Expect an error for a manifest that does not exist.")
+}
+
+func testResetInterruptedStates(t *testing.T, fixture *Fixture) {
+	_, err := fixture.ManifestStore.UpdateState(fixture.Manifest.Id, models.ManifestUploadStarted)
+	require.NoError(t, err)
+
+	n, err := fixture.ManifestStore.ResetInterruptedStates()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	manifest, err := fixture.ManifestStore.Get(fixture.Manifest.Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.ManifestFinalizing, manifest.State, "Expect the state to match the status of the files.")
+
+	n, err = fixture.ManifestStore.ResetInterruptedStates()
+	require.NoError(t, err)
+	assert.Zero(t, n, "Expect idle manifests to keep their state.")
+}
+
+func testFind(t *testing.T, fixture *Fixture) {
+	sourcePaths := func(files []ManifestFile) []string {
+		var paths []string
+		for _, file := range files {
+			paths = append(paths, file.SourcePath)
+		}
+		return paths
+	}
+
+	files, total, err := fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{
+		Statuses:   []manifestFile.Status{manifestFile.Local},
+		TargetPath: "folder2/*",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []string{"/home/user/folder2/local1.txt", "/home/user/folder2/local2.txt"}, sourcePaths(files))
+	assert.Equal(t, "folder2", files[0].TargetPath)
+	assert.Equal(t, manifestFile.Local, files[0].Status)
+
+	files, total, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{
+		SourcePath: "/home/user/folder1",
+		OrderBy:    OrderByTargetPath,
+		Descending: true,
+		Limit:      2,
+		Offset:     1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total, "Expect the total to ignore the limit and offset.")
+	assert.Equal(t, []string{"/home/user/folder1/local.txt", "/home/user/folder1/finalized.txt"}, sourcePaths(files),
+		"Expect a path without glob characters to match as a prefix.")
+
+	files, total, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{
+		Statuses: []manifestFile.Status{manifestFile.Registered, manifestFile.Finalized},
+		OrderBy:  OrderByStatus,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, []string{"/home/user/folder1/finalized.txt", "/home/user/folder1/registered.txt"}, sourcePaths(files))
+
+	files, total, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{TargetPath: "*.csv"})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, files)
+
+	files, total, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{
+		TargetPath: "/folder1/l",
+		SourcePath: "/home/user/folder2",
+	})
+	require.NoError(t, err)
+	assert.Zero(t, total, "Expect files to match both the target and the source path.")
+
+	files, _, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{TargetPath: "/folder1/l"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/home/user/folder1/local.txt"}, sourcePaths(files), "Expect a leading slash to be ignored.")
+
+	files, _, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{SourcePath: "folder1"})
+	require.NoError(t, err)
+	assert.Empty(t, files, "Expect the source path not to match the target path.")
+
+	condition, args := pathCondition("dataset_path", "sub-03/*.edf")
+	var plan []string
+	rows, err := db.Query("EXPLAIN QUERY PLAN SELECT id FROM manifest_files WHERE manifest_id = ? AND "+condition+
+		" ORDER BY dataset_path", append([]any{fixture.Manifest.Id}, args...)...)
+	require.NoError(t, err)
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		require.NoError(t, rows.Scan(&id, &parent, &notUsed, &detail))
+		plan = append(plan, detail)
+	}
+	require.NoError(t, rows.Close())
+	assert.Equal(t, []string{"SEARCH manifest_files USING INDEX idx_manifest_files_dataset_path (manifest_id=? AND dataset_path>? AND dataset_path<?)"}, plan,
+		"Expect the target path to be matched and sorted with the index.")
+
+	_, _, err = fixture.ManifestFileStore.Find(fixture.Manifest.Id, ManifestFileFilter{OrderBy: "size; DROP TABLE manifest_files"})
+	assert.Error(t, err)
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	upper, ok := prefixUpperBound("sub-03/")
+	assert.True(t, ok)
+	assert.Equal(t, "sub-030", upper)
+
+	upper, ok = prefixUpperBound("a\xff")
+	assert.True(t, ok)
+	assert.Equal(t, "b", upper)
+
+	_, ok = prefixUpperBound("\xff\xff")
+	assert.False(t, ok)
+}
+
 type Fixture struct {
 	// Stores
 	ManifestStore     *manifestStore
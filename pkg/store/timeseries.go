@@ -3,10 +3,12 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
 	log "github.com/sirupsen/logrus"
 	"strings"
+	"time"
 )
 
 type TimeseriesStore interface {
@@ -38,11 +40,74 @@ type TimeseriesStore interface {
 		Location string,
 		StartTime uint64,
 		EndTime uint64,
+		Size int64,
+		Checksum uint32,
+		Encoding models.TsSampleEncoding,
+		Scale float64,
 	) error
 	RemoveBlocksForPackage(
 		ctx context.Context,
 		packageId string,
 	) (err error)
+	TouchBlocks(
+		ctx context.Context,
+		blockNodeIds []string,
+	) error
+	GetCacheSize(
+		ctx context.Context,
+	) (int64, error)
+	GetCacheStats(
+		ctx context.Context,
+	) ([]models.TsCacheStats, error)
+	GetLeastRecentlyUsedBlocks(
+		ctx context.Context,
+		limit int,
+	) ([]models.TsBlock, error)
+	GetUnsizedBlocks(
+		ctx context.Context,
+	) ([]models.TsBlock, error)
+	SetBlockSize(
+		ctx context.Context,
+		blockNodeId string,
+		size int64,
+	) error
+	RemoveBlocks(
+		ctx context.Context,
+		blockNodeIds []string,
+	) error
+	PinPackage(
+		ctx context.Context,
+		packageId string,
+	) error
+	UnpinPackage(
+		ctx context.Context,
+		packageId string,
+	) error
+	IsPackagePinned(
+		ctx context.Context,
+		packageId string,
+	) (bool, error)
+	StoreAnnotationsForPackage(
+		ctx context.Context,
+		packageId string,
+		layers []models.TsAnnotationLayer,
+		annotations []models.TsAnnotation,
+	) error
+	GetAnnotationsFetchedAt(
+		ctx context.Context,
+		packageId string,
+	) (int64, error)
+	GetAnnotationLayersForPackage(
+		ctx context.Context,
+		packageId string,
+	) ([]models.TsAnnotationLayer, error)
+	GetAnnotationsForPackage(
+		ctx context.Context,
+		packageId string,
+		layerIds []int64,
+		startTime uint64,
+		endTime uint64,
+	) ([]models.TsAnnotation, error)
 }
 
 func NewTimeseriesStore(db *sql.DB) TimeseriesStore {
@@ -132,9 +197,9 @@ func (s *timeseriesStore) RemoveBlocksForPackage(ctx context.Context, packageId
 }
 
 func (s *timeseriesStore) StoreBlockForChannel(ctx context.Context, BlockNodeId string, ChannelNodeId string, location string,
-	StartTime uint64, EndTime uint64) error {
+	StartTime uint64, EndTime uint64, Size int64, Checksum uint32, Encoding models.TsSampleEncoding, Scale float64) error {
 
-	sqlStr := "REPLACE INTO ts_range(node_id,channel_node_id,location,start_time,end_time) VALUES (?,?,?,?,?)"
+	sqlStr := "REPLACE INTO ts_range(node_id,channel_node_id,location,start_time,end_time,size,last_access,checksum,encoding,scale) VALUES (?,?,?,?,?,?,?,?,?,?)"
 
 	stmt, err := s.db.PrepareContext(ctx, sqlStr)
 	if err != nil {
@@ -144,7 +209,7 @@ func (s *timeseriesStore) StoreBlockForChannel(ctx context.Context, BlockNodeId
 	defer stmt.Close()
 
 	log.Info(ChannelNodeId)
-	_, err = stmt.ExecContext(ctx, BlockNodeId, ChannelNodeId, location, StartTime, EndTime)
+	_, err = stmt.ExecContext(ctx, BlockNodeId, ChannelNodeId, location, StartTime, EndTime, Size, time.Now().Unix(), Checksum, Encoding, Scale)
 	if err != nil {
 		log.Error("Failed to store blocks for package: ", err)
 		return err
@@ -158,13 +223,19 @@ func (s *timeseriesStore) StoreChannelsForPackage(
 	PackageNodeId string,
 	Channels []models.TsChannel) error {
 
-	sqlStr := `INSERT INTO ts_channel(node_id, package_id, name, start_time, end_time, unit, rate) VALUES `
+	sqlStr := `INSERT INTO ts_channel(node_id, package_id, name, start_time, end_time, unit, rate, channel_group, channel_type, properties) VALUES `
 	var vals []interface{}
 
 	for _, channel := range Channels {
-		sqlStr += "(?,?,?,?,?,?,?),"
+		properties, err := json.Marshal(channel.Properties)
+		if err != nil {
+			return err
+		}
+
+		sqlStr += "(?,?,?,?,?,?,?,?,?,?),"
 		vals = append(vals, channel.ChannelNodeId, channel.PackageNodeId,
-			channel.Name, channel.Start, channel.End, channel.Unit, channel.Rate)
+			channel.Name, channel.Start, channel.End, channel.Unit, channel.Rate,
+			channel.Group, channel.Type, string(properties))
 	}
 
 	sqlStr = strings.TrimSuffix(sqlStr, ",")
@@ -176,7 +247,10 @@ func (s *timeseriesStore) StoreChannelsForPackage(
 		start_time = excluded.start_time,
 		end_time = excluded.end_time,
 		unit = excluded.unit,
-		rate = excluded.rate`
+		rate = excluded.rate,
+		channel_group = excluded.channel_group,
+		channel_type = excluded.channel_type,
+		properties = excluded.properties`
 
 	stmt, err := s.db.PrepareContext(ctx, sqlStr)
 	if err != nil {
@@ -200,7 +274,8 @@ func (s *timeseriesStore) GetChannelsForPackage(
 	packageNodeId string,
 ) ([]models.TsChannel, error) {
 
-	statement, err := s.db.PrepareContext(ctx, `SELECT node_id, package_id, name, start_time, end_time, unit, rate FROM ts_channel WHERE package_id = $1`)
+	statement, err := s.db.PrepareContext(ctx, `SELECT node_id, package_id, name, start_time, end_time, unit, rate, channel_group, channel_type, properties
+		FROM ts_channel WHERE package_id = $1`)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +290,7 @@ func (s *timeseriesStore) GetChannelsForPackage(
 	var channels []models.TsChannel
 	for rows.Next() {
 		var channel models.TsChannel
+		var properties string
 		err := rows.Scan(
 			&channel.ChannelNodeId,
 			&channel.PackageNodeId,
@@ -223,11 +299,18 @@ func (s *timeseriesStore) GetChannelsForPackage(
 			&channel.End,
 			&channel.Unit,
 			&channel.Rate,
+			&channel.Group,
+			&channel.Type,
+			&properties,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		if err := json.Unmarshal([]byte(properties), &channel.Properties); err != nil {
+			log.Warn("Ignoring invalid properties of channel ", channel.ChannelNodeId, ": ", err)
+		}
+
 		channels = append(channels, channel)
 	}
 
@@ -251,7 +334,7 @@ func (s *timeseriesStore) GetRangeBlocksForChannels(
 	// Note: not ideal to use sprintf to get channelIds in there but
 	// adding this in statement.query doesn't work.
 	statement, err := s.db.PrepareContext(ctx, fmt.Sprintf(`
-		SELECT node_id, channel_node_id, location, start_time, end_time
+		SELECT node_id, channel_node_id, location, start_time, end_time, size, checksum, encoding, scale
 		FROM ts_range
 		WHERE ((start_time <= $1 AND end_time > $1)
 		   OR (start_time >= $1 AND end_time <= $2)
@@ -280,6 +363,10 @@ func (s *timeseriesStore) GetRangeBlocksForChannels(
 			&rng.Location,
 			&rng.StartTime,
 			&rng.EndTime,
+			&rng.Size,
+			&rng.Checksum,
+			&rng.Encoding,
+			&rng.Scale,
 		)
 		if err != nil {
 			return nil, err
@@ -293,6 +380,336 @@ func (s *timeseriesStore) GetRangeBlocksForChannels(
 	return ranges, nil
 }
 
+// TouchBlocks updates the last access time of the provided blocks to now.
+func (s *timeseriesStore) TouchBlocks(ctx context.Context, blockNodeIds []string) error {
+	if len(blockNodeIds) == 0 {
+		return nil
+	}
+
+	sqlStr := fmt.Sprintf("UPDATE ts_range SET last_access = ? WHERE node_id IN (%s)", placeholders(len(blockNodeIds)))
+
+	args := append([]interface{}{time.Now().Unix()}, convertToInterfaceSlice(blockNodeIds)...)
+	_, err := s.db.ExecContext(ctx, sqlStr, args...)
+	if err != nil {
+		log.Error("Failed to update last access for blocks: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetCacheSize returns the total size in bytes of all locally cached blocks.
+func (s *timeseriesStore) GetCacheSize(ctx context.Context) (int64, error) {
+
+	var size int64
+	err := s.db.QueryRowContext(ctx, "SELECT COALESCE(SUM(size), 0) FROM ts_range").Scan(&size)
+	if err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// GetCacheStats returns the number of blocks, total size and last access time of the locally cached blocks per package.
+func (s *timeseriesStore) GetCacheStats(ctx context.Context) ([]models.TsCacheStats, error) {
+
+	sqlStr := `SELECT ts.package_id, COUNT(rng.id), COALESCE(SUM(rng.size), 0), COALESCE(MAX(rng.last_access), 0),
+        EXISTS(SELECT 1 FROM ts_pinned_package AS p WHERE p.package_id = ts.package_id)
+    FROM ts_range AS rng JOIN ts_channel AS ts ON rng.channel_node_id == ts.node_id
+    GROUP BY ts.package_id ORDER BY ts.package_id`
+
+	rows, err := s.db.QueryContext(ctx, sqlStr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []models.TsCacheStats
+	for rows.Next() {
+		var st models.TsCacheStats
+		err := rows.Scan(
+			&st.PackageNodeId,
+			&st.Blocks,
+			&st.Size,
+			&st.LastAccess,
+			&st.Pinned,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, st)
+	}
+
+	return stats, rows.Err()
+}
+
+// GetLeastRecentlyUsedBlocks returns up to limit locally cached blocks, ordered from least to most recently used.
+// Blocks of pinned packages are never returned.
+func (s *timeseriesStore) GetLeastRecentlyUsedBlocks(ctx context.Context, limit int) ([]models.TsBlock, error) {
+
+	sqlStr := `SELECT node_id, channel_node_id, location, start_time, end_time, size, last_access
+    FROM ts_range WHERE channel_node_id NOT IN (
+        SELECT ch.node_id FROM ts_channel AS ch JOIN ts_pinned_package AS p ON ch.package_id = p.package_id)
+    ORDER BY last_access, id LIMIT ?`
+
+	return s.queryBlocks(ctx, sqlStr, limit)
+}
+
+// GetUnsizedBlocks returns the locally cached blocks for which the size is unknown, such as blocks
+// that were cached before the size of blocks was recorded.
+func (s *timeseriesStore) GetUnsizedBlocks(ctx context.Context) ([]models.TsBlock, error) {
+
+	sqlStr := `SELECT node_id, channel_node_id, location, start_time, end_time, size, last_access
+    FROM ts_range WHERE size = 0`
+
+	return s.queryBlocks(ctx, sqlStr)
+}
+
+// SetBlockSize sets the size in bytes of a locally cached block.
+func (s *timeseriesStore) SetBlockSize(ctx context.Context, blockNodeId string, size int64) error {
+
+	_, err := s.db.ExecContext(ctx, "UPDATE ts_range SET size = ? WHERE node_id = ?", size, blockNodeId)
+	return err
+}
+
+// RemoveBlocks removes the provided blocks from the database.
+func (s *timeseriesStore) RemoveBlocks(ctx context.Context, blockNodeIds []string) error {
+	if len(blockNodeIds) == 0 {
+		return nil
+	}
+
+	sqlStr := fmt.Sprintf("DELETE FROM ts_range WHERE node_id IN (%s)", placeholders(len(blockNodeIds)))
+
+	_, err := s.db.ExecContext(ctx, sqlStr, convertToInterfaceSlice(blockNodeIds)...)
+	if err != nil {
+		log.Error("Failed to remove blocks: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// PinPackage protects the cached blocks of a package from eviction.
+func (s *timeseriesStore) PinPackage(ctx context.Context, packageId string) error {
+
+	_, err := s.db.ExecContext(ctx, "REPLACE INTO ts_pinned_package(package_id, pinned_at) VALUES (?, ?)",
+		packageId, time.Now().Unix())
+	if err != nil {
+		log.Error("Failed to pin package: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// UnpinPackage allows the cached blocks of a package to be evicted again.
+func (s *timeseriesStore) UnpinPackage(ctx context.Context, packageId string) error {
+
+	_, err := s.db.ExecContext(ctx, "DELETE FROM ts_pinned_package WHERE package_id = ?", packageId)
+	if err != nil {
+		log.Error("Failed to unpin package: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// IsPackagePinned returns true if the cached blocks of a package are protected from eviction.
+func (s *timeseriesStore) IsPackagePinned(ctx context.Context, packageId string) (bool, error) {
+
+	var pinned bool
+	err := s.db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM ts_pinned_package WHERE package_id = ?)",
+		packageId).Scan(&pinned)
+	return pinned, err
+}
+
+// StoreAnnotationsForPackage replaces the cached annotation layers and annotations of a package.
+func (s *timeseriesStore) StoreAnnotationsForPackage(ctx context.Context, packageId string,
+	layers []models.TsAnnotationLayer, annotations []models.TsAnnotation) error {
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM ts_annotation WHERE package_id = ?", packageId); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM ts_annotation_layer WHERE package_id = ?", packageId); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "REPLACE INTO ts_annotation_package(package_id, fetched_at) VALUES (?, ?)",
+		packageId, time.Now().Unix()); err != nil {
+		return err
+	}
+
+	layerStmt, err := tx.PrepareContext(ctx,
+		"REPLACE INTO ts_annotation_layer(layer_id, package_id, name, description, color) VALUES (?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer layerStmt.Close()
+
+	for _, layer := range layers {
+		_, err := layerStmt.ExecContext(ctx, layer.LayerId, packageId, layer.Name, layer.Description, layer.Color)
+		if err != nil {
+			log.Error("Failed to store annotation layer: ", err)
+			return err
+		}
+	}
+
+	annotationStmt, err := tx.PrepareContext(ctx, `REPLACE INTO ts_annotation(annotation_id, layer_id, package_id, channel_ids,
+		name, label, description, start_time, end_time) VALUES (?,?,?,?,?,?,?,?,?)`)
+	if err != nil {
+		return err
+	}
+	defer annotationStmt.Close()
+
+	for _, annotation := range annotations {
+		channelIds, err := json.Marshal(annotation.ChannelNodeIds)
+		if err != nil {
+			return err
+		}
+
+		_, err = annotationStmt.ExecContext(ctx, annotation.AnnotationId, annotation.LayerId, packageId, string(channelIds),
+			annotation.Name, annotation.Label, annotation.Description, annotation.StartTime, annotation.EndTime)
+		if err != nil {
+			log.Error("Failed to store annotation: ", err)
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetAnnotationsFetchedAt returns the Unix time at which the annotations of a package were cached,
+// or 0 if the annotations of the package are not cached.
+func (s *timeseriesStore) GetAnnotationsFetchedAt(ctx context.Context, packageId string) (int64, error) {
+
+	var fetchedAt int64
+	err := s.db.QueryRowContext(ctx, "SELECT fetched_at FROM ts_annotation_package WHERE package_id = ?",
+		packageId).Scan(&fetchedAt)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return fetchedAt, err
+}
+
+// GetAnnotationLayersForPackage returns the cached annotation layers of a package.
+func (s *timeseriesStore) GetAnnotationLayersForPackage(ctx context.Context, packageId string) ([]models.TsAnnotationLayer, error) {
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT layer_id, package_id, name, description, color FROM ts_annotation_layer WHERE package_id = ? ORDER BY layer_id",
+		packageId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var layers []models.TsAnnotationLayer
+	for rows.Next() {
+		var layer models.TsAnnotationLayer
+		err := rows.Scan(&layer.LayerId, &layer.PackageNodeId, &layer.Name, &layer.Description, &layer.Color)
+		if err != nil {
+			return nil, err
+		}
+
+		layers = append(layers, layer)
+	}
+
+	return layers, rows.Err()
+}
+
+// GetAnnotationsForPackage returns the cached annotations of a package that overlap with the time range,
+// ordered by start time. Only annotations in the provided layers are returned, unless no layers are provided.
+func (s *timeseriesStore) GetAnnotationsForPackage(ctx context.Context, packageId string, layerIds []int64,
+	startTime uint64, endTime uint64) ([]models.TsAnnotation, error) {
+
+	sqlStr := `SELECT annotation_id, layer_id, package_id, channel_ids, name, label, description, start_time, end_time
+		FROM ts_annotation WHERE package_id = ? AND start_time <= ? AND end_time >= ?`
+	args := []interface{}{packageId, endTime, startTime}
+
+	if len(layerIds) > 0 {
+		sqlStr += fmt.Sprintf(" AND layer_id IN (%s)", placeholders(len(layerIds)))
+		for _, id := range layerIds {
+			args = append(args, id)
+		}
+	}
+	sqlStr += " ORDER BY start_time, annotation_id"
+
+	rows, err := s.db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var annotations []models.TsAnnotation
+	for rows.Next() {
+		var annotation models.TsAnnotation
+		var channelIds string
+		err := rows.Scan(
+			&annotation.AnnotationId,
+			&annotation.LayerId,
+			&annotation.PackageNodeId,
+			&channelIds,
+			&annotation.Name,
+			&annotation.Label,
+			&annotation.Description,
+			&annotation.StartTime,
+			&annotation.EndTime,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal([]byte(channelIds), &annotation.ChannelNodeIds); err != nil {
+			return nil, err
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	return annotations, rows.Err()
+}
+
+func (s *timeseriesStore) queryBlocks(ctx context.Context, sqlStr string, args ...interface{}) ([]models.TsBlock, error) {
+
+	rows, err := s.db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []models.TsBlock
+	for rows.Next() {
+		var block models.TsBlock
+		err := rows.Scan(
+			&block.BlockNodeId,
+			&block.ChannelNodeId,
+			&block.Location,
+			&block.StartTime,
+			&block.EndTime,
+			&block.Size,
+			&block.LastAccess,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, rows.Err()
+}
+
+// placeholders returns a comma separated list of n query placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
 func convertToInterfaceSlice(stringSlice []string) []interface{} {
 	interfaceSlice := make([]interface{}, len(stringSlice))
 	for i, str := range stringSlice {
@@ -32,6 +32,8 @@ func TestCreateChannels(t *testing.T) {
 			End:           500,
 			Unit:          "uV",
 			Rate:          120.5,
+			Group:         "EEG",
+			Properties:    map[string]string{"reference": "Cz"},
 		},
 		{
 			ChannelNodeId: "N:channel:store-test-2",
@@ -52,6 +54,8 @@ func TestCreateChannels(t *testing.T) {
 
 	assert.Equal(t, 2, len(results))
 	assert.Equal(t, "N:channel:store-test-1", results[0].ChannelNodeId)
+	assert.Equal(t, "EEG", results[0].Group)
+	assert.Equal(t, "Cz", results[0].Properties["reference"])
 
 }
 
@@ -63,6 +67,7 @@ func TestRangeStore(t *testing.T) {
 	ranges, err := store.GetRangeBlocksForChannels(context.Background(), []string{"N:channel:1"}, 50, 150)
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(ranges))
+	assert.Equal(t, models.TsFloat64, ranges[0].Encoding, "Expect blocks cached before encodings were recorded to be float64.")
 
 	ranges, err = store.GetRangeBlocksForChannels(context.Background(), []string{"N:channel:1"}, 1, 100)
 	assert.NoError(t, err)
@@ -83,3 +88,136 @@ func TestRangeStore(t *testing.T) {
 	assert.Equal(t, 2, len(ranges))
 
 }
+
+func TestCacheTracking(t *testing.T) {
+
+	store := NewTimeseriesStore(db)
+	ctx := context.Background()
+
+	packageNodeId := "N:package:cache-test"
+	err := store.StoreChannelsForPackage(ctx, packageNodeId, []models.TsChannel{
+		{ChannelNodeId: "N:channel:cache-test", PackageNodeId: packageNodeId, Name: "A1", Start: 1, End: 500, Unit: "uV", Rate: 100},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.StoreBlockForChannel(ctx, "cache-1", "N:channel:cache-test", "location/cache-1", 1, 100, 1000, 1234, models.TsFloat64, 1))
+	assert.NoError(t, store.StoreBlockForChannel(ctx, "cache-2", "N:channel:cache-test", "location/cache-2", 100, 200, 2000, 0, models.TsFloat64, 1))
+
+	// Cached blocks include the size and checksum to verify the block file, and the encoding of the samples
+	cached, err := store.GetRangeBlocksForChannels(ctx, []string{"N:channel:cache-test"}, 1, 50)
+	assert.NoError(t, err)
+	if assert.Len(t, cached, 1) {
+		assert.Equal(t, int64(1000), cached[0].Size)
+		assert.Equal(t, uint32(1234), cached[0].Checksum)
+		assert.Equal(t, models.TsFloat64, cached[0].Encoding)
+		assert.Equal(t, 1.0, cached[0].Scale)
+	}
+
+	stats, err := store.GetCacheStats(ctx)
+	assert.NoError(t, err)
+	found := false
+	for _, st := range stats {
+		if st.PackageNodeId == packageNodeId {
+			found = true
+			assert.Equal(t, int64(2), st.Blocks)
+			assert.Equal(t, int64(3000), st.Size)
+			assert.NotZero(t, st.LastAccess)
+		}
+	}
+	assert.True(t, found, "Expect stats for cached package.")
+
+	// Blocks from the test data were never accessed, so they are the least recently used.
+	blocks, err := store.GetLeastRecentlyUsedBlocks(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "cache-2", blocks[len(blocks)-1].BlockNodeId)
+	assert.Zero(t, blocks[0].LastAccess)
+
+	// Blocks of pinned packages are never evicted
+	assert.NoError(t, store.PinPackage(ctx, packageNodeId))
+	pinned, err := store.IsPackagePinned(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.True(t, pinned)
+	blocks, err = store.GetLeastRecentlyUsedBlocks(ctx, 100)
+	assert.NoError(t, err)
+	for _, b := range blocks {
+		assert.NotEqual(t, "N:channel:cache-test", b.ChannelNodeId)
+	}
+	stats, err = store.GetCacheStats(ctx)
+	assert.NoError(t, err)
+	for _, st := range stats {
+		assert.Equal(t, st.PackageNodeId == packageNodeId, st.Pinned)
+	}
+
+	assert.NoError(t, store.UnpinPackage(ctx, packageNodeId))
+	pinned, err = store.IsPackagePinned(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.False(t, pinned)
+	blocks, err = store.GetLeastRecentlyUsedBlocks(ctx, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "cache-2", blocks[len(blocks)-1].BlockNodeId)
+
+	// Remove blocks from the cache
+	assert.NoError(t, store.RemoveBlocks(ctx, []string{"cache-1", "cache-2"}))
+	local, err := store.GetLocalBlocksForPackage(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.Len(t, local, 0)
+}
+
+func TestAnnotationStore(t *testing.T) {
+
+	store := NewTimeseriesStore(db)
+	ctx := context.Background()
+
+	packageNodeId := "N:package:annotation-test"
+
+	fetchedAt, err := store.GetAnnotationsFetchedAt(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), fetchedAt)
+
+	layers := []models.TsAnnotationLayer{
+		{LayerId: 1001, Name: "Default"},
+		{LayerId: 1002, Name: "Seizures", Description: "Reviewed seizures", Color: "#ff0000"},
+	}
+	annotations := []models.TsAnnotation{
+		{AnnotationId: 2001, LayerId: 1001, Label: "Eyes closed", StartTime: 100, EndTime: 100},
+		{AnnotationId: 2002, LayerId: 1002, Label: "Seizure", ChannelNodeIds: []string{"N:channel:1", "N:channel:2"},
+			StartTime: 200, EndTime: 400},
+		{AnnotationId: 2003, LayerId: 1001, Label: "Artifact", StartTime: 500, EndTime: 600},
+	}
+
+	err = store.StoreAnnotationsForPackage(ctx, packageNodeId, layers, annotations)
+	assert.NoError(t, err)
+
+	fetchedAt, err = store.GetAnnotationsFetchedAt(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.NotZero(t, fetchedAt)
+
+	cachedLayers, err := store.GetAnnotationLayersForPackage(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.Len(t, cachedLayers, 2)
+	assert.Equal(t, "#ff0000", cachedLayers[1].Color)
+
+	// Annotations overlapping with the range, including events at the boundaries
+	results, err := store.GetAnnotationsForPackage(ctx, packageNodeId, nil, 100, 300)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, int64(2001), results[0].AnnotationId)
+		assert.Equal(t, []string{"N:channel:1", "N:channel:2"}, results[1].ChannelNodeIds)
+	}
+
+	results, err = store.GetAnnotationsForPackage(ctx, packageNodeId, []int64{1001}, 0, 1000)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	// Storing annotations again replaces the cached annotations of the package
+	err = store.StoreAnnotationsForPackage(ctx, packageNodeId, layers[:1], annotations[:1])
+	assert.NoError(t, err)
+
+	results, err = store.GetAnnotationsForPackage(ctx, packageNodeId, nil, 0, 1000)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	cachedLayers, err = store.GetAnnotationLayersForPackage(ctx, packageNodeId)
+	assert.NoError(t, err)
+	assert.Len(t, cachedLayers, 1)
+}
@@ -0,0 +1,114 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+)
+
+// ErrWorkflowRunNotFound is returned when a workflow run does not exist.
+var ErrWorkflowRunNotFound = errors.New("workflow run not found")
+
+type WorkflowRunStore interface {
+	CreateRun(ctx context.Context, run models.WorkflowRun) error
+	UpdateRun(ctx context.Context, run models.WorkflowRun) error
+	GetRun(ctx context.Context, id string) (*models.WorkflowRun, error)
+	ListRuns(ctx context.Context, manifestId int32) ([]models.WorkflowRun, error)
+	FailInterruptedRuns(ctx context.Context) (int64, error)
+}
+
+func NewWorkflowRunStore(db *sql.DB) *workflowRunStore {
+	return &workflowRunStore{
+		db: db,
+	}
+}
+
+type workflowRunStore struct {
+	db *sql.DB
+}
+
+const workflowRunColumns = "id, manifest_id, workflow, status, job_dir, log_path, exit_code, error, created_at, started_at, finished_at, derivatives_manifest_id"
+
+// CreateRun stores a new workflow run.
+func (s *workflowRunStore) CreateRun(ctx context.Context, run models.WorkflowRun) error {
+	_, err := s.db.ExecContext(ctx,
+		"INSERT INTO workflow_runs("+workflowRunColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		run.Id, run.ManifestId, run.Workflow, run.Status, run.JobDir, run.LogPath,
+		run.ExitCode, run.Error, run.CreatedAt, run.StartedAt, run.FinishedAt, run.DerivativesManifestId)
+	if err != nil {
+		return fmt.Errorf("unable to create workflow run %s: %w", run.Id, err)
+	}
+	return nil
+}
+
+// UpdateRun updates the status, result and times of a workflow run.
+func (s *workflowRunStore) UpdateRun(ctx context.Context, run models.WorkflowRun) error {
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE workflow_runs SET status = ?, exit_code = ?, error = ?, started_at = ?, finished_at = ?, derivatives_manifest_id = ? WHERE id = ?",
+		run.Status, run.ExitCode, run.Error, run.StartedAt, run.FinishedAt, run.DerivativesManifestId, run.Id)
+	if err != nil {
+		return fmt.Errorf("unable to update workflow run %s: %w", run.Id, err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return ErrWorkflowRunNotFound
+	}
+	return nil
+}
+
+// GetRun returns the workflow run with the provided id, or ErrWorkflowRunNotFound.
+func (s *workflowRunStore) GetRun(ctx context.Context, id string) (*models.WorkflowRun, error) {
+	row := s.db.QueryRowContext(ctx, "SELECT "+workflowRunColumns+" FROM workflow_runs WHERE id = ?", id)
+	run, err := scanWorkflowRun(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWorkflowRunNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// ListRuns returns the workflow runs for a manifest, or for all manifests if the manifest id is 0,
+// with the most recent run first.
+func (s *workflowRunStore) ListRuns(ctx context.Context, manifestId int32) ([]models.WorkflowRun, error) {
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+workflowRunColumns+" FROM workflow_runs WHERE ? = 0 OR manifest_id = ? ORDER BY created_at DESC, rowid DESC",
+		manifestId, manifestId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []models.WorkflowRun
+	for rows.Next() {
+		run, err := scanWorkflowRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// FailInterruptedRuns marks runs that were pending or running when the agent stopped as failed,
+// and returns the number of runs that were updated.
+func (s *workflowRunStore) FailInterruptedRuns(ctx context.Context) (int64, error) {
+	result, err := s.db.ExecContext(ctx,
+		"UPDATE workflow_runs SET status = ?, error = ?, finished_at = ? WHERE status IN (?, ?)",
+		models.WorkflowFailed, "interrupted because the agent stopped", time.Now().Unix(),
+		models.WorkflowPending, models.WorkflowRunning)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func scanWorkflowRun(row interface{ Scan(dest ...any) error }) (models.WorkflowRun, error) {
+	var run models.WorkflowRun
+	err := row.Scan(&run.Id, &run.ManifestId, &run.Workflow, &run.Status, &run.JobDir, &run.LogPath,
+		&run.ExitCode, &run.Error, &run.CreatedAt, &run.StartedAt, &run.FinishedAt, &run.DerivativesManifestId)
+	return run, err
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+	"github.com/pennsieve/pennsieve-agent/v2/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWorkflowRunStore(t *testing.T) {
+
+	store := NewWorkflowRunStore(db)
+	ctx := context.Background()
+
+	runs := []models.WorkflowRun{
+		{Id: "run-1", ManifestId: 901, Workflow: "/workflows/a.nf", Status: models.WorkflowRunning, CreatedAt: 100},
+		{Id: "run-2", ManifestId: 901, Workflow: "/workflows/b.nf", Status: models.WorkflowPending, CreatedAt: 200},
+		{Id: "run-3", ManifestId: 902, Workflow: "/workflows/a.nf", Status: models.WorkflowSucceeded, CreatedAt: 300},
+	}
+	for _, run := range runs {
+		require.NoError(t, store.CreateRun(ctx, run))
+	}
+
+	run, err := store.GetRun(ctx, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, "/workflows/a.nf", run.Workflow)
+	assert.Equal(t, models.WorkflowRunning, run.Status)
+
+	_, err = store.GetRun(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrWorkflowRunNotFound)
+
+	run.Status = models.WorkflowFailed
+	run.ExitCode = 2
+	run.Error = "exit status 2"
+	run.FinishedAt = 150
+	run.DerivativesManifestId = 12
+	require.NoError(t, store.UpdateRun(ctx, *run))
+
+	run, err = store.GetRun(ctx, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowFailed, run.Status)
+	assert.Equal(t, 2, run.ExitCode)
+	assert.Equal(t, int64(150), run.FinishedAt)
+	assert.Equal(t, int32(12), run.DerivativesManifestId)
+
+	assert.ErrorIs(t, store.UpdateRun(ctx, models.WorkflowRun{Id: "unknown"}), ErrWorkflowRunNotFound)
+
+	// Most recent run first
+	manifestRuns, err := store.ListRuns(ctx, 901)
+	require.NoError(t, err)
+	if assert.Len(t, manifestRuns, 2) {
+		assert.Equal(t, "run-2", manifestRuns[0].Id)
+	}
+
+	allRuns, err := store.ListRuns(ctx, 0)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(allRuns), 3)
+
+	// Runs that did not finish when the agent stopped are failed
+	n, err := store.FailInterruptedRuns(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	run, err = store.GetRun(ctx, "run-2")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowFailed, run.Status)
+	assert.NotEmpty(t, run.Error)
+	assert.NotZero(t, run.FinishedAt)
+}
@@ -82,6 +82,7 @@ func (c *subscriberClient) Start(types []api.SubscribeResponse_MessageType, stop
 			api.SubscribeResponse_SYNC_STATUS,
 			api.SubscribeResponse_UPLOAD_CANCEL,
 			api.SubscribeResponse_DOWNLOAD_STATUS,
+			api.SubscribeResponse_WORKFLOW_STATUS,
 		}
 	}
 
@@ -233,6 +234,24 @@ func (c *subscriberClient) Start(types []api.SubscribeResponse_MessageType, stop
 				}
 
 			}
+		case api.SubscribeResponse_WORKFLOW_STATUS:
+			if contains(types, api.SubscribeResponse_WORKFLOW_STATUS) {
+				r := response.GetWorkflowStatus()
+
+				if r.Output != "" {
+					fmt.Printf("[%.8s] %s\n", r.RunId, r.Output)
+					break
+				}
+
+				fmt.Printf("Workflow run %s: %s\n", r.RunId, r.Status)
+				switch r.Status {
+				case api.WorkflowRun_SUCCEEDED, api.WorkflowRun_FAILED, api.WorkflowRun_CANCELLED:
+					if stopOnComplete.Enable && contains(stopOnComplete.OnType, api.SubscribeResponse_WORKFLOW_STATUS) {
+						_ = c.unsubscribe()
+						return
+					}
+				}
+			}
 
 		default:
 			log.Error("Received an unknown message type: ", response.GetType())
@@ -38,14 +38,17 @@ def build_container_csv_paths():
             new_path = f"{root}/{file}"
             NEWPATH_DATA[new_path] = new_path
 
-def get_manifest_roots():
+def get_manifest_mounts():
     f = open('/job/workflow/work_order.json')
     work_order = json.load(f)
-    return work_order['ManifestRoots']
+    mounts = work_order.get('Mounts')
+    if not mounts:
+        return [{'Source': root, 'Target': SYMLINK_FOLDER} for root in work_order['ManifestRoots']]
+    return mounts
 
 def main(csv_file):
     build_container_csv_paths()
-    dir_roots = get_manifest_roots()
+    mounts = get_manifest_mounts()
     print(NEWPATH_DATA)
     with open(csv_file, 'r') as file:
         reader = csv.DictReader(file)
@@ -58,8 +61,11 @@ def main(csv_file):
         for row in reader:
 
             # replace user machine path with container path
-            for root in dir_roots:
-                source_path = row['source_path'].replace(root, SYMLINK_FOLDER)
+            source_path = row['source_path']
+            for mount in mounts:
+                if source_path == mount['Source'] or source_path.startswith(mount['Source'] + os.sep):
+                    source_path = mount['Target'] + source_path[len(mount['Source']):]
+                    break
 
             target_path = row['target_path']
             create_sym_link(source_path, target_path)
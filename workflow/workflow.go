@@ -0,0 +1,12 @@
+// Package workflow contains the Nextflow workflow that wraps user workflows with the
+// pre- and postprocessing steps of the agent. The files are embedded in the agent and
+// written to the folder of each workflow run.
+package workflow
+
+import "embed"
+
+//go:embed *.nf
+var Files embed.FS
+
+// Main is the name of the workflow file that runs the user workflow.
+const Main = "master_workflow.nf"